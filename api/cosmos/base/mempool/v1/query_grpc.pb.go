@@ -0,0 +1,232 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: cosmos/base/mempool/v1/query.proto
+
+package mempoolv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	Query_Txs_FullMethodName          = "/cosmos.base.mempool.v1.Query/Txs"
+	Query_TxsBySender_FullMethodName  = "/cosmos.base.mempool.v1.Query/TxsBySender"
+	Query_TxByHash_FullMethodName     = "/cosmos.base.mempool.v1.Query/TxByHash"
+	Query_SenderCounts_FullMethodName = "/cosmos.base.mempool.v1.Query/SenderCounts"
+)
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type QueryClient interface {
+	// Txs queries the transactions pending in the mempool.
+	Txs(ctx context.Context, in *QueryTxsRequest, opts ...grpc.CallOption) (*QueryTxsResponse, error)
+	// TxsBySender queries the transactions of a sender pending in the mempool,
+	// ordered by nonce.
+	TxsBySender(ctx context.Context, in *QueryTxsBySenderRequest, opts ...grpc.CallOption) (*QueryTxsBySenderResponse, error)
+	// TxByHash queries a transaction pending in the mempool by its hash.
+	TxByHash(ctx context.Context, in *QueryTxByHashRequest, opts ...grpc.CallOption) (*QueryTxByHashResponse, error)
+	// SenderCounts queries the number of transactions pending in the mempool for
+	// each sender.
+	SenderCounts(ctx context.Context, in *QuerySenderCountsRequest, opts ...grpc.CallOption) (*QuerySenderCountsResponse, error)
+}
+
+type queryClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewQueryClient(cc grpc.ClientConnInterface) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Txs(ctx context.Context, in *QueryTxsRequest, opts ...grpc.CallOption) (*QueryTxsResponse, error) {
+	out := new(QueryTxsResponse)
+	err := c.cc.Invoke(ctx, Query_Txs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TxsBySender(ctx context.Context, in *QueryTxsBySenderRequest, opts ...grpc.CallOption) (*QueryTxsBySenderResponse, error) {
+	out := new(QueryTxsBySenderResponse)
+	err := c.cc.Invoke(ctx, Query_TxsBySender_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TxByHash(ctx context.Context, in *QueryTxByHashRequest, opts ...grpc.CallOption) (*QueryTxByHashResponse, error) {
+	out := new(QueryTxByHashResponse)
+	err := c.cc.Invoke(ctx, Query_TxByHash_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SenderCounts(ctx context.Context, in *QuerySenderCountsRequest, opts ...grpc.CallOption) (*QuerySenderCountsResponse, error) {
+	out := new(QuerySenderCountsResponse)
+	err := c.cc.Invoke(ctx, Query_SenderCounts_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+// All implementations must embed UnimplementedQueryServer
+// for forward compatibility
+type QueryServer interface {
+	// Txs queries the transactions pending in the mempool.
+	Txs(context.Context, *QueryTxsRequest) (*QueryTxsResponse, error)
+	// TxsBySender queries the transactions of a sender pending in the mempool,
+	// ordered by nonce.
+	TxsBySender(context.Context, *QueryTxsBySenderRequest) (*QueryTxsBySenderResponse, error)
+	// TxByHash queries a transaction pending in the mempool by its hash.
+	TxByHash(context.Context, *QueryTxByHashRequest) (*QueryTxByHashResponse, error)
+	// SenderCounts queries the number of transactions pending in the mempool for
+	// each sender.
+	SenderCounts(context.Context, *QuerySenderCountsRequest) (*QuerySenderCountsResponse, error)
+	mustEmbedUnimplementedQueryServer()
+}
+
+// UnimplementedQueryServer must be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (UnimplementedQueryServer) Txs(context.Context, *QueryTxsRequest) (*QueryTxsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Txs not implemented")
+}
+func (UnimplementedQueryServer) TxsBySender(context.Context, *QueryTxsBySenderRequest) (*QueryTxsBySenderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TxsBySender not implemented")
+}
+func (UnimplementedQueryServer) TxByHash(context.Context, *QueryTxByHashRequest) (*QueryTxByHashResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TxByHash not implemented")
+}
+func (UnimplementedQueryServer) SenderCounts(context.Context, *QuerySenderCountsRequest) (*QuerySenderCountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SenderCounts not implemented")
+}
+func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
+
+// UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to QueryServer will
+// result in compilation errors.
+type UnsafeQueryServer interface {
+	mustEmbedUnimplementedQueryServer()
+}
+
+func RegisterQueryServer(s grpc.ServiceRegistrar, srv QueryServer) {
+	s.RegisterService(&Query_ServiceDesc, srv)
+}
+
+func _Query_Txs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTxsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Txs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Txs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Txs(ctx, req.(*QueryTxsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TxsBySender_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTxsBySenderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TxsBySender(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_TxsBySender_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TxsBySender(ctx, req.(*QueryTxsBySenderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TxByHash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTxByHashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TxByHash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_TxByHash_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TxByHash(ctx, req.(*QueryTxByHashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SenderCounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySenderCountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SenderCounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SenderCounts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SenderCounts(ctx, req.(*QuerySenderCountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Query_ServiceDesc is the grpc.ServiceDesc for Query service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Query_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.base.mempool.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Txs",
+			Handler:    _Query_Txs_Handler,
+		},
+		{
+			MethodName: "TxsBySender",
+			Handler:    _Query_TxsBySender_Handler,
+		},
+		{
+			MethodName: "TxByHash",
+			Handler:    _Query_TxByHash_Handler,
+		},
+		{
+			MethodName: "SenderCounts",
+			Handler:    _Query_SenderCounts_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/base/mempool/v1/query.proto",
+}
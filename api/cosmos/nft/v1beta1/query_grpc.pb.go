@@ -19,13 +19,14 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_Balance_FullMethodName = "/cosmos.nft.v1beta1.Query/Balance"
-	Query_Owner_FullMethodName   = "/cosmos.nft.v1beta1.Query/Owner"
-	Query_Supply_FullMethodName  = "/cosmos.nft.v1beta1.Query/Supply"
-	Query_NFTs_FullMethodName    = "/cosmos.nft.v1beta1.Query/NFTs"
-	Query_NFT_FullMethodName     = "/cosmos.nft.v1beta1.Query/NFT"
-	Query_Class_FullMethodName   = "/cosmos.nft.v1beta1.Query/Class"
-	Query_Classes_FullMethodName = "/cosmos.nft.v1beta1.Query/Classes"
+	Query_Balance_FullMethodName     = "/cosmos.nft.v1beta1.Query/Balance"
+	Query_Owner_FullMethodName       = "/cosmos.nft.v1beta1.Query/Owner"
+	Query_Supply_FullMethodName      = "/cosmos.nft.v1beta1.Query/Supply"
+	Query_NFTs_FullMethodName        = "/cosmos.nft.v1beta1.Query/NFTs"
+	Query_NFT_FullMethodName         = "/cosmos.nft.v1beta1.Query/NFT"
+	Query_Class_FullMethodName       = "/cosmos.nft.v1beta1.Query/Class"
+	Query_Classes_FullMethodName     = "/cosmos.nft.v1beta1.Query/Classes"
+	Query_ClassPolicy_FullMethodName = "/cosmos.nft.v1beta1.Query/ClassPolicy"
 )
 
 // QueryClient is the client API for Query service.
@@ -47,6 +48,8 @@ type QueryClient interface {
 	Class(ctx context.Context, in *QueryClassRequest, opts ...grpc.CallOption) (*QueryClassResponse, error)
 	// Classes queries all NFT classes
 	Classes(ctx context.Context, in *QueryClassesRequest, opts ...grpc.CallOption) (*QueryClassesResponse, error)
+	// ClassPolicy queries the policy of an NFT class based on its id
+	ClassPolicy(ctx context.Context, in *QueryClassPolicyRequest, opts ...grpc.CallOption) (*QueryClassPolicyResponse, error)
 }
 
 type queryClient struct {
@@ -120,6 +123,15 @@ func (c *queryClient) Classes(ctx context.Context, in *QueryClassesRequest, opts
 	return out, nil
 }
 
+func (c *queryClient) ClassPolicy(ctx context.Context, in *QueryClassPolicyRequest, opts ...grpc.CallOption) (*QueryClassPolicyResponse, error) {
+	out := new(QueryClassPolicyResponse)
+	err := c.cc.Invoke(ctx, Query_ClassPolicy_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -139,6 +151,8 @@ type QueryServer interface {
 	Class(context.Context, *QueryClassRequest) (*QueryClassResponse, error)
 	// Classes queries all NFT classes
 	Classes(context.Context, *QueryClassesRequest) (*QueryClassesResponse, error)
+	// ClassPolicy queries the policy of an NFT class based on its id
+	ClassPolicy(context.Context, *QueryClassPolicyRequest) (*QueryClassPolicyResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -167,6 +181,9 @@ func (UnimplementedQueryServer) Class(context.Context, *QueryClassRequest) (*Que
 func (UnimplementedQueryServer) Classes(context.Context, *QueryClassesRequest) (*QueryClassesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Classes not implemented")
 }
+func (UnimplementedQueryServer) ClassPolicy(context.Context, *QueryClassPolicyRequest) (*QueryClassPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClassPolicy not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -306,6 +323,24 @@ func _Query_Classes_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ClassPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClassPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ClassPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ClassPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ClassPolicy(ctx, req.(*QueryClassPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -341,6 +376,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Classes",
 			Handler:    _Query_Classes_Handler,
 		},
+		{
+			MethodName: "ClassPolicy",
+			Handler:    _Query_ClassPolicy_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/nft/v1beta1/query.proto",
@@ -19,7 +19,9 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	ReflectionService_FileDescriptors_FullMethodName = "/cosmos.reflection.v1.ReflectionService/FileDescriptors"
+	ReflectionService_FileDescriptors_FullMethodName    = "/cosmos.reflection.v1.ReflectionService/FileDescriptors"
+	ReflectionService_CollectionsSchemas_FullMethodName = "/cosmos.reflection.v1.ReflectionService/CollectionsSchemas"
+	ReflectionService_DecodeStorePair_FullMethodName    = "/cosmos.reflection.v1.ReflectionService/DecodeStorePair"
 )
 
 // ReflectionServiceClient is the client API for ReflectionService service.
@@ -29,6 +31,13 @@ type ReflectionServiceClient interface {
 	// FileDescriptors queries all the file descriptors in the app in order
 	// to enable easier generation of dynamic clients.
 	FileDescriptors(ctx context.Context, in *FileDescriptorsRequest, opts ...grpc.CallOption) (*FileDescriptorsResponse, error)
+	// CollectionsSchemas queries the collections schemas of the app's modules,
+	// which describe the collections stored within each module store.
+	CollectionsSchemas(ctx context.Context, in *CollectionsSchemasRequest, opts ...grpc.CallOption) (*CollectionsSchemasResponse, error)
+	// DecodeStorePair decodes a raw key and value of a module store into JSON,
+	// using the collections schema of the module. It enables clients, such as
+	// off-chain indexers, to decode state changes without module specific decoders.
+	DecodeStorePair(ctx context.Context, in *DecodeStorePairRequest, opts ...grpc.CallOption) (*DecodeStorePairResponse, error)
 }
 
 type reflectionServiceClient struct {
@@ -48,6 +57,24 @@ func (c *reflectionServiceClient) FileDescriptors(ctx context.Context, in *FileD
 	return out, nil
 }
 
+func (c *reflectionServiceClient) CollectionsSchemas(ctx context.Context, in *CollectionsSchemasRequest, opts ...grpc.CallOption) (*CollectionsSchemasResponse, error) {
+	out := new(CollectionsSchemasResponse)
+	err := c.cc.Invoke(ctx, ReflectionService_CollectionsSchemas_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reflectionServiceClient) DecodeStorePair(ctx context.Context, in *DecodeStorePairRequest, opts ...grpc.CallOption) (*DecodeStorePairResponse, error) {
+	out := new(DecodeStorePairResponse)
+	err := c.cc.Invoke(ctx, ReflectionService_DecodeStorePair_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ReflectionServiceServer is the server API for ReflectionService service.
 // All implementations must embed UnimplementedReflectionServiceServer
 // for forward compatibility
@@ -55,6 +82,13 @@ type ReflectionServiceServer interface {
 	// FileDescriptors queries all the file descriptors in the app in order
 	// to enable easier generation of dynamic clients.
 	FileDescriptors(context.Context, *FileDescriptorsRequest) (*FileDescriptorsResponse, error)
+	// CollectionsSchemas queries the collections schemas of the app's modules,
+	// which describe the collections stored within each module store.
+	CollectionsSchemas(context.Context, *CollectionsSchemasRequest) (*CollectionsSchemasResponse, error)
+	// DecodeStorePair decodes a raw key and value of a module store into JSON,
+	// using the collections schema of the module. It enables clients, such as
+	// off-chain indexers, to decode state changes without module specific decoders.
+	DecodeStorePair(context.Context, *DecodeStorePairRequest) (*DecodeStorePairResponse, error)
 	mustEmbedUnimplementedReflectionServiceServer()
 }
 
@@ -65,6 +99,12 @@ type UnimplementedReflectionServiceServer struct {
 func (UnimplementedReflectionServiceServer) FileDescriptors(context.Context, *FileDescriptorsRequest) (*FileDescriptorsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FileDescriptors not implemented")
 }
+func (UnimplementedReflectionServiceServer) CollectionsSchemas(context.Context, *CollectionsSchemasRequest) (*CollectionsSchemasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CollectionsSchemas not implemented")
+}
+func (UnimplementedReflectionServiceServer) DecodeStorePair(context.Context, *DecodeStorePairRequest) (*DecodeStorePairResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DecodeStorePair not implemented")
+}
 func (UnimplementedReflectionServiceServer) mustEmbedUnimplementedReflectionServiceServer() {}
 
 // UnsafeReflectionServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -96,6 +136,42 @@ func _ReflectionService_FileDescriptors_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ReflectionService_CollectionsSchemas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CollectionsSchemasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReflectionServiceServer).CollectionsSchemas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReflectionService_CollectionsSchemas_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReflectionServiceServer).CollectionsSchemas(ctx, req.(*CollectionsSchemasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReflectionService_DecodeStorePair_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DecodeStorePairRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReflectionServiceServer).DecodeStorePair(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReflectionService_DecodeStorePair_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReflectionServiceServer).DecodeStorePair(ctx, req.(*DecodeStorePairRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ReflectionService_ServiceDesc is the grpc.ServiceDesc for ReflectionService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -107,6 +183,14 @@ var ReflectionService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "FileDescriptors",
 			Handler:    _ReflectionService_FileDescriptors_Handler,
 		},
+		{
+			MethodName: "CollectionsSchemas",
+			Handler:    _ReflectionService_CollectionsSchemas_Handler,
+		},
+		{
+			MethodName: "DecodeStorePair",
+			Handler:    _ReflectionService_DecodeStorePair_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/reflection/v1/reflection.proto",
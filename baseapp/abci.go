@@ -222,6 +222,13 @@ func (app *BaseApp) BeginBlock(req abci.RequestBeginBlock) (res abci.ResponseBeg
 		}
 	}
 
+	// the archive must not fall behind the state, so its errors halt the node
+	if app.archiveListener != nil {
+		if err := app.archiveListener.ListenBeginBlock(app.deliverState.ctx, req, res); err != nil {
+			panic(fmt.Errorf("failed to archive block %d: %w", req.Header.Height, err))
+		}
+	}
+
 	return res
 }
 
@@ -411,7 +418,13 @@ func (app *BaseApp) CheckTx(req abci.RequestCheckTx) abci.ResponseCheckTx {
 // Regardless of tx execution outcome, the ResponseDeliverTx will contain relevant
 // gas execution context.
 func (app *BaseApp) DeliverTx(req abci.RequestDeliverTx) abci.ResponseDeliverTx {
-	gInfo := sdk.GasInfo{}
+	gInfo, result, anteEvents, _, err := app.runTx(runTxModeDeliver, req.Tx)
+	return app.deliverTxResponse(req, gInfo, result, anteEvents, err)
+}
+
+// deliverTxResponse returns the response to the delivery of a transaction,
+// reporting it to the telemetry and the streaming services.
+func (app *BaseApp) deliverTxResponse(req abci.RequestDeliverTx, gInfo sdk.GasInfo, result *sdk.Result, anteEvents []abci.Event, err error) abci.ResponseDeliverTx {
 	resultStr := "successful"
 
 	var res abci.ResponseDeliverTx
@@ -433,7 +446,6 @@ func (app *BaseApp) DeliverTx(req abci.RequestDeliverTx) abci.ResponseDeliverTx
 		telemetry.SetGauge(float32(gInfo.GasWanted), "tx", "gas", "wanted")
 	}()
 
-	gInfo, result, anteEvents, _, err := app.runTx(runTxModeDeliver, req.Tx)
 	if err != nil {
 		resultStr = "failed"
 		return sdkerrors.ResponseDeliverTxWithEvents(err, gInfo.GasWanted, gInfo.GasUsed, sdk.MarkEventsToIndex(anteEvents, app.indexEvents), app.trace)
@@ -481,7 +493,7 @@ func (app *BaseApp) Commit() abci.ResponseCommit {
 
 	// call the streaming service hook with the EndBlock messages
 	abciListeners := app.streamingManager.ABCIListeners
-	if len(abciListeners) > 0 {
+	if len(abciListeners) > 0 || app.archiveListener != nil {
 		ctx := app.deliverState.ctx
 		blockHeight := ctx.BlockHeight()
 		changeSet := app.cms.PopStateCache()
@@ -490,6 +502,12 @@ func (app *BaseApp) Commit() abci.ResponseCommit {
 				app.logger.Error("Commit listening hook failed", "height", blockHeight, "err", err)
 			}
 		}
+
+		if app.archiveListener != nil {
+			if err := app.archiveListener.ListenCommit(ctx, res, changeSet); err != nil {
+				panic(fmt.Errorf("failed to archive block %d: %w", blockHeight, err))
+			}
+		}
 	}
 
 	app.logger.Info("commit synced", "commit", fmt.Sprintf("%X", commitID))
@@ -824,6 +842,7 @@ func (app *BaseApp) CreateQueryContext(height int64, prove bool) (sdk.Context, e
 	// branch the commit-multistore for safety
 	ctx := sdk.NewContext(cacheMS, app.checkState.ctx.BlockHeader(), true, app.logger).
 		WithMinGasPrices(app.minGasPrices).
+		WithStoreKVGasConfigs(app.storeKVGasConfigs).
 		WithBlockHeight(height)
 
 	if height != lastBlockHeight {
@@ -9,6 +9,7 @@ import (
 
 	dbm "github.com/cosmos/cosmos-db"
 
+	abcicli "github.com/cometbft/cometbft/abci/client"
 	abci "github.com/cometbft/cometbft/abci/types"
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	"github.com/cosmos/gogoproto/jsonpb"
@@ -739,6 +740,136 @@ func TestABCI_DeliverTx(t *testing.T) {
 	}
 }
 
+// conflictingTxsAnteOpt sets an AnteHandler incrementing the same counter for
+// every transaction, so that they conflict when executed concurrently.
+func conflictingTxsAnteOpt(t *testing.T) func(*baseapp.BaseApp) {
+	anteKey := []byte("ante-key")
+	return func(bapp *baseapp.BaseApp) {
+		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+			store := ctx.KVStore(capKey1)
+			counter := getIntFromStore(t, store, anteKey)
+			setIntOnStore(store, anteKey, counter+1)
+
+			ctx.EventManager().EmitEvents(counterEvent("ante_handler", counter))
+			return ctx.WithGasMeter(storetypes.NewGasMeter(100000)), nil
+		})
+	}
+}
+
+// keyValueTxs returns the transactions of a block writing to a few keys.
+func keyValueTxs(t *testing.T, suite *BaseAppSuite, height int64) [][]byte {
+	var txs [][]byte
+	for txNum := 0; txNum < 20; txNum++ {
+		builder := suite.txConfig.NewTxBuilder()
+		require.NoError(t, builder.SetMsgs(&baseapptestutil.MsgKeyValue{
+			Key:   []byte(fmt.Sprintf("key-%d", txNum%3)),
+			Value: []byte(fmt.Sprintf("value-%d-%d", height, txNum)),
+		}))
+		setTxSignature(t, builder, uint64(txNum))
+
+		txBytes, err := suite.txConfig.TxEncoder()(builder.GetTx())
+		require.NoError(t, err)
+		txs = append(txs, txBytes)
+	}
+	return txs
+}
+
+func TestABCI_DeliverTxs(t *testing.T) {
+	testCases := map[string]struct {
+		maxGas int64
+	}{
+		"no block gas limit": {maxGas: -1},
+		"block gas limit":    {maxGas: 20000},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			serial := NewBaseAppSuite(t, conflictingTxsAnteOpt(t))
+			concurrent := NewBaseAppSuite(t, conflictingTxsAnteOpt(t), baseapp.SetTxExecutionWorkers(4))
+
+			var appHashes [2][]byte
+			var responses [2][]abci.ResponseDeliverTx
+			for i, suite := range []*BaseAppSuite{serial, concurrent} {
+				baseapptestutil.RegisterKeyValueServer(suite.baseApp.MsgServiceRouter(), MsgKeyValueImpl{})
+				suite.baseApp.InitChain(abci.RequestInitChain{
+					ConsensusParams: &cmtproto.ConsensusParams{Block: &cmtproto.BlockParams{MaxGas: tc.maxGas}},
+				})
+
+				for height := int64(1); height <= 3; height++ {
+					suite.baseApp.BeginBlock(abci.RequestBeginBlock{Header: cmtproto.Header{Height: height}})
+
+					var reqs []abci.RequestDeliverTx
+					for _, txBytes := range keyValueTxs(t, suite, height) {
+						reqs = append(reqs, abci.RequestDeliverTx{Tx: txBytes})
+					}
+
+					responses[i] = append(responses[i], suite.baseApp.DeliverTxs(reqs)...)
+					suite.baseApp.EndBlock(abci.RequestEndBlock{})
+					appHashes[i] = suite.baseApp.Commit().Data
+				}
+			}
+
+			require.Equal(t, responses[0], responses[1])
+			require.Equal(t, appHashes[0], appHashes[1])
+		})
+	}
+}
+
+func TestABCI_LocalClient(t *testing.T) {
+	serial := NewBaseAppSuite(t, conflictingTxsAnteOpt(t))
+	concurrent := NewBaseAppSuite(t, conflictingTxsAnteOpt(t), baseapp.SetTxExecutionWorkers(4))
+
+	// the blocks are executed as CometBFT does, with the local client of
+	// CometBFT for the serial execution
+	clients := []abcicli.Client{
+		abcicli.NewLocalClient(nil, serial.baseApp),
+		baseapp.NewLocalClient(nil, concurrent.baseApp),
+	}
+
+	var appHashes [2][]byte
+	var responses [2][]*abci.ResponseDeliverTx
+	for i, suite := range []*BaseAppSuite{serial, concurrent} {
+		client := clients[i]
+		client.SetResponseCallback(func(_ *abci.Request, res *abci.Response) {
+			if deliverTx := res.GetDeliverTx(); deliverTx != nil {
+				responses[i] = append(responses[i], deliverTx)
+			}
+		})
+
+		baseapptestutil.RegisterKeyValueServer(suite.baseApp.MsgServiceRouter(), MsgKeyValueImpl{})
+		_, err := client.InitChainSync(abci.RequestInitChain{ConsensusParams: &cmtproto.ConsensusParams{}})
+		require.NoError(t, err)
+
+		for height := int64(1); height <= 3; height++ {
+			_, err := client.BeginBlockSync(abci.RequestBeginBlock{Header: cmtproto.Header{Height: height}})
+			require.NoError(t, err)
+
+			var reqRes []*abcicli.ReqRes
+			for _, txBytes := range keyValueTxs(t, suite, height) {
+				reqRes = append(reqRes, client.DeliverTxAsync(abci.RequestDeliverTx{Tx: txBytes}))
+			}
+			if suite == concurrent {
+				// the transactions are delivered together before EndBlock
+				require.Nil(t, reqRes[0].Response)
+			}
+
+			_, err = client.EndBlockSync(abci.RequestEndBlock{Height: height})
+			require.NoError(t, err)
+			for _, rr := range reqRes {
+				require.True(t, rr.Response.GetDeliverTx().IsOK())
+			}
+
+			res, err := client.CommitSync()
+			require.NoError(t, err)
+			appHashes[i] = res.Data
+		}
+	}
+
+	require.Len(t, responses[1], 60)
+	require.Equal(t, responses[0], responses[1])
+	require.Equal(t, appHashes[0], appHashes[1])
+}
+
 func TestABCI_DeliverTx_MultiMsg(t *testing.T) {
 	anteKey := []byte("ante-key")
 	anteOpt := func(bapp *baseapp.BaseApp) { bapp.SetAnteHandler(anteHandlerTxTest(t, capKey1, anteKey)) }
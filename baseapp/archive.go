@@ -0,0 +1,78 @@
+package baseapp
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"cosmossdk.io/store/archive"
+	storetypes "cosmossdk.io/store/types"
+)
+
+// Archive returns the archive store of the historical state, or nil if it is
+// not enabled.
+func (app *BaseApp) Archive() *archive.Store {
+	return app.archive
+}
+
+// registerArchiveListener feeds the archive store with the state changes of the
+// given store keys.
+func (app *BaseApp) registerArchiveListener(keys map[string]*storetypes.KVStoreKey) {
+	names := make([]string, 0, len(keys))
+	for name := range keys {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	storeKeys := exposeStoreKeysSorted(names, keys)
+	app.cms.AddListeners(storeKeys)
+	app.archiveListener = &archiveListener{app: app, keys: storeKeys}
+}
+
+// archiveListener feeds the archive store with the state changes of every
+// block. If the archive is empty while the app already has a state, it is
+// seeded with the full state of the stores at the first streamed block.
+//
+// Unlike the streaming listeners, it is not registered in the streaming manager:
+// its errors are fatal, since the archive must not silently fall behind the
+// state of the app.
+type archiveListener struct {
+	app  *BaseApp
+	keys []storetypes.StoreKey
+	seed bool
+}
+
+func (l *archiveListener) ListenBeginBlock(ctx context.Context, req abci.RequestBeginBlock, res abci.ResponseBeginBlock) error {
+	lastHeight := l.app.LastBlockHeight()
+	_, latest := l.app.archive.Versions()
+	if latest != 0 && latest != lastHeight {
+		return fmt.Errorf("the latest archived version %d does not match the last block height %d", latest, lastHeight)
+	}
+
+	l.seed = latest == 0 && lastHeight > 0
+	return l.app.archive.ListenBeginBlock(ctx, req, res)
+}
+
+func (l *archiveListener) ListenCommit(ctx context.Context, res abci.ResponseCommit, changeSet []*storetypes.StoreKVPair) error {
+	if !l.seed {
+		return l.app.archive.ListenCommit(ctx, res, changeSet)
+	}
+
+	// the state committed by the block is imported as a whole, so its changes
+	// are already included
+	l.seed = false
+	height := l.app.archive.Height()
+	l.app.logger.Info("seeding archive store", "height", height)
+	for _, key := range l.keys {
+		store := l.app.cms.GetCommitKVStore(key)
+		if store == nil {
+			continue
+		}
+		if err := l.app.archive.Import(height, key.Name(), store.Iterator(nil, nil)); err != nil {
+			return err
+		}
+	}
+	return l.app.archive.Commit(height, nil)
+}
@@ -8,8 +8,10 @@ import (
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/log"
 	"cosmossdk.io/store"
+	"cosmossdk.io/store/archive"
 	storemetrics "cosmossdk.io/store/metrics"
 	"cosmossdk.io/store/snapshots"
+	"cosmossdk.io/store/streaming/channel"
 	storetypes "cosmossdk.io/store/types"
 	"github.com/cockroachdb/errors"
 	abci "github.com/cometbft/cometbft/abci/types"
@@ -143,6 +145,27 @@ type BaseApp struct {
 	// streamingManager for managing instances and configuration of ABCIListener services
 	streamingManager storetypes.StreamingManager
 
+	// streamingChannelListener is the in-process channel listener registered
+	// from the streaming configuration, if enabled
+	streamingChannelListener *channel.Listener
+
+	// archive is the archive store of the historical state, used by queries at
+	// heights that have been pruned from the multistore, if enabled
+	archive *archive.Store
+
+	// archiveListener feeds the archive store with the state changes of every
+	// block, apart from the streaming listeners so that its errors are fatal
+	archiveListener *archiveListener
+
+	// storeKVGasConfigs holds the gas configurations of the KVStores, by store
+	// key name, which differ from the default one
+	storeKVGasConfigs map[string]storetypes.GasConfig
+
+	// txExecutionWorkers is the number of workers executing the transactions
+	// of a block concurrently in DeliverTxs, they are executed one after
+	// another if it is zero
+	txExecutionWorkers int
+
 	chainID string
 }
 
@@ -213,6 +236,16 @@ func (app *BaseApp) Logger() log.Logger {
 	return app.logger
 }
 
+// Mempool returns the application's mempool.
+func (app *BaseApp) Mempool() mempool.Mempool {
+	return app.mempool
+}
+
+// TxEncoder returns the application's transaction encoder.
+func (app *BaseApp) TxEncoder() sdk.TxEncoder {
+	return app.txEncoder
+}
+
 // Trace returns the boolean value for logging error stack traces.
 func (app *BaseApp) Trace() bool {
 	return app.trace
@@ -246,6 +279,9 @@ func (app *BaseApp) MountStores(keys ...storetypes.StoreKey) {
 		case *storetypes.MemoryStoreKey:
 			app.MountStore(key, storetypes.StoreTypeMemory)
 
+		case *storetypes.ObjectStoreKey:
+			app.MountStore(key, storetypes.StoreTypeObject)
+
 		default:
 			panic(fmt.Sprintf("Unrecognized store key type :%T", key))
 		}
@@ -285,6 +321,16 @@ func (app *BaseApp) MountMemoryStores(keys map[string]*storetypes.MemoryStoreKey
 	}
 }
 
+// MountObjectStores mounts all object stores to the provided keys in the
+// BaseApp multistore.
+func (app *BaseApp) MountObjectStores(keys map[string]*storetypes.ObjectStoreKey) {
+	skeys := maps.Keys(keys)
+	sort.Strings(skeys)
+	for _, key := range skeys {
+		app.MountStore(keys[key], storetypes.StoreTypeObject)
+	}
+}
+
 // MountStore mounts a store to the provided key in the BaseApp multistore,
 // using the default DB.
 func (app *BaseApp) MountStore(key storetypes.StoreKey, typ storetypes.StoreType) {
@@ -320,6 +366,16 @@ func (app *BaseApp) SnapshotManager() *snapshots.Manager {
 	return app.snapshotManager
 }
 
+// Close is called in start cmd to gracefully cleanup resources, it closes the
+// archive store if it is enabled.
+func (app *BaseApp) Close() error {
+	if app.archive != nil {
+		return app.archive.Close()
+	}
+
+	return nil
+}
+
 // LoadVersion loads the BaseApp application version. It will panic if called
 // more than once on a running baseapp.
 func (app *BaseApp) LoadVersion(version int64) error {
@@ -408,8 +464,10 @@ func (app *BaseApp) IsSealed() bool { return app.sealed }
 func (app *BaseApp) setState(mode runTxMode, header cmtproto.Header) {
 	ms := app.cms.CacheMultiStore()
 	baseState := &state{
-		ms:  ms,
-		ctx: sdk.NewContext(ms, header, false, app.logger).WithStreamingManager(app.streamingManager),
+		ms: ms,
+		ctx: sdk.NewContext(ms, header, false, app.logger).
+			WithStreamingManager(app.streamingManager).
+			WithStoreKVGasConfigs(app.storeKVGasConfigs),
 	}
 
 	switch mode {
@@ -613,12 +671,18 @@ func (app *BaseApp) cacheTxContext(ctx sdk.Context, txBytes []byte) (sdk.Context
 // returned if the tx does not run out of gas and if all the messages are valid
 // and execute successfully. An error is returned otherwise.
 func (app *BaseApp) runTx(mode runTxMode, txBytes []byte) (gInfo sdk.GasInfo, result *sdk.Result, anteEvents []abci.Event, priority int64, err error) {
+	return app.runTxOnContext(mode, app.getContextForTx(mode, txBytes), txBytes, true)
+}
+
+// runTxOnContext processes a transaction on the given context, see runTx. The
+// transaction is removed from the mempool in DeliverTx if removeFromMempool is
+// set, the concurrent executions of a block leave it to the caller.
+func (app *BaseApp) runTxOnContext(mode runTxMode, ctx sdk.Context, txBytes []byte, removeFromMempool bool) (gInfo sdk.GasInfo, result *sdk.Result, anteEvents []abci.Event, priority int64, err error) {
 	// NOTE: GasWanted should be returned by the AnteHandler. GasUsed is
 	// determined by the GasMeter. We need access to the context to get the gas
 	// meter, so we initialize upfront.
 	var gasWanted uint64
 
-	ctx := app.getContextForTx(mode, txBytes)
 	ms := ctx.MultiStore()
 
 	// only run the tx if there is block gas remaining
@@ -715,7 +779,7 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte) (gInfo sdk.GasInfo, re
 		if err != nil {
 			return gInfo, nil, anteEvents, priority, err
 		}
-	} else if mode == runTxModeDeliver {
+	} else if mode == runTxModeDeliver && removeFromMempool {
 		err = app.mempool.Remove(tx)
 		if err != nil && !errors.Is(err, mempool.ErrTxNotFound) {
 			return gInfo, nil, anteEvents, priority,
@@ -26,6 +26,7 @@ type MessageRouter interface {
 type MsgServiceRouter struct {
 	interfaceRegistry codectypes.InterfaceRegistry
 	routes            map[string]MsgServiceHandler
+	circuitBreaker    CircuitBreaker
 }
 
 var _ gogogrpc.Server = &MsgServiceRouter{}
@@ -45,6 +46,13 @@ func (msr *MsgServiceRouter) Handler(msg sdk.Msg) MsgServiceHandler {
 	return msr.routes[sdk.MsgTypeURL(msg)]
 }
 
+// SetCircuit sets the circuit breaker checked before routing every message, so
+// that disabled messages are rejected whether they come from a transaction or
+// are dispatched by a module, e.g. by x/authz, x/group or x/gov.
+func (msr *MsgServiceRouter) SetCircuit(cb CircuitBreaker) {
+	msr.circuitBreaker = cb
+}
+
 // HandlerByTypeURL returns the MsgServiceHandler for a given query route path or nil
 // if not found.
 func (msr *MsgServiceRouter) HandlerByTypeURL(typeURL string) MsgServiceHandler {
@@ -122,6 +130,17 @@ func (msr *MsgServiceRouter) RegisterService(sd *grpc.ServiceDesc, handler inter
 				return handler(goCtx, msg)
 			}
 
+			if msr.circuitBreaker != nil {
+				isAllowed, err := msr.circuitBreaker.IsAllowed(ctx, requestTypeName)
+				if err != nil {
+					return nil, err
+				}
+
+				if !isAllowed {
+					return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "circuit breaker disables execution of this message: %s", requestTypeName)
+				}
+			}
+
 			if m, ok := msg.(sdk.HasValidateBasic); ok {
 				if err := m.ValidateBasic(); err != nil {
 					return nil, err
@@ -12,11 +12,14 @@ import (
 	"cosmossdk.io/depinject"
 	"cosmossdk.io/log"
 
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
 	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
@@ -160,3 +163,35 @@ func TestMsgService(t *testing.T) {
 	res := app.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
 	require.Equal(t, abci.CodeTypeOK, res.Code, "res=%+v", res)
 }
+
+type mockCircuitBreaker struct {
+	disabled map[string]bool
+}
+
+func (cb mockCircuitBreaker) IsAllowed(_ context.Context, typeURL string) (bool, error) {
+	return !cb.disabled[typeURL], nil
+}
+
+func TestMsgServiceCircuitBreaker(t *testing.T) {
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	testdata.RegisterInterfaces(interfaceRegistry)
+
+	router := baseapp.NewMsgServiceRouter()
+	router.SetInterfaceRegistry(interfaceRegistry)
+	testdata.RegisterMsgServer(router, testdata.MsgServerImpl{})
+
+	msg := &testdata.MsgCreateDog{Dog: &testdata.Dog{Name: "Spot"}}
+	cb := mockCircuitBreaker{disabled: map[string]bool{}}
+	router.SetCircuit(cb)
+
+	ctx := sdk.Context{}.WithContext(context.Background())
+	_, err := router.Handler(msg)(ctx, msg)
+	require.NoError(t, err)
+
+	// a disabled message is rejected by every route, whoever dispatches it
+	cb.disabled[sdk.MsgTypeURL(msg)] = true
+	_, err = router.Handler(msg)(ctx, msg)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = router.HandlerByTypeURL(sdk.MsgTypeURL(msg))(ctx, msg)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+}
@@ -4,8 +4,10 @@ import (
 	"fmt"
 	"io"
 
+	"cosmossdk.io/store/archive"
 	"cosmossdk.io/store/metrics"
 	pruningtypes "cosmossdk.io/store/pruning/types"
+	"cosmossdk.io/store/rootmulti"
 	"cosmossdk.io/store/snapshots"
 	snapshottypes "cosmossdk.io/store/snapshots/types"
 	storetypes "cosmossdk.io/store/types"
@@ -87,6 +89,22 @@ func SetSnapshot(snapshotStore *snapshots.Store, opts snapshottypes.SnapshotOpti
 	return func(app *BaseApp) { app.SetSnapshot(snapshotStore, opts) }
 }
 
+// SetArchive sets the archive store of the historical state.
+func SetArchive(archiveStore *archive.Store) func(*BaseApp) {
+	return func(app *BaseApp) { app.SetArchive(archiveStore) }
+}
+
+// SetStoreKVGasConfig sets the gas configuration of the KVStore of a store key.
+func SetStoreKVGasConfig(key storetypes.StoreKey, gasConfig storetypes.GasConfig) func(*BaseApp) {
+	return func(app *BaseApp) { app.SetStoreKVGasConfig(key, gasConfig) }
+}
+
+// SetTxExecutionWorkers sets the number of workers executing the transactions
+// of a block concurrently in DeliverTxs.
+func SetTxExecutionWorkers(workers int) func(*BaseApp) {
+	return func(app *BaseApp) { app.SetTxExecutionWorkers(workers) }
+}
+
 // SetMempool sets the mempool on BaseApp.
 func SetMempool(mempool mempool.Mempool) func(*BaseApp) {
 	return func(app *BaseApp) { app.SetMempool(mempool) }
@@ -251,6 +269,47 @@ func (app *BaseApp) SetSnapshot(snapshotStore *snapshots.Store, opts snapshottyp
 	app.snapshotManager = snapshots.NewManager(snapshotStore, opts, app.cms, nil, app.logger)
 }
 
+// SetArchive sets the archive store of the historical state. Queries fall back
+// to it for the heights pruned from the multistore, and it is fed with the state
+// changes of the store keys given to RegisterStreamingServices.
+func (app *BaseApp) SetArchive(archiveStore *archive.Store) {
+	if app.sealed {
+		panic("SetArchive() on sealed BaseApp")
+	}
+
+	app.archive = archiveStore
+	if rms, ok := app.cms.(*rootmulti.Store); ok {
+		rms.SetArchive(archiveStore)
+	}
+}
+
+// SetStoreKVGasConfig sets the gas configuration of the KVStore of a store key,
+// so that the accesses to the store cost less or more gas than the accesses to
+// the other stores, which use storetypes.KVGasConfig.
+func (app *BaseApp) SetStoreKVGasConfig(key storetypes.StoreKey, gasConfig storetypes.GasConfig) {
+	if app.sealed {
+		panic("SetStoreKVGasConfig() on sealed BaseApp")
+	}
+
+	if app.storeKVGasConfigs == nil {
+		app.storeKVGasConfigs = make(map[string]storetypes.GasConfig)
+	}
+	app.storeKVGasConfigs[key.Name()] = gasConfig
+}
+
+// SetTxExecutionWorkers sets the number of workers executing the transactions
+// of a block concurrently in DeliverTxs, zero disables the concurrent execution.
+func (app *BaseApp) SetTxExecutionWorkers(workers int) {
+	if app.sealed {
+		panic("SetTxExecutionWorkers() on sealed BaseApp")
+	}
+	if workers < 0 {
+		panic(fmt.Sprintf("invalid number of tx execution workers: %d", workers))
+	}
+
+	app.txExecutionWorkers = workers
+}
+
 // SetInterfaceRegistry sets the InterfaceRegistry.
 func (app *BaseApp) SetInterfaceRegistry(registry types.InterfaceRegistry) {
 	app.interfaceRegistry = registry
@@ -258,6 +317,16 @@ func (app *BaseApp) SetInterfaceRegistry(registry types.InterfaceRegistry) {
 	app.msgServiceRouter.SetInterfaceRegistry(registry)
 }
 
+// SetCircuitBreaker sets the circuit breaker checked by the MsgServiceRouter
+// before routing every message.
+func (app *BaseApp) SetCircuitBreaker(cb CircuitBreaker) {
+	if app.msgServiceRouter == nil {
+		panic("cannot set circuit breaker with no msg service router set")
+	}
+
+	app.msgServiceRouter.SetCircuit(cb)
+}
+
 // SetTxDecoder sets the TxDecoder if it wasn't provided in the BaseApp constructor.
 func (app *BaseApp) SetTxDecoder(txDecoder sdk.TxDecoder) {
 	app.txDecoder = txDecoder
@@ -0,0 +1,229 @@
+package baseapp
+
+import (
+	"errors"
+
+	abcicli "github.com/cometbft/cometbft/abci/client"
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtsync "github.com/cometbft/cometbft/libs/sync"
+
+	"cosmossdk.io/store/blockstm"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+)
+
+// txResult is the outcome of the latest execution of a transaction of a block
+// executed concurrently.
+type txResult struct {
+	gInfo      sdk.GasInfo
+	result     *sdk.Result
+	anteEvents []abci.Event
+	err        error
+	// gas is the gas the transaction consumed from the gas meter of the block
+	// context, before the AnteHandler set its own.
+	gas uint64
+	// blockGas is the gas the transaction consumed from the block gas meter.
+	blockGas uint64
+}
+
+// DeliverTxs delivers the transactions of a block and returns their responses,
+// in order. If SetTxExecutionWorkers enabled it, the transactions are executed
+// concurrently on multi-version stores, they are executed again whenever they
+// conflict and their results are committed in the order of the block, so that
+// the outcome is the one of calling DeliverTx on each of them in turn.
+//
+// A transaction accessing an object store, exceeding the block gas limit or
+// not given a gas meter by the AnteHandler is delivered again with DeliverTx
+// along with the following ones. The transactions executed concurrently are
+// removed from the mempool whatever their outcome.
+func (app *BaseApp) DeliverTxs(reqs []abci.RequestDeliverTx) []abci.ResponseDeliverTx {
+	if app.txExecutionWorkers == 0 || len(reqs) < 2 || app.deliverState.ms.TracingEnabled() {
+		return app.deliverTxsSerially(reqs)
+	}
+
+	ctx := app.deliverState.ctx
+	results := make([]txResult, len(reqs))
+	writeSets := blockstm.ExecuteBlock(len(reqs), app.deliverState.ms, app.txExecutionWorkers, func(txIndex int, ms storetypes.MultiStore) {
+		// the meters of the block are not shared between the executions, their
+		// gas is consumed when the results are committed
+		gasMeter, blockGasMeter := storetypes.NewInfiniteGasMeter(), storetypes.NewInfiniteGasMeter()
+		txCtx := ctx.
+			WithTxBytes(reqs[txIndex].Tx).
+			WithMultiStore(ms).
+			WithGasMeter(gasMeter).
+			WithBlockGasMeter(blockGasMeter).
+			WithEventManager(sdk.NewEventManager())
+		txCtx = txCtx.WithConsensusParams(app.GetConsensusParams(txCtx))
+
+		var r txResult
+		r.gInfo, r.result, r.anteEvents, _, r.err = app.runTxOnContext(runTxModeDeliver, txCtx, reqs[txIndex].Tx, false)
+		r.gas, r.blockGas = gasMeter.GasConsumed(), blockGasMeter.GasConsumed()
+		results[txIndex] = r
+	})
+
+	gasMeter, blockGasMeter := ctx.GasMeter(), ctx.BlockGasMeter()
+	responses := make([]abci.ResponseDeliverTx, 0, len(reqs))
+	for i, ws := range writeSets {
+		r := results[i]
+		// The gas used by a transaction whose AnteHandler did not set a gas
+		// meter includes the gas consumed by the previous ones.
+		sharedGasMeter := r.gInfo.GasUsed == r.gas && gasMeter.GasConsumed() > 0
+		if ws.Sequential() || sharedGasMeter ||
+			blockGasMeter.IsOutOfGas() || r.blockGas > blockGasMeter.Limit()-blockGasMeter.GasConsumed() {
+			return append(responses, app.deliverTxsSerially(reqs[i:])...)
+		}
+
+		ws.Write(app.deliverState.ms)
+		gasMeter.ConsumeGas(r.gas, "tx gas meter")
+		blockGasMeter.ConsumeGas(r.blockGas, "block gas meter")
+		app.removeFromMempool(reqs[i].Tx)
+
+		responses = append(responses, app.deliverTxResponse(reqs[i], r.gInfo, r.result, r.anteEvents, r.err))
+	}
+
+	return responses
+}
+
+func (app *BaseApp) deliverTxsSerially(reqs []abci.RequestDeliverTx) []abci.ResponseDeliverTx {
+	responses := make([]abci.ResponseDeliverTx, len(reqs))
+	for i, req := range reqs {
+		responses[i] = app.DeliverTx(req)
+	}
+	return responses
+}
+
+// removeFromMempool removes a delivered transaction from the mempool.
+func (app *BaseApp) removeFromMempool(txBytes []byte) {
+	tx, err := app.txDecoder(txBytes)
+	if err != nil {
+		return
+	}
+
+	if err := app.mempool.Remove(tx); err != nil && !errors.Is(err, mempool.ErrTxNotFound) {
+		app.logger.Error("failed to remove tx from mempool", "err", err)
+	}
+}
+
+// BlockApplication is an ABCI application able to deliver the transactions of
+// a block together, as BaseApp does with DeliverTxs.
+type BlockApplication interface {
+	abci.Application
+
+	DeliverTxs(reqs []abci.RequestDeliverTx) []abci.ResponseDeliverTx
+}
+
+var _ BlockApplication = (*BaseApp)(nil)
+
+// localClient is an ABCI client calling the application in-process, as the
+// local client of CometBFT does, except that the DeliverTx requests of a block
+// are buffered until EndBlock, Commit or a flush, and then delivered together
+// with DeliverTxs.
+type localClient struct {
+	abcicli.Client
+
+	mtx *cmtsync.Mutex
+	app BlockApplication
+	cb  abcicli.Callback
+
+	reqs   []abci.RequestDeliverTx
+	reqRes []*abcicli.ReqRes
+}
+
+var _ abcicli.Client = (*localClient)(nil)
+
+// NewLocalClient returns an ABCI client calling the given application
+// in-process, so that SetTxExecutionWorkers can execute the transactions of the
+// blocks concurrently. The DeliverTx requests are buffered and delivered
+// together before EndBlock, their responses are then passed to the callbacks in
+// the order of the requests. As for the local client of CometBFT, all the
+// clients of an application must share the same mutex.
+func NewLocalClient(mtx *cmtsync.Mutex, app BlockApplication) abcicli.Client {
+	if mtx == nil {
+		mtx = new(cmtsync.Mutex)
+	}
+
+	return &localClient{
+		Client: abcicli.NewLocalClient(mtx, app),
+		mtx:    mtx,
+		app:    app,
+	}
+}
+
+func (c *localClient) SetResponseCallback(cb abcicli.Callback) {
+	c.Client.SetResponseCallback(cb)
+
+	c.mtx.Lock()
+	c.cb = cb
+	c.mtx.Unlock()
+}
+
+func (c *localClient) DeliverTxAsync(req abci.RequestDeliverTx) *abcicli.ReqRes {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	reqRes := abcicli.NewReqRes(abci.ToRequestDeliverTx(req))
+	c.reqs = append(c.reqs, req)
+	c.reqRes = append(c.reqRes, reqRes)
+	return reqRes
+}
+
+func (c *localClient) DeliverTxSync(req abci.RequestDeliverTx) (*abci.ResponseDeliverTx, error) {
+	reqRes := c.DeliverTxAsync(req)
+	c.deliverTxs()
+	return reqRes.Response.GetDeliverTx(), nil
+}
+
+func (c *localClient) FlushAsync() *abcicli.ReqRes {
+	c.deliverTxs()
+	return c.Client.FlushAsync()
+}
+
+func (c *localClient) FlushSync() error {
+	c.deliverTxs()
+	return c.Client.FlushSync()
+}
+
+func (c *localClient) EndBlockAsync(req abci.RequestEndBlock) *abcicli.ReqRes {
+	c.deliverTxs()
+	return c.Client.EndBlockAsync(req)
+}
+
+func (c *localClient) EndBlockSync(req abci.RequestEndBlock) (*abci.ResponseEndBlock, error) {
+	c.deliverTxs()
+	return c.Client.EndBlockSync(req)
+}
+
+func (c *localClient) CommitAsync() *abcicli.ReqRes {
+	c.deliverTxs()
+	return c.Client.CommitAsync()
+}
+
+func (c *localClient) CommitSync() (*abci.ResponseCommit, error) {
+	c.deliverTxs()
+	return c.Client.CommitSync()
+}
+
+// deliverTxs delivers the buffered DeliverTx requests and completes their
+// ReqRes in order.
+func (c *localClient) deliverTxs() {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	if len(c.reqs) == 0 {
+		return
+	}
+
+	responses := c.app.DeliverTxs(c.reqs)
+	for i, reqRes := range c.reqRes {
+		reqRes.Response = abci.ToResponseDeliverTx(responses[i])
+		if c.cb != nil {
+			c.cb(reqRes.Request, reqRes.Response)
+		}
+		reqRes.Done()
+		reqRes.InvokeCallback()
+	}
+
+	c.reqs, c.reqRes = nil, nil
+}
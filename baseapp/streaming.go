@@ -1,13 +1,19 @@
 package baseapp
 
 import (
+	"context"
 	"fmt"
+	"path/filepath"
 	"sort"
 	"strings"
 
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/spf13/cast"
+
 	"cosmossdk.io/store/streaming"
+	"cosmossdk.io/store/streaming/channel"
+	"cosmossdk.io/store/streaming/file"
 	storetypes "cosmossdk.io/store/types"
-	"github.com/spf13/cast"
 
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
@@ -19,6 +25,15 @@ const (
 	StreamingABCIPluginTomlKey        = "plugin"
 	StreamingABCIKeysTomlKey          = "keys"
 	StreamingABCIStopNodeOnErrTomlKey = "stop-node-on-err"
+
+	StreamingFileTomlKey              = "file"
+	StreamingFileWriteDirTomlKey      = "write-dir"
+	StreamingFilePrefixTomlKey        = "prefix"
+	StreamingFileMaxFileSizeTomlKey   = "max-file-size"
+	StreamingFileFsyncTomlKey         = "fsync"
+	StreamingChannelTomlKey           = "channel"
+	StreamingChannelBufferSizeTomlKey = "buffer-size"
+	StreamingChannelBlockingTomlKey   = "blocking"
 )
 
 // RegisterStreamingServices registers streaming services with the BaseApp.
@@ -40,9 +55,25 @@ func (app *BaseApp) RegisterStreamingServices(appOpts servertypes.AppOptions, ke
 		}
 	}
 
+	if err := app.registerFileListener(appOpts, keys); err != nil {
+		return fmt.Errorf("failed to register file streaming listener: %w", err)
+	}
+
+	app.registerChannelListener(appOpts, keys)
+
+	if app.archive != nil {
+		app.registerArchiveListener(keys)
+	}
+
 	return nil
 }
 
+// StreamingChannelListener returns the in-process channel listener registered
+// from the streaming.channel configuration, or nil if it is not enabled.
+func (app *BaseApp) StreamingChannelListener() *channel.Listener {
+	return app.streamingChannelListener
+}
+
 // registerStreamingPlugin registers streaming plugins with the BaseApp.
 func (app *BaseApp) registerStreamingPlugin(
 	appOpts servertypes.AppOptions,
@@ -65,17 +96,106 @@ func (app *BaseApp) registerABCIListenerPlugin(
 	abciListener storetypes.ABCIListener,
 ) {
 	stopNodeOnErrKey := fmt.Sprintf("%s.%s.%s", StreamingTomlKey, StreamingABCITomlKey, StreamingABCIStopNodeOnErrTomlKey)
-	stopNodeOnErr := cast.ToBool(appOpts.Get(stopNodeOnErrKey))
-	keysKey := fmt.Sprintf("%s.%s.%s", StreamingTomlKey, StreamingABCITomlKey, StreamingABCIKeysTomlKey)
-	exposeKeysStr := cast.ToStringSlice(appOpts.Get(keysKey))
+	app.streamingManager.StopNodeOnErr = cast.ToBool(appOpts.Get(stopNodeOnErrKey))
+	app.addABCIListener(streamingKeys(appOpts, StreamingABCITomlKey), keys, abciListener)
+}
+
+// registerFileListener registers the file listener if a write directory is
+// configured. A relative write directory is relative to the node home.
+func (app *BaseApp) registerFileListener(appOpts servertypes.AppOptions, keys map[string]*storetypes.KVStoreKey) error {
+	dir := strings.TrimSpace(cast.ToString(appOpts.Get(streamingTomlKey(StreamingFileTomlKey, StreamingFileWriteDirTomlKey))))
+	if len(dir) == 0 {
+		return nil
+	}
+
+	if !filepath.IsAbs(dir) {
+		dir = filepath.Join(cast.ToString(appOpts.Get(flags.FlagHome)), dir)
+	}
+
+	listener, err := file.NewListener(file.Options{
+		Dir:           dir,
+		Prefix:        cast.ToString(appOpts.Get(streamingTomlKey(StreamingFileTomlKey, StreamingFilePrefixTomlKey))),
+		MaxFileSize:   cast.ToInt64(appOpts.Get(streamingTomlKey(StreamingFileTomlKey, StreamingFileMaxFileSizeTomlKey))),
+		Fsync:         cast.ToBool(appOpts.Get(streamingTomlKey(StreamingFileTomlKey, StreamingFileFsyncTomlKey))),
+		StopNodeOnErr: cast.ToBool(appOpts.Get(streamingTomlKey(StreamingFileTomlKey, StreamingABCIStopNodeOnErrTomlKey))),
+	})
+	if err != nil {
+		return err
+	}
+
+	app.addABCIListener(streamingKeys(appOpts, StreamingFileTomlKey), keys, listener)
+	return nil
+}
+
+// registerChannelListener registers the channel listener if store keys are
+// configured for it.
+func (app *BaseApp) registerChannelListener(appOpts servertypes.AppOptions, keys map[string]*storetypes.KVStoreKey) {
+	exposeKeysStr := streamingKeys(appOpts, StreamingChannelTomlKey)
+	if len(exposeKeysStr) == 0 {
+		return
+	}
+
+	listener := channel.NewListener(channel.Options{
+		BufferSize:    cast.ToInt(appOpts.Get(streamingTomlKey(StreamingChannelTomlKey, StreamingChannelBufferSizeTomlKey))),
+		Blocking:      cast.ToBool(appOpts.Get(streamingTomlKey(StreamingChannelTomlKey, StreamingChannelBlockingTomlKey))),
+		StopNodeOnErr: cast.ToBool(appOpts.Get(streamingTomlKey(StreamingChannelTomlKey, StreamingABCIStopNodeOnErrTomlKey))),
+	})
+
+	app.streamingChannelListener = listener
+	app.addABCIListener(exposeKeysStr, keys, listener)
+}
+
+// addABCIListener adds the listener to the streaming manager, streaming the
+// state changes of the given store keys only.
+func (app *BaseApp) addABCIListener(exposeKeysStr []string, keys map[string]*storetypes.KVStoreKey, abciListener storetypes.ABCIListener) {
 	exposedKeys := exposeStoreKeysSorted(exposeKeysStr, keys)
 	app.cms.AddListeners(exposedKeys)
-	app.SetStreamingManager(
-		storetypes.StreamingManager{
-			ABCIListeners: []storetypes.ABCIListener{abciListener},
-			StopNodeOnErr: stopNodeOnErr,
-		},
-	)
+
+	// the commit multistore streams the state changes of the store keys of all
+	// the listeners, so they are filtered for each listener
+	if !exposeAll(exposeKeysStr) {
+		abciListener = newStoreKeysFilterListener(abciListener, exposedKeys)
+	}
+
+	app.streamingManager.ABCIListeners = append(app.streamingManager.ABCIListeners, abciListener)
+}
+
+func streamingTomlKey(service, key string) string {
+	return fmt.Sprintf("%s.%s.%s", StreamingTomlKey, service, key)
+}
+
+func streamingKeys(appOpts servertypes.AppOptions, service string) []string {
+	return cast.ToStringSlice(appOpts.Get(streamingTomlKey(service, StreamingABCIKeysTomlKey)))
+}
+
+// storeKeysFilterListener wraps an ABCIListener to only stream the state
+// changes of a set of store keys.
+type storeKeysFilterListener struct {
+	storetypes.ABCIListener
+	storeKeys map[string]struct{}
+}
+
+func newStoreKeysFilterListener(abciListener storetypes.ABCIListener, storeKeys []storetypes.StoreKey) storeKeysFilterListener {
+	l := storeKeysFilterListener{
+		ABCIListener: abciListener,
+		storeKeys:    make(map[string]struct{}, len(storeKeys)),
+	}
+	for _, storeKey := range storeKeys {
+		l.storeKeys[storeKey.Name()] = struct{}{}
+	}
+
+	return l
+}
+
+func (l storeKeysFilterListener) ListenCommit(ctx context.Context, res abci.ResponseCommit, changeSet []*storetypes.StoreKVPair) error {
+	filtered := make([]*storetypes.StoreKVPair, 0, len(changeSet))
+	for _, pair := range changeSet {
+		if _, ok := l.storeKeys[pair.StoreKey]; ok {
+			filtered = append(filtered, pair)
+		}
+	}
+
+	return l.ABCIListener.ListenCommit(ctx, res, filtered)
 }
 
 func exposeAll(list []string) bool {
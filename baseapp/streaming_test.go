@@ -7,11 +7,17 @@ import (
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
 	"github.com/stretchr/testify/require"
 
+	"cosmossdk.io/store/archive"
+	streamingabci "cosmossdk.io/store/streaming/abci"
+	"cosmossdk.io/store/streaming/file"
 	storetypes "cosmossdk.io/store/types"
+
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	baseapptestutil "github.com/cosmos/cosmos-sdk/baseapp/testutil"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -145,3 +151,122 @@ func Test_Ctx_with_StreamingManager(t *testing.T) {
 		suite.baseApp.Commit()
 	}
 }
+
+func TestRegisterStreamingServices_FileAndChannel(t *testing.T) {
+	distKey2 := storetypes.NewKVStoreKey("distKey2")
+	writeDir := t.TempDir()
+	appOpts := simtestutil.AppOptionsMap{
+		"streaming.file.write-dir":      writeDir,
+		"streaming.file.keys":           []string{distKey1.Name()},
+		"streaming.channel.keys":        []string{"*"},
+		"streaming.channel.buffer-size": 100,
+	}
+
+	registerOpt := func(bapp *baseapp.BaseApp) {
+		bapp.MountStores(distKey1, distKey2)
+		keys := map[string]*storetypes.KVStoreKey{distKey1.Name(): distKey1, distKey2.Name(): distKey2}
+		require.NoError(t, bapp.RegisterStreamingServices(appOpts, keys))
+	}
+	suite := NewBaseAppSuite(t, registerOpt)
+	require.NotNil(t, suite.baseApp.StreamingChannelListener())
+
+	suite.baseApp.InitChain(abci.RequestInitChain{
+		ConsensusParams: &tmproto.ConsensusParams{},
+	})
+
+	suite.baseApp.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: 1}})
+	ctx := getDeliverStateCtx(suite.baseApp)
+	ctx.KVStore(distKey1).Set([]byte("key1"), []byte("value1"))
+	ctx.KVStore(distKey2).Set([]byte("key2"), []byte("value2"))
+	suite.baseApp.EndBlock(abci.RequestEndBlock{Height: 1})
+	suite.baseApp.Commit()
+
+	// the file listener only streams the changes of its store keys
+	var fileChangeSet []*storetypes.StoreKVPair
+	require.NoError(t, file.Replay(writeDir, "", func(record streamingabci.Record) error {
+		if record.Commit != nil {
+			fileChangeSet = record.Commit.ChangeSet
+		}
+		return nil
+	}))
+	require.Len(t, fileChangeSet, 1)
+	require.Equal(t, distKey1.Name(), fileChangeSet[0].StoreKey)
+
+	// the channel listener streams the changes of all the store keys
+	records := suite.baseApp.StreamingChannelListener().Records()
+	require.Len(t, records, 3)
+	require.NotNil(t, (<-records).BeginBlock)
+	require.NotNil(t, (<-records).EndBlock)
+	commit := (<-records).Commit
+	require.NotNil(t, commit)
+	require.Len(t, commit.ChangeSet, 2)
+	require.Equal(t, int64(1), commit.BlockHeight)
+}
+
+func TestRegisterStreamingServices_Archive(t *testing.T) {
+	archiveStore, err := archive.NewStore(dbm.NewMemDB())
+	require.NoError(t, err)
+
+	registerOpt := func(bapp *baseapp.BaseApp) {
+		bapp.MountStores(distKey1)
+		bapp.SetArchive(archiveStore)
+		keys := map[string]*storetypes.KVStoreKey{distKey1.Name(): distKey1}
+		require.NoError(t, bapp.RegisterStreamingServices(simtestutil.AppOptionsMap{}, keys))
+	}
+	suite := NewBaseAppSuite(t, registerOpt)
+	require.Equal(t, archiveStore, suite.baseApp.Archive())
+
+	suite.baseApp.InitChain(abci.RequestInitChain{
+		ConsensusParams: &tmproto.ConsensusParams{},
+	})
+
+	for height := int64(1); height <= 2; height++ {
+		suite.baseApp.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: height}})
+		ctx := getDeliverStateCtx(suite.baseApp)
+		ctx.KVStore(distKey1).Set([]byte("key"), []byte(fmt.Sprintf("value%d", height)))
+		suite.baseApp.EndBlock(abci.RequestEndBlock{Height: height})
+		suite.baseApp.Commit()
+	}
+
+	start, latest := archiveStore.Versions()
+	require.Equal(t, int64(1), start)
+	require.Equal(t, int64(2), latest)
+	require.Equal(t, []byte("value1"), archiveStore.KVStore(distKey1.Name(), 1).Get([]byte("key")))
+	require.Equal(t, []byte("value2"), archiveStore.KVStore(distKey1.Name(), 2).Get([]byte("key")))
+}
+
+func TestRegisterStreamingServices_ArchiveBehind(t *testing.T) {
+	archiveStore, err := archive.NewStore(dbm.NewMemDB())
+	require.NoError(t, err)
+	// the archive does not follow the state of the app
+	require.NoError(t, archiveStore.Commit(5, nil))
+
+	registerOpt := func(bapp *baseapp.BaseApp) {
+		bapp.MountStores(distKey1)
+		bapp.SetArchive(archiveStore)
+		keys := map[string]*storetypes.KVStoreKey{distKey1.Name(): distKey1}
+		require.NoError(t, bapp.RegisterStreamingServices(simtestutil.AppOptionsMap{}, keys))
+	}
+	suite := NewBaseAppSuite(t, registerOpt)
+
+	suite.baseApp.InitChain(abci.RequestInitChain{
+		ConsensusParams: &tmproto.ConsensusParams{},
+	})
+
+	require.Panics(t, func() {
+		suite.baseApp.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: 1}})
+	})
+}
+
+func TestBaseApp_CloseArchive(t *testing.T) {
+	db, err := dbm.NewGoLevelDB("archive", t.TempDir(), nil)
+	require.NoError(t, err)
+	archiveStore, err := archive.NewStore(db)
+	require.NoError(t, err)
+
+	suite := NewBaseAppSuite(t, baseapp.SetArchive(archiveStore))
+	require.NoError(t, suite.baseApp.Close())
+
+	// the database of the archive is closed
+	require.Error(t, db.Set([]byte("key"), []byte("value")))
+}
@@ -19,7 +19,8 @@ import (
 // BroadcastTx broadcasts a transactions either synchronously or asynchronously
 // based on the context parameters. The result of the broadcast is parsed into
 // an intermediate structure which is logged if the context has a logger
-// defined.
+// defined. If the context waits for the transactions, the result of an accepted
+// transaction is the result of its execution in a block, see WaitForTx.
 func (ctx Context) BroadcastTx(txBytes []byte) (res *sdk.TxResponse, err error) {
 	switch ctx.BroadcastMode {
 	case flags.BroadcastSync:
@@ -32,7 +33,11 @@ func (ctx Context) BroadcastTx(txBytes []byte) (res *sdk.TxResponse, err error)
 		return nil, fmt.Errorf("unsupported return type %s; supported types: sync, async", ctx.BroadcastMode)
 	}
 
-	return res, err
+	if err != nil || !ctx.Wait || res.Code != 0 {
+		return res, err
+	}
+
+	return ctx.WaitForTx(res.TxHash, ctx.WaitTimeout)
 }
 
 // Deprecated: Use CheckCometError instead.
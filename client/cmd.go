@@ -240,6 +240,12 @@ func readTxCommandFlags(clientCtx Context, flagSet *pflag.FlagSet) (Context, err
 		clientCtx = clientCtx.WithSkipConfirmation(skipConfirm)
 	}
 
+	if !clientCtx.Wait || flagSet.Changed(flags.FlagWait) || flagSet.Changed(flags.FlagWaitTimeout) {
+		wait, _ := flagSet.GetBool(flags.FlagWait)
+		waitTimeout, _ := flagSet.GetDuration(flags.FlagWaitTimeout)
+		clientCtx = clientCtx.WithWait(wait, waitTimeout)
+	}
+
 	if clientCtx.SignModeStr == "" || flagSet.Changed(flags.FlagSignMode) {
 		signModeStr, _ := flagSet.GetString(flags.FlagSignMode)
 		clientCtx = clientCtx.WithSignModeStr(signModeStr)
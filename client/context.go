@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"time"
 
 	"github.com/cosmos/gogoproto/proto"
 	"github.com/spf13/viper"
@@ -48,6 +49,8 @@ type Context struct {
 	GenerateOnly      bool
 	Offline           bool
 	SkipConfirm       bool
+	Wait              bool
+	WaitTimeout       time.Duration
 	TxConfig          TxConfig
 	AccountRetriever  AccountRetriever
 	NodeURI           string
@@ -237,6 +240,15 @@ func (ctx Context) WithSignModeStr(signModeStr string) Context {
 	return ctx
 }
 
+// WithWait returns a copy of the context with an updated Wait value, waiting
+// for the inclusion of the broadcast transactions in a block with the given
+// timeout.
+func (ctx Context) WithWait(wait bool, timeout time.Duration) Context {
+	ctx.Wait = wait
+	ctx.WaitTimeout = timeout
+	return ctx
+}
+
 // WithSkipConfirmation returns a copy of the context with an updated SkipConfirm
 // value.
 func (ctx Context) WithSkipConfirmation(skip bool) Context {
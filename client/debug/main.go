@@ -9,6 +9,7 @@ import (
 
 	"github.com/spf13/cobra"
 
+	"cosmossdk.io/collections"
 	errorsmod "cosmossdk.io/errors"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -29,6 +30,13 @@ var (
 
 // Cmd creates a main CLI command
 func Cmd() *cobra.Command {
+	return CmdWithCollectionsSchemas(nil)
+}
+
+// CmdWithCollectionsSchemas creates a main CLI command, whose state-diff
+// command decodes the state of the modules with the given collections schemas,
+// by store name.
+func CmdWithCollectionsSchemas(schemas map[string]collections.Schema) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "debug",
 		Short: "Tool for helping with debugging your application",
@@ -40,6 +48,7 @@ func Cmd() *cobra.Command {
 	cmd.AddCommand(AddrCmd())
 	cmd.AddCommand(RawBytesCmd())
 	cmd.AddCommand(PrefixesCmd())
+	cmd.AddCommand(StateDiffCmd(schemas))
 
 	return cmd
 }
@@ -0,0 +1,259 @@
+package debug
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"path/filepath"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/spf13/cobra"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/metrics"
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+const (
+	flagHeight       = "height"
+	flagOtherHeight  = "other-height"
+	flagAppDBBackend = "app-db-backend"
+)
+
+// StateDiffCmd returns a command comparing the application state of two
+// nodes, or of a node at two heights. The keys of the modules having a
+// collections schema, looked up by store name, are decoded with it.
+func StateDiffCmd(schemas map[string]collections.Schema) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "state-diff [home] [other-home]",
+		Short: "Compare the application state of two nodes, or of a node at two heights",
+		Long: fmt.Sprintf(`Compare the application state of two nodes, or of a node at two heights,
+to find out why their app hashes diverge. The nodes must be stopped.
+
+The commit info of both states is compared store by store, then the keys and
+values of the stores whose hashes differ are walked and the differing ones are
+printed, "-" for the first state and "+" for the second one. The keys and values
+of modules with a collections schema are decoded to JSON.
+
+With two homes, the states are compared at --height, which defaults to the latest
+height common to both nodes. With a single home, the state at --height, which
+defaults to the latest height, is compared to the state at --other-height.
+
+Example:
+$ %s debug state-diff ~/.node1 ~/.node2 --height 100
+$ %s debug state-diff ~/.node1 --height 99 --other-height 100
+			`, version.AppName, version.AppName),
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			height, err := cmd.Flags().GetInt64(flagHeight)
+			if err != nil {
+				return err
+			}
+			otherHeight, err := cmd.Flags().GetInt64(flagOtherHeight)
+			if err != nil {
+				return err
+			}
+			backend, err := cmd.Flags().GetString(flagAppDBBackend)
+			if err != nil {
+				return err
+			}
+
+			home := filepath.Clean(args[0])
+			otherHome := home
+			if len(args) == 2 {
+				otherHome = filepath.Clean(args[1])
+			}
+			if otherHome == home && otherHeight == 0 {
+				return fmt.Errorf("--%s is required to compare the state of a single node", flagOtherHeight)
+			}
+
+			db, err := openAppDB(home, backend)
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			otherDB := db
+			if otherHome != home {
+				otherDB, err = openAppDB(otherHome, backend)
+				if err != nil {
+					return err
+				}
+				defer otherDB.Close()
+			}
+
+			if height == 0 {
+				height = rootmulti.GetLatestVersion(db)
+				if latest := rootmulti.GetLatestVersion(otherDB); latest < height {
+					height = latest
+				}
+			}
+			if otherHeight == 0 {
+				otherHeight = height
+			}
+
+			state, err := loadState(db, height)
+			if err != nil {
+				return fmt.Errorf("failed to load the state of %s: %w", home, err)
+			}
+			otherState, err := loadState(otherDB, otherHeight)
+			if err != nil {
+				return fmt.Errorf("failed to load the state of %s: %w", otherHome, err)
+			}
+
+			w := cmd.OutOrStdout()
+			fmt.Fprintf(w, "- %s at height %d\n", home, height)
+			fmt.Fprintf(w, "+ %s at height %d\n", otherHome, otherHeight)
+			return diffStates(w, state, otherState, schemas)
+		},
+	}
+
+	cmd.Flags().Int64(flagHeight, 0, "The height of the compared state (default: the latest common height)")
+	cmd.Flags().Int64(flagOtherHeight, 0, "The height of the state of the other node, or of the node compared to --height (default: --height)")
+	cmd.Flags().String(flagAppDBBackend, string(dbm.GoLevelDBBackend), "The type of database of the application databases")
+
+	return cmd
+}
+
+func openAppDB(home, backend string) (dbm.DB, error) {
+	return dbm.NewDB("application", dbm.BackendType(backend), filepath.Join(home, "data"))
+}
+
+// appState is the application state committed at a height.
+type appState struct {
+	commitInfo *storetypes.CommitInfo
+	store      *rootmulti.Store
+}
+
+// loadState loads the stores of the application state committed at the given
+// height, as listed by its commit info.
+func loadState(db dbm.DB, height int64) (*appState, error) {
+	store := rootmulti.NewStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics())
+	// the databases are only read
+	store.SetIAVLDisableFastNode(true)
+
+	commitInfo, err := store.GetCommitInfo(height)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the commit info at height %d: %w", height, err)
+	}
+	for _, storeInfo := range commitInfo.StoreInfos {
+		store.MountStoreWithDB(storetypes.NewKVStoreKey(storeInfo.Name), storetypes.StoreTypeIAVL, nil)
+	}
+	if err := store.LoadVersion(height); err != nil {
+		return nil, err
+	}
+
+	return &appState{commitInfo: commitInfo, store: store}, nil
+}
+
+// diffStates writes the differences between two application states.
+func diffStates(w io.Writer, state, otherState *appState, schemas map[string]collections.Schema) error {
+	hash, otherHash := state.commitInfo.Hash(), otherState.commitInfo.Hash()
+	if bytes.Equal(hash, otherHash) {
+		fmt.Fprintf(w, "app hash %X is identical\n", hash)
+		return nil
+	}
+	fmt.Fprintf(w, "app hash %X != %X\n", hash, otherHash)
+
+	otherInfos := make(map[string]storetypes.StoreInfo, len(otherState.commitInfo.StoreInfos))
+	for _, storeInfo := range otherState.commitInfo.StoreInfos {
+		otherInfos[storeInfo.Name] = storeInfo
+	}
+
+	// store infos are sorted by name
+	for _, storeInfo := range state.commitInfo.StoreInfos {
+		otherInfo, ok := otherInfos[storeInfo.Name]
+		if !ok {
+			fmt.Fprintf(w, "store %s: only in -\n", storeInfo.Name)
+			continue
+		}
+		delete(otherInfos, storeInfo.Name)
+
+		if bytes.Equal(storeInfo.CommitId.Hash, otherInfo.CommitId.Hash) {
+			continue
+		}
+		fmt.Fprintf(w, "store %s: hash %X != %X\n", storeInfo.Name, storeInfo.CommitId.Hash, otherInfo.CommitId.Hash)
+
+		schema, hasSchema := schemas[storeInfo.Name]
+		var decode func(key, value []byte) string
+		if hasSchema {
+			decode = func(key, value []byte) string { return decodePair(schema, key, value) }
+		} else {
+			decode = func(key, value []byte) string { return fmt.Sprintf("%X: %X", key, value) }
+		}
+
+		if err := diffStores(
+			w,
+			state.store.GetStoreByName(storeInfo.Name).(storetypes.KVStore),
+			otherState.store.GetStoreByName(storeInfo.Name).(storetypes.KVStore),
+			decode,
+		); err != nil {
+			return fmt.Errorf("failed to compare store %s: %w", storeInfo.Name, err)
+		}
+	}
+
+	for _, storeInfo := range otherState.commitInfo.StoreInfos {
+		if _, ok := otherInfos[storeInfo.Name]; ok {
+			fmt.Fprintf(w, "store %s: only in +\n", storeInfo.Name)
+		}
+	}
+
+	return nil
+}
+
+// diffStores walks both stores in the order of their keys and writes the pairs
+// which differ.
+func diffStores(w io.Writer, store, otherStore storetypes.KVStore, decode func(key, value []byte) string) error {
+	it := store.Iterator(nil, nil)
+	defer it.Close()
+	otherIt := otherStore.Iterator(nil, nil)
+	defer otherIt.Close()
+
+	for it.Valid() || otherIt.Valid() {
+		cmp := 0
+		switch {
+		case !otherIt.Valid():
+			cmp = -1
+		case !it.Valid():
+			cmp = 1
+		default:
+			cmp = bytes.Compare(it.Key(), otherIt.Key())
+		}
+
+		switch {
+		case cmp < 0:
+			fmt.Fprintf(w, "  - %s\n", decode(it.Key(), it.Value()))
+			it.Next()
+		case cmp > 0:
+			fmt.Fprintf(w, "  + %s\n", decode(otherIt.Key(), otherIt.Value()))
+			otherIt.Next()
+		default:
+			if !bytes.Equal(it.Value(), otherIt.Value()) {
+				fmt.Fprintf(w, "  - %s\n", decode(it.Key(), it.Value()))
+				fmt.Fprintf(w, "  + %s\n", decode(otherIt.Key(), otherIt.Value()))
+			}
+			it.Next()
+			otherIt.Next()
+		}
+	}
+
+	if err := it.Error(); err != nil {
+		return err
+	}
+	return otherIt.Error()
+}
+
+// decodePair returns the JSON representation of a pair decoded with the
+// collections schema of its store, or its hex representation if it does not
+// belong to a collection.
+func decodePair(schema collections.Schema, key, value []byte) string {
+	pair, err := schema.DecodePair(key, value)
+	if err != nil {
+		return fmt.Sprintf("%X: %X", key, value)
+	}
+	return fmt.Sprintf("%s %s: %s", pair.Collection, pair.Key, pair.Value)
+}
@@ -0,0 +1,95 @@
+package debug
+
+import (
+	"bytes"
+	"testing"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/metrics"
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+)
+
+func commitState(t *testing.T, db dbm.DB, names []string, pairs map[string]map[string]string) {
+	t.Helper()
+	store := rootmulti.NewStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics())
+	keys := map[string]storetypes.StoreKey{}
+	for _, name := range names {
+		keys[name] = storetypes.NewKVStoreKey(name)
+		store.MountStoreWithDB(keys[name], storetypes.StoreTypeIAVL, nil)
+	}
+	require.NoError(t, store.LoadLatestVersion())
+
+	for name, kvs := range pairs {
+		kvStore := store.GetKVStore(keys[name])
+		for key, value := range kvs {
+			if value == "" {
+				kvStore.Delete([]byte(key))
+			} else {
+				kvStore.Set([]byte(key), []byte(value))
+			}
+		}
+	}
+	store.Commit()
+}
+
+func TestDiffStates(t *testing.T) {
+	db, otherDB := dbm.NewMemDB(), dbm.NewMemDB()
+	commitState(t, db, []string{"acc", "bank", "gov"}, map[string]map[string]string{
+		"acc":  {"a": "1"},
+		"bank": {"a": "1", "b": "1", "d": "1"},
+		"gov":  {"a": "1"},
+	})
+	commitState(t, otherDB, []string{"acc", "bank", "mint"}, map[string]map[string]string{
+		"acc":  {"a": "1"},
+		"bank": {"a": "1", "b": "2", "c": "2"},
+		"mint": {"a": "1"},
+	})
+	commitState(t, db, []string{"acc", "bank", "gov"}, map[string]map[string]string{
+		"bank": {"d": ""},
+	})
+
+	state, err := loadState(db, 1)
+	require.NoError(t, err)
+	otherState, err := loadState(otherDB, 1)
+	require.NoError(t, err)
+
+	var out bytes.Buffer
+	require.NoError(t, diffStates(&out, state, otherState, nil))
+	lines := bytes.Split(bytes.TrimSpace(out.Bytes()), []byte("\n"))
+	require.Len(t, lines, 8)
+	require.Contains(t, string(lines[0]), "app hash")
+	require.Contains(t, string(lines[1]), "store bank: hash")
+	require.Equal(t, []string{
+		"  - 62: 31",
+		"  + 62: 32",
+		"  + 63: 32",
+		"  - 64: 31",
+		"store gov: only in -",
+		"store mint: only in +",
+	}, toStrings(lines[2:]))
+
+	// the state of a node at two heights
+	otherState, err = loadState(db, 2)
+	require.NoError(t, err)
+	out.Reset()
+	require.NoError(t, diffStates(&out, state, otherState, nil))
+	lines = bytes.Split(bytes.TrimSpace(out.Bytes()), []byte("\n"))
+	require.Len(t, lines, 3)
+	require.Equal(t, "  - 64: 31", string(lines[2]))
+
+	out.Reset()
+	require.NoError(t, diffStates(&out, state, state, nil))
+	require.Contains(t, out.String(), "is identical")
+}
+
+func toStrings(lines [][]byte) []string {
+	strs := make([]string, len(lines))
+	for i, line := range lines {
+		strs[i] = string(line)
+	}
+	return strs
+}
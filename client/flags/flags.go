@@ -3,6 +3,7 @@ package flags
 import (
 	"fmt"
 	"strconv"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
@@ -28,6 +29,10 @@ const (
 	// immediately.
 	BroadcastAsync = "async"
 
+	// DefaultWaitTimeout is the default time waited for the inclusion of a
+	// transaction in a block with --wait.
+	DefaultWaitTimeout = time.Minute
+
 	// SignModeDirect is the value of the --sign-mode flag for SIGN_MODE_DIRECT
 	SignModeDirect = "direct"
 	// SignModeLegacyAminoJSON is the value of the --sign-mode flag for SIGN_MODE_LEGACY_AMINO_JSON
@@ -67,6 +72,8 @@ const (
 	FlagOffline          = "offline"
 	FlagOutputDocument   = "output-document" // inspired by wget -O
 	FlagSkipConfirmation = "yes"
+	FlagWait             = "wait"
+	FlagWaitTimeout      = "wait-timeout"
 	FlagProve            = "prove"
 	FlagKeyringBackend   = "keyring-backend"
 	FlagPage             = "page"
@@ -76,6 +83,8 @@ const (
 	FlagOffset           = "offset"
 	FlagCountTotal       = "count-total"
 	FlagTimeoutHeight    = "timeout-height"
+	FlagTimeoutDuration  = "timeout-duration"
+	FlagUnordered        = "unordered"
 	FlagKeyType          = "key-type"
 	FlagFeePayer         = "fee-payer"
 	FlagFeeGranter       = "fee-granter"
@@ -132,8 +141,12 @@ func AddTxFlagsToCmd(cmd *cobra.Command) {
 	f.Bool(FlagGenerateOnly, false, "Build an unsigned transaction and write it to STDOUT (when enabled, the local Keybase only accessed when providing a key name)")
 	f.Bool(FlagOffline, false, "Offline mode (does not allow any online functionality)")
 	f.BoolP(FlagSkipConfirmation, "y", false, "Skip tx broadcasting prompt confirmation")
+	f.Bool(FlagWait, false, "Wait for the inclusion of the tx in a block, and output the result of its execution")
+	f.Duration(FlagWaitTimeout, DefaultWaitTimeout, "Maximum time waited for the inclusion of the tx in a block with --wait")
 	f.String(FlagSignMode, "", "Choose sign mode (direct|amino-json|direct-aux), this is an advanced feature")
 	f.Uint64(FlagTimeoutHeight, 0, "Set a block timeout height to prevent the tx from being committed past a certain height")
+	f.Duration(FlagTimeoutDuration, 0, "Set a timeout duration from now, after which the tx cannot be committed anymore")
+	f.Bool(FlagUnordered, false, "Enable unordered transaction delivery; must be used in conjunction with --timeout-height or --timeout-duration")
 	f.String(FlagFeePayer, "", "Fee payer pays fees for the transaction instead of deducting from the signer")
 	f.String(FlagFeeGranter, "", "Fee granter grants fees for the transaction")
 	f.String(FlagTip, "", "Tip is the amount that is going to be transferred to the fee payer on the target chain. This flag is only valid when used with --aux, and is ignored if the target chain didn't enable the TipDecorator")
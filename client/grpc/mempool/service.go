@@ -0,0 +1,302 @@
+package mempool
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"sort"
+
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+// MempoolProvider defines the interface used by the mempool gRPC service to
+// access the application's mempool and to encode its transactions. It is
+// implemented by BaseApp.
+type MempoolProvider interface {
+	Mempool() sdkmempool.Mempool
+	TxEncoder() sdk.TxEncoder
+}
+
+// RegisterMempoolService registers the mempool gRPC service on the provided
+// gRPC router, if the mempool of the provider implements mempool.Introspector.
+// It must be called once the mempool of the application is set, e.g. when
+// loading the application.
+func RegisterMempoolService(server gogogrpc.Server, provider MempoolProvider) {
+	if _, ok := provider.Mempool().(sdkmempool.Introspector); !ok {
+		return
+	}
+
+	RegisterQueryServer(server, NewQueryServer(provider))
+}
+
+// RegisterGRPCGatewayRoutes mounts the mempool gRPC service's GRPC-gateway
+// routes on the given mux object.
+func RegisterGRPCGatewayRoutes(clientConn gogogrpc.ClientConn, mux *runtime.ServeMux) {
+	_ = RegisterQueryHandlerClient(context.Background(), mux, NewQueryClient(clientConn))
+}
+
+var _ QueryServer = queryServer{}
+
+type queryServer struct {
+	provider MempoolProvider
+}
+
+// NewQueryServer creates a new mempool gRPC query server. The queries fail with
+// codes.Unimplemented if the mempool does not implement mempool.Introspector.
+//
+// NOTE: The queries may be served concurrently with CheckTx and
+// PrepareProposal, the mempool implementation must therefore be safe for
+// concurrent use, as the mempools of the types/mempool package are.
+func NewQueryServer(provider MempoolProvider) QueryServer {
+	return queryServer{
+		provider: provider,
+	}
+}
+
+// mempoolEntry is a transaction in the mempool along with its sender and nonce.
+type mempoolEntry struct {
+	sender string
+	nonce  uint64
+	tx     sdk.Tx
+}
+
+func (s queryServer) Txs(_ context.Context, req *QueryTxsRequest) (*QueryTxsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	mp, err := s.introspector()
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []mempoolEntry
+	mp.Walk(func(sender string, nonce uint64, tx sdk.Tx) bool {
+		entries = append(entries, mempoolEntry{sender: sender, nonce: nonce, tx: tx})
+		return false
+	})
+
+	page, pageRes, err := paginate(entries, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	txs, err := s.toMempoolTxs(page)
+	if err != nil {
+		return nil, err
+	}
+
+	return &QueryTxsResponse{Txs: txs, Pagination: pageRes}, nil
+}
+
+func (s queryServer) TxsBySender(_ context.Context, req *QueryTxsBySenderRequest) (*QueryTxsBySenderResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid sender address: %s", err)
+	}
+
+	mp, err := s.introspector()
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []mempoolEntry
+	mp.WalkSender(sender.String(), func(sender string, nonce uint64, tx sdk.Tx) bool {
+		entries = append(entries, mempoolEntry{sender: sender, nonce: nonce, tx: tx})
+		return false
+	})
+
+	page, pageRes, err := paginate(entries, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	txs, err := s.toMempoolTxs(page)
+	if err != nil {
+		return nil, err
+	}
+
+	return &QueryTxsBySenderResponse{Txs: txs, Pagination: pageRes}, nil
+}
+
+func (s queryServer) TxByHash(_ context.Context, req *QueryTxByHashRequest) (*QueryTxByHashResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	hash, err := hex.DecodeString(req.Hash)
+	if err != nil || len(hash) != tmhash.Size {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid tx hash: %s", req.Hash)
+	}
+
+	mp, err := s.introspector()
+	if err != nil {
+		return nil, err
+	}
+
+	txEncoder := s.provider.TxEncoder()
+	if txEncoder == nil {
+		return nil, status.Error(codes.Unavailable, "tx encoder not set")
+	}
+
+	var (
+		res     *MempoolTx
+		walkErr error
+	)
+	mp.Walk(func(sender string, nonce uint64, tx sdk.Tx) bool {
+		bz, err := txEncoder(tx)
+		if err != nil {
+			walkErr = err
+			return true
+		}
+
+		txHash := tmhash.Sum(bz)
+		if !bytes.Equal(txHash, hash) {
+			return false
+		}
+
+		res = &MempoolTx{
+			Hash:   fmt.Sprintf("%X", txHash),
+			Sender: sender,
+			Nonce:  nonce,
+			Tx:     bz,
+		}
+		return true
+	})
+	if walkErr != nil {
+		return nil, status.Errorf(codes.Internal, "failed to encode tx: %s", walkErr)
+	}
+
+	if res == nil {
+		return nil, status.Errorf(codes.NotFound, "tx %s not found in mempool", req.Hash)
+	}
+
+	return &QueryTxByHashResponse{Tx: res}, nil
+}
+
+func (s queryServer) SenderCounts(_ context.Context, req *QuerySenderCountsRequest) (*QuerySenderCountsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	mp, err := s.introspector()
+	if err != nil {
+		return nil, err
+	}
+
+	senderCounts := mp.SenderCounts()
+	counts := make([]*SenderCount, 0, len(senderCounts))
+	for sender, count := range senderCounts {
+		counts = append(counts, &SenderCount{Sender: sender, Count: uint64(count)})
+	}
+	sort.Slice(counts, func(i, j int) bool { return counts[i].Sender < counts[j].Sender })
+
+	page, pageRes, err := paginate(counts, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &QuerySenderCountsResponse{Counts: page, Pagination: pageRes}, nil
+}
+
+// introspector returns the application's mempool if it supports introspection.
+func (s queryServer) introspector() (sdkmempool.Introspector, error) {
+	mp, ok := s.provider.Mempool().(sdkmempool.Introspector)
+	if !ok {
+		return nil, status.Error(codes.Unimplemented, "the application's mempool does not support introspection")
+	}
+
+	return mp, nil
+}
+
+// toMempoolTxs encodes the given mempool entries.
+func (s queryServer) toMempoolTxs(entries []mempoolEntry) ([]*MempoolTx, error) {
+	txEncoder := s.provider.TxEncoder()
+	if txEncoder == nil {
+		return nil, status.Error(codes.Unavailable, "tx encoder not set")
+	}
+
+	txs := make([]*MempoolTx, 0, len(entries))
+	for _, entry := range entries {
+		bz, err := txEncoder(entry.tx)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to encode tx: %s", err)
+		}
+
+		txs = append(txs, &MempoolTx{
+			Hash:   fmt.Sprintf("%X", tmhash.Sum(bz)),
+			Sender: entry.sender,
+			Nonce:  entry.nonce,
+			Tx:     bz,
+		})
+	}
+
+	return txs, nil
+}
+
+// paginate returns the page of items selected by the page request. The mempool
+// is not a store so the key of the page request, and the next key of the page
+// response, are the big endian encoded offset of the next item.
+func paginate[T any](items []T, pageReq *query.PageRequest) ([]T, *query.PageResponse, error) {
+	if pageReq == nil {
+		pageReq = &query.PageRequest{}
+	}
+
+	offset := pageReq.Offset
+	if len(pageReq.Key) > 0 {
+		if offset > 0 {
+			return nil, nil, status.Error(codes.InvalidArgument, "invalid request, either offset or key is expected, got both")
+		}
+
+		if len(pageReq.Key) != 8 {
+			return nil, nil, status.Error(codes.InvalidArgument, "invalid pagination key")
+		}
+
+		offset = binary.BigEndian.Uint64(pageReq.Key)
+	}
+
+	limit := pageReq.Limit
+	if limit == 0 {
+		limit = query.DefaultLimit
+	}
+
+	if pageReq.Reverse {
+		reversed := make([]T, len(items))
+		for i, item := range items {
+			reversed[len(items)-1-i] = item
+		}
+		items = reversed
+	}
+
+	total := uint64(len(items))
+	pageRes := &query.PageResponse{}
+	if pageReq.CountTotal {
+		pageRes.Total = total
+	}
+
+	if offset >= total {
+		return nil, pageRes, nil
+	}
+
+	end := total
+	if limit < total-offset {
+		end = offset + limit
+		pageRes.NextKey = binary.BigEndian.AppendUint64(nil, end)
+	}
+
+	return items[offset:end], pageRes, nil
+}
@@ -0,0 +1,213 @@
+package mempool
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"testing"
+
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/log"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	txsigning "github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+// testTx is a dummy implementation of Tx used for testing.
+type testTx struct {
+	id    int
+	pk    *secp256k1.PubKey
+	nonce uint64
+}
+
+func (tx testTx) GetMsgs() []sdk.Msg { return nil }
+
+func (tx testTx) ValidateBasic() error { return nil }
+
+func (tx testTx) GetSigners() []sdk.AccAddress { return []sdk.AccAddress{tx.pk.Address().Bytes()} }
+
+func (tx testTx) GetPubKeys() ([]cryptotypes.PubKey, error) { return []cryptotypes.PubKey{tx.pk}, nil }
+
+func (tx testTx) GetSignaturesV2() ([]txsigning.SignatureV2, error) {
+	return []txsigning.SignatureV2{{PubKey: tx.pk, Sequence: tx.nonce}}, nil
+}
+
+func (tx testTx) sender() string { return sdk.AccAddress(tx.pk.Address()).String() }
+
+func testTxEncoder(tx sdk.Tx) ([]byte, error) {
+	return []byte(fmt.Sprintf("tx-%d", tx.(testTx).id)), nil
+}
+
+type testProvider struct {
+	mempool   sdkmempool.Mempool
+	txEncoder sdk.TxEncoder
+}
+
+func (p testProvider) Mempool() sdkmempool.Mempool { return p.mempool }
+
+func (p testProvider) TxEncoder() sdk.TxEncoder { return p.txEncoder }
+
+func setupQueryServer(t *testing.T) (QueryServer, []testTx) {
+	t.Helper()
+
+	pkA := secp256k1.GenPrivKey().PubKey().(*secp256k1.PubKey)
+	pkB := secp256k1.GenPrivKey().PubKey().(*secp256k1.PubKey)
+
+	txs := []testTx{
+		{id: 0, pk: pkA, nonce: 0},
+		{id: 1, pk: pkA, nonce: 1},
+		{id: 2, pk: pkB, nonce: 0},
+		{id: 3, pk: pkA, nonce: 2},
+	}
+
+	mp := sdkmempool.NewSenderNonceMempool()
+	ctx := sdk.NewContext(nil, cmtproto.Header{}, false, log.NewNopLogger())
+	for _, tx := range txs {
+		require.NoError(t, mp.Insert(ctx, tx))
+	}
+
+	return NewQueryServer(testProvider{mempool: mp, txEncoder: testTxEncoder}), txs
+}
+
+func requireTxs(t *testing.T, expected []testTx, actual []*MempoolTx) {
+	t.Helper()
+
+	require.Len(t, actual, len(expected))
+	for i, tx := range expected {
+		bz, err := testTxEncoder(tx)
+		require.NoError(t, err)
+		require.Equal(t, fmt.Sprintf("%X", tmhash.Sum(bz)), actual[i].Hash)
+		require.Equal(t, tx.sender(), actual[i].Sender)
+		require.Equal(t, tx.nonce, actual[i].Nonce)
+		require.Equal(t, bz, actual[i].Tx)
+	}
+}
+
+func TestQueryServer_Txs(t *testing.T) {
+	svr, txs := setupQueryServer(t)
+
+	res, err := svr.Txs(context.Background(), &QueryTxsRequest{Pagination: &query.PageRequest{CountTotal: true}})
+	require.NoError(t, err)
+	require.Len(t, res.Txs, len(txs))
+	require.Equal(t, uint64(len(txs)), res.Pagination.Total)
+	require.Nil(t, res.Pagination.NextKey)
+
+	// paginate through the mempool with the next key
+	var (
+		all     []*MempoolTx
+		nextKey []byte
+	)
+	for {
+		res, err := svr.Txs(context.Background(), &QueryTxsRequest{Pagination: &query.PageRequest{Key: nextKey, Limit: 3}})
+		require.NoError(t, err)
+		all = append(all, res.Txs...)
+		nextKey = res.Pagination.NextKey
+		if nextKey == nil {
+			break
+		}
+	}
+	require.Equal(t, res.Txs, all)
+
+	_, err = svr.Txs(context.Background(), &QueryTxsRequest{Pagination: &query.PageRequest{Key: binary.BigEndian.AppendUint64(nil, 1), Offset: 1}})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = svr.Txs(context.Background(), nil)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestQueryServer_TxsBySender(t *testing.T) {
+	svr, txs := setupQueryServer(t)
+
+	res, err := svr.TxsBySender(context.Background(), &QueryTxsBySenderRequest{Sender: txs[0].sender()})
+	require.NoError(t, err)
+	requireTxs(t, []testTx{txs[0], txs[1], txs[3]}, res.Txs)
+
+	res, err = svr.TxsBySender(context.Background(), &QueryTxsBySenderRequest{
+		Sender:     txs[0].sender(),
+		Pagination: &query.PageRequest{Limit: 1, Reverse: true},
+	})
+	require.NoError(t, err)
+	requireTxs(t, []testTx{txs[3]}, res.Txs)
+	require.NotNil(t, res.Pagination.NextKey)
+
+	res, err = svr.TxsBySender(context.Background(), &QueryTxsBySenderRequest{
+		Sender: sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String(),
+	})
+	require.NoError(t, err)
+	require.Empty(t, res.Txs)
+
+	_, err = svr.TxsBySender(context.Background(), &QueryTxsBySenderRequest{Sender: "invalid"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestQueryServer_TxByHash(t *testing.T) {
+	svr, txs := setupQueryServer(t)
+
+	bz, err := testTxEncoder(txs[2])
+	require.NoError(t, err)
+
+	res, err := svr.TxByHash(context.Background(), &QueryTxByHashRequest{Hash: fmt.Sprintf("%X", tmhash.Sum(bz))})
+	require.NoError(t, err)
+	requireTxs(t, []testTx{txs[2]}, []*MempoolTx{res.Tx})
+
+	_, err = svr.TxByHash(context.Background(), &QueryTxByHashRequest{Hash: fmt.Sprintf("%X", tmhash.Sum([]byte("unknown")))})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = svr.TxByHash(context.Background(), &QueryTxByHashRequest{Hash: "invalid"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestQueryServer_SenderCounts(t *testing.T) {
+	svr, txs := setupQueryServer(t)
+
+	res, err := svr.SenderCounts(context.Background(), &QuerySenderCountsRequest{})
+	require.NoError(t, err)
+
+	expected := []*SenderCount{
+		{Sender: txs[0].sender(), Count: 3},
+		{Sender: txs[2].sender(), Count: 1},
+	}
+	if expected[0].Sender > expected[1].Sender {
+		expected[0], expected[1] = expected[1], expected[0]
+	}
+	require.Equal(t, expected, res.Counts)
+}
+
+func TestQueryServer_Unimplemented(t *testing.T) {
+	svr := NewQueryServer(testProvider{mempool: sdkmempool.NoOpMempool{}, txEncoder: testTxEncoder})
+
+	_, err := svr.Txs(context.Background(), &QueryTxsRequest{})
+	require.Equal(t, codes.Unimplemented, status.Code(err))
+
+	_, err = svr.SenderCounts(context.Background(), &QuerySenderCountsRequest{})
+	require.Equal(t, codes.Unimplemented, status.Code(err))
+}
+
+// recordingServer records the names of the services registered on it.
+type recordingServer struct {
+	services []string
+}
+
+func (s *recordingServer) RegisterService(sd *grpc.ServiceDesc, _ interface{}) {
+	s.services = append(s.services, sd.ServiceName)
+}
+
+func TestRegisterMempoolService(t *testing.T) {
+	// the service is not registered for the mempools without introspection
+	server := &recordingServer{}
+	RegisterMempoolService(server, testProvider{mempool: sdkmempool.NoOpMempool{}, txEncoder: testTxEncoder})
+	require.Empty(t, server.services)
+
+	server = &recordingServer{}
+	RegisterMempoolService(server, testProvider{mempool: sdkmempool.NewSenderNonceMempool(), txEncoder: testTxEncoder})
+	require.Equal(t, []string{"cosmos.base.mempool.v1.Query"}, server.services)
+}
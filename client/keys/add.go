@@ -23,15 +23,17 @@ import (
 )
 
 const (
-	flagInteractive = "interactive"
-	flagRecover     = "recover"
-	flagNoBackup    = "no-backup"
-	flagCoinType    = "coin-type"
-	flagAccount     = "account"
-	flagIndex       = "index"
-	flagMultisig    = "multisig"
-	flagNoSort      = "nosort"
-	flagHDPath      = "hd-path"
+	flagInteractive  = "interactive"
+	flagRecover      = "recover"
+	flagNoBackup     = "no-backup"
+	flagCoinType     = "coin-type"
+	flagAccount      = "account"
+	flagIndex        = "index"
+	flagMultisig     = "multisig"
+	flagNoSort       = "nosort"
+	flagHDPath       = "hd-path"
+	flagRemoteSigner = "remote-signer"
+	flagRemoteKey    = "remote-key"
 
 	// DefaultKeyPass contains the default key password for genesis transactions
 	DefaultKeyPass = "12345678"
@@ -53,6 +55,10 @@ If run with --dry-run, a key would be generated (or recovered) but not stored to
 local keystore.
 Use the --pubkey flag to add arbitrary public keys to the keystore for constructing
 multisig transactions.
+Use the --remote-signer flag to add a reference to a key held by a remote signer, given
+its gRPC address. Only the public key is stored, the transactions signed with the key are
+signed by the remote signer, over TLS. The key is looked up in the remote signer by the
+name given by --remote-key, or by <name>.
 
 You can create and store a multisig key by passing the list of key names stored in a keyring
 and the minimum number of signatures required through --multisig-threshold. The keys are
@@ -69,6 +75,8 @@ Example:
 	f.Int(flagMultiSigThreshold, 1, "K out of N required signatures. For use in conjunction with --multisig")
 	f.Bool(flagNoSort, false, "Keys passed to --multisig are taken in the order they're supplied")
 	f.String(FlagPublicKey, "", "Parse a public key in JSON format and saves key info to <name> file.")
+	f.String(flagRemoteSigner, "", "Store a local reference to a key held by the remote signer at the given gRPC address")
+	f.String(flagRemoteKey, "", "Name of the key in the remote signer, <name> by default. For use in conjunction with --remote-signer")
 	f.BoolP(flagInteractive, "i", false, "Interactively prompt user for BIP39 passphrase and mnemonic")
 	f.Bool(flags.FlagUseLedger, false, "Store a local reference to a private key on a Ledger device")
 	f.Bool(flagRecover, false, "Provide seed phrase to recover existing key instead of creating")
@@ -203,6 +211,21 @@ func runAddCmd(ctx client.Context, cmd *cobra.Command, args []string, inBuf *buf
 		return printCreate(cmd, k, false, "", outputFormat)
 	}
 
+	remoteSigner, _ := cmd.Flags().GetString(flagRemoteSigner)
+	if remoteSigner != "" {
+		remoteKey, _ := cmd.Flags().GetString(flagRemoteKey)
+		if remoteKey == "" {
+			remoteKey = name
+		}
+
+		k, err := kb.SaveRemoteKey(name, remoteSigner, remoteKey)
+		if err != nil {
+			return err
+		}
+
+		return printCreate(cmd, k, false, "", outputFormat)
+	}
+
 	coinType, _ := cmd.Flags().GetUint32(flagCoinType)
 	account, _ := cmd.Flags().GetUint32(flagAccount)
 	index, _ := cmd.Flags().GetUint32(flagIndex)
@@ -5,18 +5,23 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"net"
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/crypto/hd"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring/remotesigner"
 	"github.com/cosmos/cosmos-sdk/testutil"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	"github.com/cosmos/go-bip39"
 )
 
@@ -274,3 +279,62 @@ func TestAddRecoverFileBackend(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, "keyname1", k.Name)
 }
+
+func Test_runAddCmdRemoteSigner(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig().Codec
+	signerKb := keyring.NewInMemory(cdc)
+	validator, _, err := signerKb.NewMnemonic("validator", keyring.English, sdk.FullFundraiserPath, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	server := grpc.NewServer()
+	remotesigner.RegisterSignerServer(server, keyring.NewRemoteSignerServer(signerKb))
+	go server.Serve(listener) //nolint:errcheck // the server stops with the test
+	t.Cleanup(server.Stop)
+
+	cmd := AddKeyCommand()
+	cmd.Flags().AddFlagSet(Commands("home").PersistentFlags())
+	mockIn := testutil.ApplyMockIODiscardOutErr(cmd)
+	kbHome := t.TempDir()
+
+	insecureDial := func(options *keyring.Options) {
+		options.RemoteSignerDialOptions = []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
+	}
+	clientCtx := client.Context{}.WithKeyringDir(kbHome).WithInput(mockIn).WithCodec(cdc).WithKeyringOptions(insecureDial)
+	ctx := context.WithValue(context.Background(), client.ClientContextKey, &clientCtx)
+
+	cmd.SetArgs([]string{
+		"operator",
+		fmt.Sprintf("--%s=%s", flagRemoteSigner, listener.Addr()),
+		fmt.Sprintf("--%s=validator", flagRemoteKey),
+		fmt.Sprintf("--%s=%s", flags.FlagKeyringBackend, keyring.BackendTest),
+	})
+	require.NoError(t, cmd.ExecuteContext(ctx))
+
+	kb, err := keyring.New(sdk.KeyringServiceName(), keyring.BackendTest, kbHome, mockIn, cdc, insecureDial)
+	require.NoError(t, err)
+	k, err := kb.Key("operator")
+	require.NoError(t, err)
+	require.Equal(t, keyring.TypeRemote, k.GetType())
+	require.Equal(t, validator.PubKey, k.PubKey)
+
+	msg := []byte("sign bytes")
+	sig, pub, err := kb.Sign("operator", msg, signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+	require.True(t, pub.VerifySignature(msg, sig))
+
+	// the remote key defaults to the name of the key
+	_, _, err = signerKb.NewMnemonic("alice", keyring.English, sdk.FullFundraiserPath, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+	cmd.SetArgs([]string{
+		"alice",
+		fmt.Sprintf("--%s=%s", flagRemoteSigner, listener.Addr()),
+		fmt.Sprintf("--%s=", flagRemoteKey),
+		fmt.Sprintf("--%s=%s", flags.FlagKeyringBackend, keyring.BackendTest),
+	})
+	require.NoError(t, cmd.ExecuteContext(ctx))
+	k, err = kb.Key("alice")
+	require.NoError(t, err)
+	require.Equal(t, "alice", k.GetRemote().Key)
+}
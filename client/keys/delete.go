@@ -22,9 +22,9 @@ func DeleteKeyCommand() *cobra.Command {
 		Short: "Delete the given keys",
 		Long: `Delete keys from the Keybase backend.
 
-Note that removing offline or ledger keys will remove
+Note that removing offline, ledger or remote keys will remove
 only the public key references stored locally, i.e.
-private keys stored in a ledger device cannot be deleted with the CLI.
+private keys stored in a ledger device or a remote signer cannot be deleted with the CLI.
 `,
 		Args: cobra.MinimumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -53,7 +53,7 @@ private keys stored in a ledger device cannot be deleted with the CLI.
 					return err
 				}
 
-				if k.GetType() == keyring.TypeLedger || k.GetType() == keyring.TypeOffline {
+				if k.GetType() == keyring.TypeLedger || k.GetType() == keyring.TypeOffline || k.GetType() == keyring.TypeRemote {
 					cmd.PrintErrln("Public key reference deleted")
 					continue
 				}
@@ -0,0 +1,82 @@
+package keys
+
+import (
+	"crypto/tls"
+	"net"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring/remotesigner"
+)
+
+const (
+	flagTLSCert = "tls-cert"
+	flagTLSKey  = "tls-key"
+)
+
+// ServeRemoteSignerCommand defines a keys command serving the keys of the keyring as a remote signer.
+func ServeRemoteSignerCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "serve-remote-signer <address>",
+		Short: "Serve the keys of the keyring as a remote signer",
+		Long: `Serve the keys of the keyring as a remote signer on the given address, over TLS.
+Other keyrings can then reference the keys with 'keys add <name> --remote-signer <address>'
+and sign with them.
+
+This is a reference implementation of the remote signer protocol, meant for testing: it signs
+any bytes with any key of the keyring for any client. The keyrings trust the certificate of the
+remote signer if it is signed by the system root certificates, or by the certificates in the
+SSL_CERT_FILE file.
+
+Example:
+
+    keys serve-remote-signer localhost:9095 --tls-cert signer.crt --tls-key signer.key
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			certFile, _ := cmd.Flags().GetString(flagTLSCert)
+			keyFile, _ := cmd.Flags().GetString(flagTLSKey)
+			cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+			if err != nil {
+				return err
+			}
+
+			listener, err := net.Listen("tcp", args[0])
+			if err != nil {
+				return err
+			}
+
+			server := grpc.NewServer(grpc.Creds(credentials.NewServerTLSFromCert(&cert)))
+			remotesigner.RegisterSignerServer(server, keyring.NewRemoteSignerServer(clientCtx.Keyring))
+
+			sigs := make(chan os.Signal, 1)
+			signal.Notify(sigs, os.Interrupt, syscall.SIGTERM)
+			go func() {
+				<-sigs
+				server.GracefulStop()
+			}()
+
+			cmd.PrintErrf("Serving the remote signer on %s\n", listener.Addr())
+			return server.Serve(listener)
+		},
+	}
+
+	cmd.Flags().String(flagTLSCert, "", "Path to the TLS certificate of the remote signer")
+	cmd.Flags().String(flagTLSKey, "", "Path to the TLS private key of the remote signer")
+	_ = cmd.MarkFlagRequired(flagTLSCert)
+	_ = cmd.MarkFlagRequired(flagTLSKey)
+
+	return cmd
+}
@@ -18,9 +18,9 @@ func RenameKeyCommand() *cobra.Command {
 		Short: "Rename an existing key",
 		Long: `Rename a key from the Keybase backend.
 
-Note that renaming offline or ledger keys will rename
+Note that renaming offline, ledger or remote keys will rename
 only the public key references stored locally, i.e.
-private keys stored in a ledger device cannot be renamed with the CLI.
+private keys stored in a ledger device or a remote signer cannot be renamed with the CLI.
 `,
 		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -51,7 +51,7 @@ private keys stored in a ledger device cannot be renamed with the CLI.
 				return err
 			}
 
-			if k.GetType() == keyring.TypeLedger || k.GetType() == keyring.TypeOffline {
+			if k.GetType() == keyring.TypeLedger || k.GetType() == keyring.TypeOffline || k.GetType() == keyring.TypeRemote {
 				cmd.PrintErrln("Public key reference renamed")
 				return nil
 			}
@@ -0,0 +1,225 @@
+package tx
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// BatchOptions defines the options of the broadcast of a batch of transactions.
+type BatchOptions struct {
+	// MaxGas is the gas limit of the transactions: the messages are packed into a
+	// transaction as long as its gas stays under it.
+	MaxGas uint64
+	// MaxRetries is the number of times a transaction rejected for a wrong account
+	// sequence is signed again and broadcast again.
+	MaxRetries int
+	// RetryInterval is the time waited before querying the account sequence to retry
+	// a transaction, leaving time to the pending transactions of the account to be
+	// included in a block.
+	RetryInterval time.Duration
+}
+
+// DefaultBatchOptions returns the default options of the broadcast of a batch.
+func DefaultBatchOptions() BatchOptions {
+	return BatchOptions{
+		MaxGas:        1_000_000,
+		MaxRetries:    3,
+		RetryInterval: 6 * time.Second,
+	}
+}
+
+// BatchTx is a transaction of a batch, with its messages and gas limit.
+type BatchTx struct {
+	Msgs []sdk.Msg
+	Gas  uint64
+}
+
+// BroadcastBatch packs the messages into transactions with PackMsgs, then signs and
+// broadcasts them with BroadcastBatchTxs. It returns the responses of the broadcast
+// transactions, along with the error stopping the batch if any.
+func BroadcastBatch(clientCtx client.Context, txf Factory, opts BatchOptions, msgs ...sdk.Msg) ([]*sdk.TxResponse, error) {
+	if clientCtx.Offline {
+		return nil, errors.New("cannot broadcast a batch in offline mode")
+	}
+
+	txf, err := txf.Prepare(clientCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	txs, err := PackMsgs(clientCtx, txf, opts.MaxGas, msgs...)
+	if err != nil {
+		return nil, err
+	}
+
+	return BroadcastBatchTxs(clientCtx, txf, opts, txs)
+}
+
+// PackMsgs packs the messages, in order, into transactions whose gas stays under maxGas.
+// If the factory simulates the transactions, their gas is simulated, with the account
+// sequence of the factory. Otherwise, each message is given the gas of the factory.
+func PackMsgs(clientCtx client.Context, txf Factory, maxGas uint64, msgs ...sdk.Msg) ([]BatchTx, error) {
+	for _, msg := range msgs {
+		m, ok := msg.(sdk.HasValidateBasic)
+		if !ok {
+			continue
+		}
+
+		if err := m.ValidateBasic(); err != nil {
+			return nil, err
+		}
+	}
+
+	var (
+		txs     []BatchTx
+		current BatchTx
+	)
+	for i, msg := range msgs {
+		if len(current.Msgs) > 0 {
+			candidate := append(append(make([]sdk.Msg, 0, len(current.Msgs)+1), current.Msgs...), msg)
+			gas, err := batchTxGas(clientCtx, txf, candidate)
+			if err != nil {
+				return nil, err
+			}
+
+			if gas <= maxGas {
+				current = BatchTx{Msgs: candidate, Gas: gas}
+				continue
+			}
+
+			txs = append(txs, current)
+		}
+
+		gas, err := batchTxGas(clientCtx, txf, []sdk.Msg{msg})
+		if err != nil {
+			return nil, err
+		}
+		if gas > maxGas {
+			return nil, fmt.Errorf("message %d needs %d gas, more than the gas limit of %d", i, gas, maxGas)
+		}
+
+		current = BatchTx{Msgs: []sdk.Msg{msg}, Gas: gas}
+	}
+
+	if len(current.Msgs) > 0 {
+		txs = append(txs, current)
+	}
+
+	return txs, nil
+}
+
+func batchTxGas(clientCtx client.Context, txf Factory, msgs []sdk.Msg) (uint64, error) {
+	if !txf.SimulateAndExecute() {
+		return txf.Gas() * uint64(len(msgs)), nil
+	}
+
+	_, adjusted, err := CalculateGas(clientCtx, txf, msgs...)
+	return adjusted, err
+}
+
+// BroadcastBatchTxs signs and broadcasts the transactions of a batch, with consecutive
+// account sequences starting from the sequence of the factory, without waiting for their
+// inclusion in a block. The transactions must be broadcast in sync mode, so that the
+// transactions rejected for a wrong account sequence are reported. Such a transaction
+// is signed again and broadcast again, up to opts.MaxRetries times, with the account
+// sequence queried from the account retriever, unless the sequence is still used by
+// the pending transactions of the batch. The batch stops at the first transaction which
+// cannot be broadcast.
+//
+// If the context waits for the transactions, the batch waits for their inclusion in a
+// block once all of them are broadcast, and the responses of the included transactions
+// replace the responses of their broadcast. It returns the responses of the broadcast
+// transactions, along with the error stopping the batch if any.
+func BroadcastBatchTxs(clientCtx client.Context, txf Factory, opts BatchOptions, txs []BatchTx) ([]*sdk.TxResponse, error) {
+	if clientCtx.BroadcastMode != flags.BroadcastSync {
+		return nil, fmt.Errorf("a batch must be broadcast in %s mode, got %s", flags.BroadcastSync, clientCtx.BroadcastMode)
+	}
+
+	responses, err := broadcastBatchTxs(clientCtx.WithWait(false, 0), txf, opts, txs)
+	if err != nil || !clientCtx.Wait {
+		return responses, err
+	}
+
+	for i, res := range responses {
+		included, err := clientCtx.WaitForTx(res.TxHash, clientCtx.WaitTimeout)
+		if err != nil {
+			return responses, err
+		}
+		responses[i] = included
+	}
+
+	for _, res := range responses {
+		if res.Code != 0 {
+			return responses, errorsmod.ABCIError(res.Codespace, res.Code, res.RawLog)
+		}
+	}
+
+	return responses, nil
+}
+
+func broadcastBatchTxs(clientCtx client.Context, txf Factory, opts BatchOptions, txs []BatchTx) ([]*sdk.TxResponse, error) {
+	responses := make([]*sdk.TxResponse, 0, len(txs))
+	sequence := txf.Sequence()
+	for _, batchTx := range txs {
+		for retries := 0; ; retries++ {
+			res, err := signAndBroadcast(clientCtx, txf.WithSequence(sequence).WithGas(batchTx.Gas), batchTx.Msgs)
+			if err != nil {
+				return responses, err
+			}
+
+			if !isWrongSequence(res) || retries >= opts.MaxRetries {
+				responses = append(responses, res)
+				if res.Code != 0 {
+					return responses, errorsmod.ABCIError(res.Codespace, res.Code, res.RawLog)
+				}
+				break
+			}
+
+			time.Sleep(opts.RetryInterval)
+			_, accountSequence, err := txf.AccountRetriever().GetAccountNumberSequence(clientCtx, clientCtx.GetFromAddress())
+			if err != nil {
+				return responses, err
+			}
+
+			// the account sequence only counts the committed transactions, the
+			// sequences of the pending transactions of the batch are still used
+			if accountSequence > sequence {
+				sequence = accountSequence
+			}
+		}
+
+		sequence++
+	}
+
+	return responses, nil
+}
+
+func signAndBroadcast(clientCtx client.Context, txf Factory, msgs []sdk.Msg) (*sdk.TxResponse, error) {
+	tx, err := txf.BuildUnsignedTx(msgs...)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := Sign(clientCtx.CmdContext, txf, clientCtx.GetFromName(), tx, true); err != nil {
+		return nil, err
+	}
+
+	txBytes, err := clientCtx.TxConfig.TxEncoder()(tx.GetTx())
+	if err != nil {
+		return nil, err
+	}
+
+	return clientCtx.BroadcastTx(txBytes)
+}
+
+func isWrongSequence(res *sdk.TxResponse) bool {
+	return res.Codespace == sdkerrors.ErrWrongSequence.Codespace() && res.Code == sdkerrors.ErrWrongSequence.ABCICode()
+}
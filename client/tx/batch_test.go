@@ -0,0 +1,241 @@
+package tx_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtbytes "github.com/cometbft/cometbft/libs/bytes"
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+const (
+	batchTxGas  = 5_000
+	batchMsgGas = 10_000
+)
+
+// batchNode is a mock CometBFT node simulating the transactions, and checking the account
+// sequence of the broadcast transactions as CheckTx does. The sequence of the account is
+// the sequence checked by CheckTx, counting the pending transactions, while the committed
+// sequence only counts the transactions included in a block.
+type batchNode struct {
+	client.CometRPC
+
+	t         *testing.T
+	txConfig  client.TxConfig
+	sequence  uint64
+	committed uint64
+	txs       []signing.Tx
+	// attempts are the sequences of the broadcast transactions, accepted or not
+	attempts []uint64
+	// onBroadcast is called after each accepted transaction
+	onBroadcast func()
+	// included are the hashes of the transactions found by the tx service
+	included []string
+}
+
+var _ client.CometRPC = (*batchNode)(nil)
+
+func (n *batchNode) ABCIQueryWithOptions(_ context.Context, path string, data cmtbytes.HexBytes, _ rpcclient.ABCIQueryOptions) (*coretypes.ResultABCIQuery, error) {
+	if path == "/cosmos.tx.v1beta1.Service/GetTx" {
+		var req txtypes.GetTxRequest
+		require.NoError(n.t, req.Unmarshal(data))
+		n.included = append(n.included, req.Hash)
+
+		res := txtypes.GetTxResponse{TxResponse: &sdk.TxResponse{TxHash: req.Hash, Height: 10}}
+		bz, err := res.Marshal()
+		require.NoError(n.t, err)
+
+		return &coretypes.ResultABCIQuery{Response: abci.ResponseQuery{Value: bz}}, nil
+	}
+
+	require.Equal(n.t, "/cosmos.tx.v1beta1.Service/Simulate", path)
+
+	var req txtypes.SimulateRequest
+	require.NoError(n.t, req.Unmarshal(data))
+	decoded, err := n.txConfig.TxDecoder()(req.TxBytes)
+	require.NoError(n.t, err)
+
+	gasUsed := uint64(batchTxGas + batchMsgGas*len(decoded.GetMsgs()))
+	res := txtypes.SimulateResponse{GasInfo: &sdk.GasInfo{GasUsed: gasUsed}, Result: &sdk.Result{}}
+	bz, err := res.Marshal()
+	require.NoError(n.t, err)
+
+	return &coretypes.ResultABCIQuery{Response: abci.ResponseQuery{Value: bz}}, nil
+}
+
+func (n *batchNode) BroadcastTxSync(_ context.Context, txBytes cmttypes.Tx) (*coretypes.ResultBroadcastTx, error) {
+	decoded, err := n.txConfig.TxDecoder()(txBytes)
+	require.NoError(n.t, err)
+	sigTx := decoded.(signing.Tx)
+	sigs, err := sigTx.GetSignaturesV2()
+	require.NoError(n.t, err)
+
+	n.attempts = append(n.attempts, sigs[0].Sequence)
+	if sigs[0].Sequence != n.sequence {
+		return &coretypes.ResultBroadcastTx{
+			Code:      sdkerrors.ErrWrongSequence.ABCICode(),
+			Codespace: sdkerrors.ErrWrongSequence.Codespace(),
+			Log:       "account sequence mismatch",
+		}, nil
+	}
+
+	n.sequence++
+	n.txs = append(n.txs, sigTx)
+	if n.onBroadcast != nil {
+		n.onBroadcast()
+	}
+	return &coretypes.ResultBroadcastTx{Code: 0, Hash: txBytes.Hash()}, nil
+}
+
+// batchAccountRetriever returns the committed account sequence of a batchNode, then
+// commits the pending transactions, as if a block was produced.
+type batchAccountRetriever struct {
+	client.MockAccountRetriever
+	node *batchNode
+}
+
+func (r batchAccountRetriever) GetAccountNumberSequence(client.Context, sdk.AccAddress) (uint64, uint64, error) {
+	committed := r.node.committed
+	r.node.committed = r.node.sequence
+	return 1, committed, nil
+}
+
+func TestBroadcastBatch(t *testing.T) {
+	encodingConfig := moduletestutil.MakeTestEncodingConfig()
+	banktypes.RegisterInterfaces(encodingConfig.InterfaceRegistry)
+	cdc := encodingConfig.Codec
+	txCfg := authtx.NewTxConfig(codec.NewProtoCodec(encodingConfig.InterfaceRegistry), authtx.DefaultSignModes)
+	kr, err := keyring.New(t.Name(), keyring.BackendMemory, t.TempDir(), nil, cdc)
+	require.NoError(t, err)
+	k, _, err := kr.NewMnemonic("alice", keyring.English, sdk.FullFundraiserPath, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+	from, err := k.GetAddress()
+	require.NoError(t, err)
+
+	msgs := make([]sdk.Msg, 7)
+	for i := range msgs {
+		msgs[i] = banktypes.NewMsgSend(from, sdk.AccAddress("to"), sdk.NewCoins(sdk.NewInt64Coin("stake", int64(i+1))))
+	}
+
+	setup := func(sequence uint64) (*batchNode, client.Context, tx.Factory) {
+		node := &batchNode{t: t, txConfig: txCfg, sequence: sequence, committed: sequence}
+		ar := batchAccountRetriever{node: node}
+		clientCtx := client.Context{}.
+			WithTxConfig(txCfg).
+			WithCodec(cdc).
+			WithKeyring(kr).
+			WithClient(node).
+			WithAccountRetriever(ar).
+			WithFromName("alice").
+			WithFromAddress(from).
+			WithBroadcastMode(flags.BroadcastSync).
+			WithCmdContext(context.Background())
+		txf := tx.Factory{}.
+			WithTxConfig(txCfg).
+			WithKeybase(kr).
+			WithAccountRetriever(ar).
+			WithChainID("test-chain").
+			WithSignMode(signingtypes.SignMode_SIGN_MODE_DIRECT).
+			WithGasAdjustment(1).
+			WithSimulateAndExecute(true)
+		return node, clientCtx, txf
+	}
+
+	// the messages are packed by 3 under the gas limit, and broadcast with consecutive sequences
+	node, clientCtx, txf := setup(5)
+	opts := tx.BatchOptions{MaxGas: batchTxGas + 3*batchMsgGas, MaxRetries: 1}
+	responses, err := tx.BroadcastBatch(clientCtx, txf, opts, msgs...)
+	require.NoError(t, err)
+	require.Len(t, responses, 3)
+	require.Equal(t, uint64(8), node.sequence)
+	require.Len(t, node.txs, 3)
+	for i, expMsgs := range [][]sdk.Msg{msgs[:3], msgs[3:6], msgs[6:]} {
+		require.Equal(t, expMsgs, node.txs[i].GetMsgs())
+		require.Equal(t, uint64(batchTxGas+batchMsgGas*len(expMsgs)), node.txs[i].GetGas())
+	}
+
+	// the transactions rejected for a wrong sequence are signed again with the sequence of the account
+	node, clientCtx, txf = setup(5)
+	txf, err = txf.Prepare(clientCtx)
+	require.NoError(t, err)
+	txs, err := tx.PackMsgs(clientCtx, txf, opts.MaxGas, msgs...)
+	require.NoError(t, err)
+	require.Len(t, txs, 3)
+	node.sequence, node.committed = 9, 9 // other transactions of the account were included
+	responses, err = tx.BroadcastBatchTxs(clientCtx, txf, opts, txs)
+	require.NoError(t, err)
+	require.Len(t, responses, 3)
+	require.Equal(t, uint64(12), node.sequence)
+
+	// the sequences of the pending transactions of the batch are not used again, even if the
+	// committed sequence of the account is lower
+	node, clientCtx, _ = setup(5)
+	node.onBroadcast = func() {
+		if node.sequence == 6 {
+			node.sequence++ // another transaction of the account is pending
+		}
+		node.onBroadcast = nil
+	}
+	txf = txf.WithAccountRetriever(batchAccountRetriever{node: node})
+	responses, err = tx.BroadcastBatchTxs(clientCtx, txf, tx.BatchOptions{MaxRetries: 2}, txs)
+	require.NoError(t, err)
+	require.Len(t, responses, 3)
+	require.Equal(t, []uint64{5, 6, 6, 7, 8}, node.attempts)
+
+	// the batch is only broadcast in sync mode
+	_, err = tx.BroadcastBatchTxs(clientCtx.WithBroadcastMode(flags.BroadcastAsync), txf, opts, txs)
+	require.ErrorContains(t, err, "must be broadcast in sync mode")
+
+	// the batch waits for the transactions once all of them are broadcast
+	node, clientCtx, _ = setup(5)
+	node.onBroadcast = func() {
+		require.Empty(t, node.included)
+	}
+	txf = txf.WithAccountRetriever(batchAccountRetriever{node: node})
+	responses, err = tx.BroadcastBatchTxs(clientCtx.WithWait(true, time.Minute), txf, opts, txs)
+	require.NoError(t, err)
+	require.Len(t, responses, 3)
+	require.Len(t, node.included, 3)
+	for i, res := range responses {
+		require.Equal(t, int64(10), res.Height)
+		require.Equal(t, node.included[i], res.TxHash)
+	}
+
+	node.sequence = 9
+	// the batch stops at the first transaction which cannot be broadcast
+	node.sequence, node.committed = 20, 20
+	responses, err = tx.BroadcastBatchTxs(clientCtx, txf, tx.BatchOptions{MaxGas: opts.MaxGas}, txs)
+	require.ErrorIs(t, err, sdkerrors.ErrWrongSequence)
+	require.Len(t, responses, 1)
+	require.Equal(t, sdkerrors.ErrWrongSequence.ABCICode(), responses[0].Code)
+
+	// without simulation, each message is given the gas of the factory
+	txs, err = tx.PackMsgs(clientCtx, txf.WithSimulateAndExecute(false).WithGas(20_000), 50_000, msgs...)
+	require.NoError(t, err)
+	require.Len(t, txs, 4)
+	require.Equal(t, uint64(40_000), txs[0].Gas)
+	require.Equal(t, uint64(20_000), txs[3].Gas)
+
+	_, err = tx.PackMsgs(clientCtx, txf, batchMsgGas, msgs...)
+	require.ErrorContains(t, err, "more than the gas limit")
+}
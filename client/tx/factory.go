@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 	"strings"
+	"time"
 
 	"cosmossdk.io/math"
 	"github.com/spf13/pflag"
@@ -32,6 +33,8 @@ type Factory struct {
 	sequence           uint64
 	gas                uint64
 	timeoutHeight      uint64
+	timeoutTimestamp   time.Time
+	unordered          bool
 	gasAdjustment      float64
 	chainID            string
 	offline            bool
@@ -79,6 +82,12 @@ func NewFactoryCLI(clientCtx client.Context, flagSet *pflag.FlagSet) (Factory, e
 	gasAdj, _ := flagSet.GetFloat64(flags.FlagGasAdjustment)
 	memo, _ := flagSet.GetString(flags.FlagNote)
 	timeoutHeight, _ := flagSet.GetUint64(flags.FlagTimeoutHeight)
+	unordered, _ := flagSet.GetBool(flags.FlagUnordered)
+
+	var timeoutTimestamp time.Time
+	if timeoutDuration, _ := flagSet.GetDuration(flags.FlagTimeoutDuration); timeoutDuration > 0 {
+		timeoutTimestamp = time.Now().Add(timeoutDuration)
+	}
 
 	gasStr, _ := flagSet.GetString(flags.FlagGas)
 	gasSetting, _ := flags.ParseGasSetting(gasStr)
@@ -95,6 +104,8 @@ func NewFactoryCLI(clientCtx client.Context, flagSet *pflag.FlagSet) (Factory, e
 		accountNumber:      accNum,
 		sequence:           accSeq,
 		timeoutHeight:      timeoutHeight,
+		timeoutTimestamp:   timeoutTimestamp,
+		unordered:          unordered,
 		gasAdjustment:      gasAdj,
 		memo:               memo,
 		signMode:           signMode,
@@ -129,6 +140,8 @@ func (f Factory) Fees() sdk.Coins                           { return f.fees }
 func (f Factory) GasPrices() sdk.DecCoins                   { return f.gasPrices }
 func (f Factory) AccountRetriever() client.AccountRetriever { return f.accountRetriever }
 func (f Factory) TimeoutHeight() uint64                     { return f.timeoutHeight }
+func (f Factory) TimeoutTimestamp() time.Time               { return f.timeoutTimestamp }
+func (f Factory) Unordered() bool                           { return f.unordered }
 
 // SimulateAndExecute returns the option to simulate and then execute the transaction
 // using the gas from the simulation results
@@ -248,6 +261,19 @@ func (f Factory) WithTimeoutHeight(height uint64) Factory {
 	return f
 }
 
+// WithTimeoutTimestamp returns a copy of the Factory with an updated timeout
+// timestamp.
+func (f Factory) WithTimeoutTimestamp(timestamp time.Time) Factory {
+	f.timeoutTimestamp = timestamp
+	return f
+}
+
+// WithUnordered returns a copy of the Factory with an updated unordered field.
+func (f Factory) WithUnordered(v bool) Factory {
+	f.unordered = v
+	return f
+}
+
 // WithFeeGranter returns a copy of the Factory with an updated fee granter.
 func (f Factory) WithFeeGranter(fg sdk.AccAddress) Factory {
 	f.feeGranter = fg
@@ -340,6 +366,20 @@ func (f Factory) BuildUnsignedTx(msgs ...sdk.Msg) (client.TxBuilder, error) {
 		return nil, errors.New("cannot provide a valid mnemonic seed in the memo field")
 	}
 
+	if !f.timeoutTimestamp.IsZero() && f.signMode == signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON {
+		return nil, fmt.Errorf("timeout timestamps cannot be signed with %s", f.signMode)
+	}
+
+	if f.unordered {
+		if f.timeoutHeight == 0 && f.timeoutTimestamp.IsZero() {
+			return nil, errors.New("timeout height or timeout timestamp must be set for unordered transactions")
+		}
+
+		if f.signMode == signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON {
+			return nil, fmt.Errorf("unordered transactions cannot be signed with %s", f.signMode)
+		}
+	}
+
 	tx := f.txConfig.NewTxBuilder()
 
 	if err := tx.SetMsgs(msgs...); err != nil {
@@ -352,6 +392,12 @@ func (f Factory) BuildUnsignedTx(msgs ...sdk.Msg) (client.TxBuilder, error) {
 	tx.SetFeeGranter(f.feeGranter)
 	tx.SetFeePayer(f.feePayer)
 	tx.SetTimeoutHeight(f.TimeoutHeight())
+	if !f.timeoutTimestamp.IsZero() {
+		tx.SetTimeoutTimestamp(f.timeoutTimestamp)
+	}
+	if f.unordered {
+		tx.SetUnordered(true)
+	}
 
 	if etx, ok := tx.(client.ExtendedTxBuilder); ok {
 		etx.SetExtensionOptions(f.extOptions...)
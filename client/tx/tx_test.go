@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc"
@@ -154,6 +155,38 @@ func TestBuildUnsignedTx(t *testing.T) {
 	require.Empty(t, sigs)
 }
 
+func TestBuildUnsignedUnorderedTx(t *testing.T) {
+	txConfig, _ := newTestTxConfig()
+	msg := banktypes.NewMsgSend(sdk.AccAddress("from"), sdk.AccAddress("to"), nil)
+
+	txf := mockTxFactory(txConfig).WithUnordered(true)
+	_, err := txf.BuildUnsignedTx(msg)
+	require.ErrorContains(t, err, "timeout height or timeout timestamp must be set")
+
+	txf = txf.WithTimeoutHeight(10)
+	_, err = txf.WithSignMode(signingtypes.SignMode_SIGN_MODE_LEGACY_AMINO_JSON).BuildUnsignedTx(msg)
+	require.ErrorContains(t, err, "unordered transactions cannot be signed")
+
+	tx, err := txf.BuildUnsignedTx(msg)
+	require.NoError(t, err)
+	unorderedTx, ok := tx.GetTx().(sdk.TxWithUnordered)
+	require.True(t, ok)
+	require.True(t, unorderedTx.GetUnordered())
+	require.Equal(t, uint64(10), unorderedTx.GetTimeoutHeight())
+
+	timeout := time.Unix(100, 0)
+	txf = txf.WithTimeoutHeight(0).WithTimeoutTimestamp(timeout)
+	_, err = txf.WithSignMode(signingtypes.SignMode_SIGN_MODE_LEGACY_AMINO_JSON).BuildUnsignedTx(msg)
+	require.ErrorContains(t, err, "timeout timestamps cannot be signed")
+
+	tx, err = txf.BuildUnsignedTx(msg)
+	require.NoError(t, err)
+	unorderedTx, ok = tx.GetTx().(sdk.TxWithUnordered)
+	require.True(t, ok)
+	require.True(t, unorderedTx.GetUnordered())
+	require.True(t, timeout.Equal(unorderedTx.GetTimeoutTimeStamp()))
+}
+
 func TestBuildUnsignedTxWithWithExtensionOptions(t *testing.T) {
 	txCfg := moduletestutil.MakeBuilderTestTxConfig()
 	extOpts := []*codectypes.Any{
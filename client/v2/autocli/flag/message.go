@@ -37,7 +37,7 @@ func (m MessageBinder) Bind(msg protoreflect.Message, positionalArgs []string) e
 		}
 
 		name := fmt.Sprintf("%d", i)
-		if i == n-1 && m.hasVarargs {
+		if i == len(m.positionalArgs)-1 && m.hasVarargs {
 			for _, v := range positionalArgs[i:] {
 				err := m.positionalFlagSet.Set(name, v)
 				if err != nil {
@@ -88,11 +88,13 @@ func (f fieldBinding) bind(msg protoreflect.Message) error {
 	if err != nil {
 		return err
 	}
-	kind := f.field.Kind()
-	if !(field.IsList() ||
-		field.IsMap() ||
-		kind == protoreflect.MessageKind ||
-		kind == protoreflect.GroupKind) {
+	if field.IsMap() {
+		return nil
+	}
+
+	// message values, such as the ones parsed from JSON, are not necessarily
+	// the field of the message they were given, so they are set as well
+	if val.IsValid() {
 		msg.Set(f.field, val)
 	}
 	return nil
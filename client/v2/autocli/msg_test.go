@@ -210,6 +210,41 @@ func TestEverythingMsg(t *testing.T) {
 	assert.Equal(t, output.GetPositional2(), "abc")
 	assert.Equal(t, output.GetABool(), true)
 	assert.Equal(t, output.GetAnEnum(), testpb.Enum_ENUM_TWO)
+	// message fields are bound to the message
+	assert.Equal(t, output.GetAMessage().GetBar(), "abc")
+	assert.Equal(t, output.GetAMessage().GetBaz(), int32(-3))
+	assert.Equal(t, output.GetACoin().GetAmount(), "10000000")
+	assert.Equal(t, output.GetTimestamp().AsTime().Unix(), int64(1546387262))
+	assert.Equal(t, output.GetDuration().AsDuration().String(), "4h0m3s")
+	assert.Equal(t, len(output.GetPositional3Varargs()), 2)
+}
+
+func TestMsgMessagePositionalArg(t *testing.T) {
+	conn := testExecCommon(t,
+		func(moduleName string, b *Builder) (*cobra.Command, error) {
+			cmd := topLevelCmd(moduleName, fmt.Sprintf("Transactions commands for the %s module", moduleName))
+			err := b.AddMsgServiceCommands(cmd, &autocliv1.ServiceCommandDescriptor{
+				Service: testpb.Msg_ServiceDesc.ServiceName,
+				RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+					{
+						RpcMethod: "Send",
+						Use:       "send [a_message_json]",
+						PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+							{ProtoField: "a_message"},
+						},
+					},
+				},
+			})
+			return cmd, err
+		},
+		"send", `{"bar":"abc", "baz":-3}`,
+		"--output", "json",
+	)
+	var output testpb.MsgRequest
+	err := protojson.Unmarshal(conn.out.Bytes(), &output)
+	assert.NilError(t, err)
+	assert.Equal(t, output.GetAMessage().GetBar(), "abc")
+	assert.Equal(t, output.GetAMessage().GetBaz(), int32(-3))
 }
 
 func TestHelpMsg(t *testing.T) {
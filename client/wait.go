@@ -0,0 +1,178 @@
+package client
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
+	rpchttp "github.com/cometbft/cometbft/rpc/client/http"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+// waitTxPollInterval is the interval between the queries of the tx service
+// when waiting for the inclusion of a transaction without event subscription.
+const waitTxPollInterval = time.Second
+
+// WaitForTx waits for the inclusion in a block of the transaction of the given
+// hex encoded hash, and returns the result of its execution, with its events.
+// It subscribes to the event of the transaction if the CometBFT RPC client
+// supports event subscriptions, and polls the tx service otherwise. It fails if
+// the transaction is not included within the given timeout, unless the timeout
+// is zero.
+func (ctx Context) WaitForTx(txHash string, timeout time.Duration) (*sdk.TxResponse, error) {
+	hash, err := hex.DecodeString(txHash)
+	if err != nil {
+		return nil, fmt.Errorf("invalid transaction hash %s: %w", txHash, err)
+	}
+
+	goCtx := ctx.CmdContext
+	if goCtx == nil {
+		goCtx = context.Background()
+	}
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		goCtx, cancel = context.WithTimeout(goCtx, timeout)
+		defer cancel()
+	}
+
+	res, err := ctx.subscribeTx(goCtx, hash)
+	if errors.Is(err, errNoTxSubscription) {
+		res, err = ctx.pollTx(goCtx, hash)
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		return nil, fmt.Errorf("transaction %X was not included in a block within %s: %w", hash, timeout, err)
+	}
+
+	return res, err
+}
+
+var errNoTxSubscription = errors.New("cannot subscribe to the transaction events")
+
+// subscribeTx waits for the event of the transaction of the given hash. It
+// returns errNoTxSubscription if the CometBFT RPC client does not support
+// event subscriptions, or if the subscription is closed before the event.
+func (ctx Context) subscribeTx(goCtx context.Context, hash []byte) (*sdk.TxResponse, error) {
+	node, err := ctx.GetNode()
+	if err != nil {
+		return nil, errNoTxSubscription
+	}
+
+	events, ok := node.(rpcclient.EventsClient)
+	if !ok {
+		return nil, errNoTxSubscription
+	}
+
+	// the HTTP client only supports subscriptions once its websocket is started,
+	// use a dedicated websocket to leave the client of the context untouched
+	if httpClient, ok := node.(*rpchttp.HTTP); ok && !httpClient.IsRunning() {
+		ws, err := rpchttp.New(httpClient.Remote(), "/websocket")
+		if err != nil {
+			return nil, errNoTxSubscription
+		}
+		if err := ws.Start(); err != nil {
+			return nil, errNoTxSubscription
+		}
+		defer ws.Stop() //nolint:errcheck // the websocket is not used anymore
+
+		events = ws
+	}
+
+	const subscriber = "cosmos-sdk/client"
+	query := fmt.Sprintf("%s='%s' AND %s='%X'", cmttypes.EventTypeKey, cmttypes.EventTx, cmttypes.TxHashKey, hash)
+	out, err := events.Subscribe(goCtx, subscriber, query)
+	if err != nil {
+		return nil, errNoTxSubscription
+	}
+	defer events.Unsubscribe(context.Background(), subscriber, query) //nolint:errcheck // the subscription is not used anymore
+
+	// the transaction may have been included before the subscription
+	if res, err := ctx.queryTx(goCtx, hash); err == nil {
+		return res, nil
+	}
+
+	for {
+		select {
+		case <-goCtx.Done():
+			return nil, goCtx.Err()
+
+		case event, ok := <-out:
+			if !ok {
+				return nil, errNoTxSubscription
+			}
+
+			data, ok := event.Data.(cmttypes.EventDataTx)
+			if !ok {
+				continue
+			}
+
+			return ctx.txResponseFromEvent(goCtx, node, hash, data)
+		}
+	}
+}
+
+// pollTx queries the tx service for the transaction of the given hash until it
+// is included in a block.
+func (ctx Context) pollTx(goCtx context.Context, hash []byte) (*sdk.TxResponse, error) {
+	ticker := time.NewTicker(waitTxPollInterval)
+	defer ticker.Stop()
+
+	for {
+		if res, err := ctx.queryTx(goCtx, hash); err == nil {
+			return res, nil
+		}
+
+		select {
+		case <-goCtx.Done():
+			return nil, goCtx.Err()
+
+		case <-ticker.C:
+		}
+	}
+}
+
+// queryTx queries the tx service for the transaction of the given hash.
+func (ctx Context) queryTx(goCtx context.Context, hash []byte) (*sdk.TxResponse, error) {
+	res, err := tx.NewServiceClient(ctx).GetTx(goCtx, &tx.GetTxRequest{Hash: fmt.Sprintf("%X", hash)})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.TxResponse, nil
+}
+
+// txResponseFromEvent returns the response of the transaction of an event, whose
+// timestamp is the time of its block.
+func (ctx Context) txResponseFromEvent(goCtx context.Context, node CometRPC, hash []byte, data cmttypes.EventDataTx) (*sdk.TxResponse, error) {
+	decoded, err := ctx.TxConfig.TxDecoder()(data.Tx)
+	if err != nil {
+		return nil, err
+	}
+
+	p, ok := decoded.(interface{ AsAny() *codectypes.Any })
+	if !ok {
+		return nil, fmt.Errorf("expecting a type implementing AsAny, got: %T", decoded)
+	}
+
+	block, err := node.Block(goCtx, &data.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	resTx := &coretypes.ResultTx{
+		Hash:     hash,
+		Height:   data.Height,
+		Index:    data.Index,
+		TxResult: data.Result,
+		Tx:       data.Tx,
+	}
+
+	return sdk.NewResponseResultTx(resTx, p.AsAny(), block.Block.Time.Format(time.RFC3339)), nil
+}
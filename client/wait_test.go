@@ -0,0 +1,183 @@
+package client_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtbytes "github.com/cometbft/cometbft/libs/bytes"
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+)
+
+// waitNode is a mock CometBFT node whose tx service finds the transactions
+// after a number of queries.
+type waitNode struct {
+	client.CometRPC
+
+	t           *testing.T
+	foundAfter  int
+	queries     int
+	txResponse  *sdk.TxResponse
+	broadcastTx cmttypes.Tx
+}
+
+func (n *waitNode) BroadcastTxSync(_ context.Context, tx cmttypes.Tx) (*coretypes.ResultBroadcastTx, error) {
+	n.broadcastTx = tx
+	return &coretypes.ResultBroadcastTx{Hash: tx.Hash()}, nil
+}
+
+func (n *waitNode) ABCIQueryWithOptions(_ context.Context, path string, data cmtbytes.HexBytes, _ rpcclient.ABCIQueryOptions) (*coretypes.ResultABCIQuery, error) {
+	require.Equal(n.t, "/cosmos.tx.v1beta1.Service/GetTx", path)
+
+	var req txtypes.GetTxRequest
+	require.NoError(n.t, req.Unmarshal(data))
+	require.Equal(n.t, fmt.Sprintf("%X", n.broadcastTx.Hash()), req.Hash)
+
+	n.queries++
+	if n.queries <= n.foundAfter {
+		return nil, errors.New("tx not found")
+	}
+
+	bz, err := (&txtypes.GetTxResponse{TxResponse: n.txResponse}).Marshal()
+	require.NoError(n.t, err)
+
+	return &coretypes.ResultABCIQuery{Response: abci.ResponseQuery{Value: bz}}, nil
+}
+
+// eventsNode is a waitNode publishing the events of the transactions.
+type eventsNode struct {
+	*waitNode
+
+	blockTime    time.Time
+	result       abci.ResponseDeliverTx
+	unsubscribed bool
+	// dropped closes the subscriptions without publishing the events
+	dropped bool
+}
+
+func (n *eventsNode) Subscribe(_ context.Context, _, query string, _ ...int) (<-chan coretypes.ResultEvent, error) {
+	require.Equal(n.t, fmt.Sprintf("tm.event='Tx' AND tx.hash='%X'", n.broadcastTx.Hash()), query)
+
+	out := make(chan coretypes.ResultEvent, 1)
+	if n.dropped {
+		close(out)
+		return out, nil
+	}
+
+	out <- coretypes.ResultEvent{
+		Query: query,
+		Data: cmttypes.EventDataTx{TxResult: abci.TxResult{
+			Height: 12,
+			Tx:     n.broadcastTx,
+			Result: n.result,
+		}},
+	}
+
+	return out, nil
+}
+
+func (n *eventsNode) Unsubscribe(context.Context, string, string) error {
+	n.unsubscribed = true
+	return nil
+}
+
+func (n *eventsNode) UnsubscribeAll(context.Context, string) error {
+	return nil
+}
+
+func (n *eventsNode) Block(_ context.Context, height *int64) (*coretypes.ResultBlock, error) {
+	require.Equal(n.t, int64(12), *height)
+	return &coretypes.ResultBlock{Block: &cmttypes.Block{Header: cmttypes.Header{Height: 12, Time: n.blockTime}}}, nil
+}
+
+var _ rpcclient.EventsClient = (*eventsNode)(nil)
+
+func TestWaitForTx(t *testing.T) {
+	interfaceRegistry := types.NewInterfaceRegistry()
+	testdata.RegisterInterfaces(interfaceRegistry)
+	txConfig := authtx.NewTxConfig(codec.NewProtoCodec(interfaceRegistry), authtx.DefaultSignModes)
+
+	builder := txConfig.NewTxBuilder()
+	require.NoError(t, builder.SetMsgs(testdata.NewTestMsg()))
+	txBytes, err := txConfig.TxEncoder()(builder.GetTx())
+	require.NoError(t, err)
+	txHash := fmt.Sprintf("%X", cmttypes.Tx(txBytes).Hash())
+
+	ctx := client.Context{}.
+		WithTxConfig(txConfig).
+		WithInterfaceRegistry(interfaceRegistry).
+		WithBroadcastMode(flags.BroadcastSync)
+
+	// without event subscription, the tx service is polled until it finds the transaction
+	node := &waitNode{t: t, foundAfter: 1, txResponse: &sdk.TxResponse{TxHash: txHash, Height: 10}}
+	ctx = ctx.WithClient(node)
+	res, err := ctx.BroadcastTx(txBytes)
+	require.NoError(t, err)
+	require.Equal(t, int64(0), res.Height)
+
+	res, err = ctx.WithWait(true, time.Minute).BroadcastTx(txBytes)
+	require.NoError(t, err)
+	require.Equal(t, node.txResponse, res)
+	require.Equal(t, 2, node.queries)
+
+	// the transaction is not included within the timeout
+	node.queries, node.foundAfter = 0, 100
+	_, err = ctx.WaitForTx(txHash, 10*time.Millisecond)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+
+	// with event subscription, the response of the transaction is built from its event
+	events := &eventsNode{
+		waitNode:  node,
+		blockTime: time.Date(2023, 5, 1, 12, 0, 0, 0, time.UTC),
+		result: abci.ResponseDeliverTx{
+			Code:      0,
+			GasWanted: 200000,
+			GasUsed:   50000,
+			Events:    []abci.Event{{Type: "message", Attributes: []abci.EventAttribute{{Key: "action", Value: "test"}}}},
+		},
+	}
+	node.queries = 0
+	ctx = ctx.WithClient(events).WithWait(true, time.Minute)
+	res, err = ctx.BroadcastTx(txBytes)
+	require.NoError(t, err)
+	require.Equal(t, 1, node.queries)
+	require.True(t, events.unsubscribed)
+	require.Equal(t, txHash, res.TxHash)
+	require.Equal(t, int64(12), res.Height)
+	require.Equal(t, int64(50000), res.GasUsed)
+	require.Equal(t, events.result.Events, res.Events)
+	require.Equal(t, "2023-05-01T12:00:00Z", res.Timestamp)
+	require.NotNil(t, res.Tx)
+
+	// the transaction included before the subscription is returned by the tx service
+	node.queries, node.foundAfter = 0, 0
+	res, err = ctx.WaitForTx(txHash, time.Minute)
+	require.NoError(t, err)
+	require.Equal(t, node.txResponse, res)
+
+	// the tx service is polled once the subscription is dropped
+	node.queries, node.foundAfter = 0, 1
+	events.dropped = true
+	res, err = ctx.WaitForTx(txHash, time.Minute)
+	require.NoError(t, err)
+	require.Equal(t, node.txResponse, res)
+	require.Equal(t, 2, node.queries)
+
+	_, err = ctx.WaitForTx("not hex", time.Minute)
+	require.ErrorContains(t, err, "invalid transaction hash")
+}
@@ -1,6 +1,7 @@
 package collections
 
 import (
+	"encoding/json"
 	"errors"
 	"math"
 
@@ -83,6 +84,15 @@ type collection interface {
 	// getPrefix is the unique prefix of the collection within a schema.
 	getPrefix() []byte
 
+	// keyType and valueType are the human-readable names of the key and value
+	// types of the collection.
+	keyType() string
+	valueType() string
+
+	// decodeJSON decodes the provided raw key, stripped of the collection prefix,
+	// and raw value into their JSON representation. A nil value is decoded to nil.
+	decodeJSON(key, value []byte) (keyJSON, valueJSON json.RawMessage, err error)
+
 	genesisHandler
 }
 
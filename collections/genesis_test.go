@@ -18,11 +18,12 @@ func TestDefaultGenesis(t *testing.T) {
 		writers = append(writers, w)
 		return w, nil
 	}))
-	require.Len(t, writers, 4)
+	require.Len(t, writers, 5)
 	require.Equal(t, `[]`, writers[0].Buffer.String())
 	require.Equal(t, `[]`, writers[1].Buffer.String())
 	require.Equal(t, `[]`, writers[2].Buffer.String())
 	require.Equal(t, `[]`, writers[3].Buffer.String())
+	require.Equal(t, `[]`, writers[4].Buffer.String())
 }
 
 func TestValidateGenesis(t *testing.T) {
@@ -61,6 +62,18 @@ func TestImportGenesis(t *testing.T) {
 	seq, err := f.s.Peek(f.ctx)
 	require.NoError(t, err)
 	require.Equal(t, uint64(1000), seq)
+
+	// assert vec correct genesis
+	vecIt, err := f.v.Iterate(f.ctx, nil)
+	require.NoError(t, err)
+	defer vecIt.Close()
+
+	values, err := vecIt.Values()
+	require.NoError(t, err)
+	require.Equal(t, []string{"a", "b", "c"}, values)
+	length, err := f.v.Len(f.ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), length)
 }
 
 func TestExportGenesis(t *testing.T) {
@@ -73,11 +86,12 @@ func TestExportGenesis(t *testing.T) {
 		writers = append(writers, w)
 		return w, nil
 	}))
-	require.Len(t, writers, 4)
+	require.Len(t, writers, 5)
 	require.Equal(t, expectedItemGenesis, writers[0].Buffer.String())
 	require.Equal(t, expectedKeySetGenesis, writers[1].Buffer.String())
 	require.Equal(t, expectedMapGenesis, writers[2].Buffer.String())
 	require.Equal(t, expectedSequenceGenesis, writers[3].Buffer.String())
+	require.Equal(t, expectedVecGenesis, writers[4].Buffer.String())
 }
 
 type testFixture struct {
@@ -87,6 +101,7 @@ type testFixture struct {
 	i      Item[string]
 	s      Sequence
 	ks     KeySet[string]
+	v      Vec[string]
 }
 
 func initFixture(t *testing.T) *testFixture {
@@ -96,6 +111,7 @@ func initFixture(t *testing.T) *testFixture {
 	i := NewItem(schemaBuilder, NewPrefix(2), "item", StringValue)
 	s := NewSequence(schemaBuilder, NewPrefix(3), "sequence")
 	ks := NewKeySet(schemaBuilder, NewPrefix(4), "key_set", StringKey)
+	v := NewVec(schemaBuilder, NewPrefix(5), "vec", StringValue)
 	schema, err := schemaBuilder.Build()
 	require.NoError(t, err)
 	return &testFixture{
@@ -105,11 +121,12 @@ func initFixture(t *testing.T) *testFixture {
 		i:      i,
 		s:      s,
 		ks:     ks,
+		v:      v,
 	}
 }
 
 func createTestGenesisSource(t *testing.T) appmodule.GenesisSource {
-	expectedOrder := []string{"item", "key_set", "map", "sequence"}
+	expectedOrder := []string{"item", "key_set", "map", "sequence", "vec"}
 	currentIndex := 0
 	return func(field string) (io.ReadCloser, error) {
 		require.Equal(t, expectedOrder[currentIndex], field, "unordered genesis")
@@ -124,6 +141,8 @@ func createTestGenesisSource(t *testing.T) appmodule.GenesisSource {
 			return newBufCloser(t, expectedKeySetGenesis), nil
 		case "sequence":
 			return newBufCloser(t, expectedSequenceGenesis), nil
+		case "vec":
+			return newBufCloser(t, expectedVecGenesis), nil
 		default:
 			return nil, nil
 		}
@@ -135,6 +154,7 @@ const (
 	expectedItemGenesis     = `[{"key":"item","value":"superCoolItem"}]`
 	expectedKeySetGenesis   = `[{"key":"0"},{"key":"1"},{"key":"2"}]`
 	expectedSequenceGenesis = `[{"key":"item","value":"1000"}]`
+	expectedVecGenesis      = `["a","b","c"]`
 )
 
 type bufCloser struct {
@@ -0,0 +1,141 @@
+package indexes
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/codec"
+)
+
+// RotatedTriple is an index that is used with collections.Triple keys. It indexes objects by the
+// second and third parts of the key. When the value is being indexed by collections.IndexedMap then
+// RotatedTriple will create a relationship between the second and third parts of the primary key and
+// the first part, by storing the primary key rotated as Join3(K2, K3, K1).
+// Example: given balances keyed by (owner, denom, id), the index allows to find all the balances of
+// a denom, or the owner of the (denom, id) tuple.
+type RotatedTriple[K1, K2, K3, Value any] struct {
+	refKeys collections.KeySet[collections.Triple[K2, K3, K1]] // refKeys has the relationships between Join3(K2, K3, K1)
+}
+
+// tripleKeyCodec is an interface to cast a collections.KeyCodec to a triple codec,
+// see pairKeyCodec.
+type tripleKeyCodec[K1, K2, K3 any] interface {
+	KeyCodec1() codec.KeyCodec[K1]
+	KeyCodec2() codec.KeyCodec[K2]
+	KeyCodec3() codec.KeyCodec[K3]
+}
+
+// NewRotatedTriple instantiates a new RotatedTriple index.
+// NOTE: when using this function you will need to type hint: doing NewRotatedTriple[Value]()
+// Example: if the value of the indexed map is string, you need to do NewRotatedTriple[string](...)
+func NewRotatedTriple[Value, K1, K2, K3 any](
+	sb *collections.SchemaBuilder,
+	prefix collections.Prefix,
+	name string,
+	tripleCodec codec.KeyCodec[collections.Triple[K1, K2, K3]],
+) *RotatedTriple[K1, K2, K3, Value] {
+	tkc := tripleCodec.(tripleKeyCodec[K1, K2, K3])
+	return &RotatedTriple[K1, K2, K3, Value]{
+		refKeys: collections.NewKeySet(
+			sb, prefix, name,
+			collections.TripleKeyCodec(tkc.KeyCodec2(), tkc.KeyCodec3(), tkc.KeyCodec1()),
+		),
+	}
+}
+
+// Iterate exposes the raw iterator API.
+func (i *RotatedTriple[K1, K2, K3, Value]) Iterate(ctx context.Context, ranger collections.Ranger[collections.Triple[K2, K3, K1]]) (iter RotatedTripleIterator[K2, K3, K1], err error) {
+	sIter, err := i.refKeys.Iterate(ctx, ranger)
+	if err != nil {
+		return
+	}
+	return (RotatedTripleIterator[K2, K3, K1])(sIter), nil
+}
+
+// MatchExact will return an iterator containing only the primary keys with the provided second and
+// third parts of the multipart triple key.
+func (i *RotatedTriple[K1, K2, K3, Value]) MatchExact(ctx context.Context, k2 K2, k3 K3) (RotatedTripleIterator[K2, K3, K1], error) {
+	return i.Iterate(ctx, collections.NewSuperPrefixedTripleRange[K2, K3, K1](k2, k3))
+}
+
+// MatchPrefix will return an iterator containing only the primary keys with the provided second part
+// of the multipart triple key.
+func (i *RotatedTriple[K1, K2, K3, Value]) MatchPrefix(ctx context.Context, k2 K2) (RotatedTripleIterator[K2, K3, K1], error) {
+	return i.Iterate(ctx, collections.NewPrefixedTripleRange[K2, K3, K1](k2))
+}
+
+// Reference implements collections.Index
+func (i *RotatedTriple[K1, K2, K3, Value]) Reference(ctx context.Context, pk collections.Triple[K1, K2, K3], _ Value, _ func() (Value, error)) error {
+	return i.refKeys.Set(ctx, collections.Join3(pk.K2(), pk.K3(), pk.K1()))
+}
+
+// Unreference implements collections.Index
+func (i *RotatedTriple[K1, K2, K3, Value]) Unreference(ctx context.Context, pk collections.Triple[K1, K2, K3], _ func() (Value, error)) error {
+	return i.refKeys.Remove(ctx, collections.Join3(pk.K2(), pk.K3(), pk.K1()))
+}
+
+func (i *RotatedTriple[K1, K2, K3, Value]) Walk(
+	ctx context.Context,
+	ranger collections.Ranger[collections.Triple[K2, K3, K1]],
+	walkFunc func(indexingKey2 K2, indexingKey3 K3, indexedKey K1) bool,
+) error {
+	return i.refKeys.Walk(ctx, ranger, func(key collections.Triple[K2, K3, K1]) bool {
+		return walkFunc(key.K1(), key.K2(), key.K3())
+	})
+}
+
+func (i *RotatedTriple[K1, K2, K3, Value]) IterateRaw(
+	ctx context.Context, start, end []byte, order collections.Order,
+) (
+	iter collections.Iterator[collections.Triple[K2, K3, K1], collections.NoValue], err error,
+) {
+	return i.refKeys.IterateRaw(ctx, start, end, order)
+}
+
+func (i *RotatedTriple[K1, K2, K3, Value]) KeyCodec() codec.KeyCodec[collections.Triple[K2, K3, K1]] {
+	return i.refKeys.KeyCodec()
+}
+
+// RotatedTripleIterator is a helper type around a collections.KeySetIterator when used to work
+// with RotatedTriple indexes iterations.
+type RotatedTripleIterator[K2, K3, K1 any] collections.KeySetIterator[collections.Triple[K2, K3, K1]]
+
+// PrimaryKey returns the primary key from the index. The index is composed like a rotated
+// triple key. So we just fetch the triple key from the index and rotate it back.
+func (m RotatedTripleIterator[K2, K3, K1]) PrimaryKey() (triple collections.Triple[K1, K2, K3], err error) {
+	rotated, err := m.FullKey()
+	if err != nil {
+		return triple, err
+	}
+	triple = collections.Join3(rotated.K3(), rotated.K1(), rotated.K2())
+	return triple, nil
+}
+
+// PrimaryKeys returns all the primary keys contained in the iterator.
+func (m RotatedTripleIterator[K2, K3, K1]) PrimaryKeys() (triples []collections.Triple[K1, K2, K3], err error) {
+	defer m.Close()
+	for ; m.Valid(); m.Next() {
+		triple, err := m.PrimaryKey()
+		if err != nil {
+			return nil, err
+		}
+		triples = append(triples, triple)
+	}
+	return triples, err
+}
+
+func (m RotatedTripleIterator[K2, K3, K1]) FullKey() (t collections.Triple[K2, K3, K1], err error) {
+	return (collections.KeySetIterator[collections.Triple[K2, K3, K1]])(m).Key()
+}
+
+func (m RotatedTripleIterator[K2, K3, K1]) Next() {
+	(collections.KeySetIterator[collections.Triple[K2, K3, K1]])(m).Next()
+}
+
+func (m RotatedTripleIterator[K2, K3, K1]) Valid() bool {
+	return (collections.KeySetIterator[collections.Triple[K2, K3, K1]])(m).Valid()
+}
+
+func (m RotatedTripleIterator[K2, K3, K1]) Close() error {
+	return (collections.KeySetIterator[collections.Triple[K2, K3, K1]])(m).Close()
+}
@@ -0,0 +1,79 @@
+package indexes
+
+import (
+	"testing"
+
+	"cosmossdk.io/collections"
+	"github.com/stretchr/testify/require"
+)
+
+type ID = uint64
+
+// our nft balance index, allows us to efficiently create an index between the key that maps
+// nft balances which is a collections.Triple[Address, Denom, ID] and the (Denom, ID) tuple.
+type nftBalanceIndex struct {
+	DenomID *RotatedTriple[Address, Denom, ID, Amount]
+}
+
+func (b nftBalanceIndex) IndexesList() []collections.Index[collections.Triple[Address, Denom, ID], Amount] {
+	return []collections.Index[collections.Triple[Address, Denom, ID], Amount]{b.DenomID}
+}
+
+func TestRotatedTriple(t *testing.T) {
+	sk, ctx := deps()
+	sb := collections.NewSchemaBuilder(sk)
+	// we create an indexed map that maps nft balances, which are saved as
+	// key: Triple[Address, Denom, ID]
+	// value: Amount
+	keyCodec := collections.TripleKeyCodec(collections.StringKey, collections.StringKey, collections.Uint64Key)
+
+	indexedMap := collections.NewIndexedMap(
+		sb,
+		collections.NewPrefix("balances"), "balances",
+		keyCodec,
+		collections.Uint64Value,
+		nftBalanceIndex{
+			DenomID: NewRotatedTriple[Amount](sb, collections.NewPrefix("denom_id_index"), "denom_id_index", keyCodec),
+		},
+	)
+
+	err := indexedMap.Set(ctx, collections.Join3("address1", "kitty", uint64(1)), 1)
+	require.NoError(t, err)
+
+	err = indexedMap.Set(ctx, collections.Join3("address1", "punk", uint64(1)), 1)
+	require.NoError(t, err)
+
+	err = indexedMap.Set(ctx, collections.Join3("address2", "punk", uint64(2)), 1)
+	require.NoError(t, err)
+
+	// assert if we match (punk, 2) we find address2
+	iter, err := indexedMap.Indexes.DenomID.MatchExact(ctx, "punk", 2)
+	require.NoError(t, err)
+
+	pks, err := iter.PrimaryKeys()
+	require.NoError(t, err)
+	require.Equal(t, []collections.Triple[Address, Denom, ID]{collections.Join3("address2", "punk", uint64(2))}, pks)
+
+	// assert if we match punk we find address1 and address2
+	iter, err = indexedMap.Indexes.DenomID.MatchPrefix(ctx, "punk")
+	require.NoError(t, err)
+
+	pks, err = iter.PrimaryKeys()
+	require.NoError(t, err)
+	require.Equal(t, []collections.Triple[Address, Denom, ID]{
+		collections.Join3("address1", "punk", uint64(1)),
+		collections.Join3("address2", "punk", uint64(2)),
+	}, pks)
+
+	// assert the index is cleaned up on removal
+	err = indexedMap.Remove(ctx, collections.Join3("address1", "punk", uint64(1)))
+	require.NoError(t, err)
+
+	var found []Address
+	err = indexedMap.Indexes.DenomID.Walk(ctx, nil, func(denom Denom, id ID, address Address) bool {
+		found = append(found, address)
+		return false
+	})
+	require.NoError(t, err)
+	require.Equal(t, []Address{"address1", "address2"}, found)
+}
@@ -167,19 +167,7 @@ func iteratorFromRanger[K, V any](ctx context.Context, m Map[K, V], r Ranger[K])
 }
 
 func newIterator[K, V any](ctx context.Context, start, end []byte, order Order, m Map[K, V]) (Iterator[K, V], error) {
-	kv := m.sa(ctx)
-	var (
-		iter store.Iterator
-		err  error
-	)
-	switch order {
-	case OrderAscending:
-		iter, err = kv.Iterator(start, end)
-	case OrderDescending:
-		iter, err = kv.ReverseIterator(start, end)
-	default:
-		return Iterator[K, V]{}, errOrder
-	}
+	iter, err := m.iterator(ctx, start, end, order)
 	if err != nil {
 		return Iterator[K, V]{}, err
 	}
@@ -210,6 +198,9 @@ type Iterator[K, V any] struct {
 
 // Value returns the current iterator value bytes decoded.
 func (i Iterator[K, V]) Value() (V, error) {
+	if iter, ok := i.iter.(objectIterator); ok {
+		return objectValue[V](iter.ObjectIterator.Value())
+	}
 	return i.vc.Decode(i.iter.Value())
 }
 
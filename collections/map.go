@@ -2,6 +2,7 @@ package collections
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 
 	"cosmossdk.io/collections/codec"
@@ -17,7 +18,9 @@ type Map[K, V any] struct {
 	vc codec.ValueCodec[V]
 
 	// store accessor
-	sa     func(context.Context) store.KVStore
+	sa func(context.Context) store.KVStore
+	// object store accessor, set instead of sa for the maps of an object store
+	oa     func(context.Context) ObjectStore
 	prefix []byte
 	name   string
 }
@@ -32,15 +35,28 @@ func NewMap[K, V any](
 	keyCodec codec.KeyCodec[K],
 	valueCodec codec.ValueCodec[V],
 ) Map[K, V] {
-	m := Map[K, V]{
+	m := newMap(schemaBuilder, prefix, name, keyCodec, valueCodec)
+	schemaBuilder.addCollection(m)
+	return m
+}
+
+// newMap returns a Map which is not registered in the schema, it is used by
+// collections which are composed of other collections.
+func newMap[K, V any](
+	schemaBuilder *SchemaBuilder,
+	prefix Prefix,
+	name string,
+	keyCodec codec.KeyCodec[K],
+	valueCodec codec.ValueCodec[V],
+) Map[K, V] {
+	return Map[K, V]{
 		kc:     keyCodec,
 		vc:     valueCodec,
 		sa:     schemaBuilder.schema.storeAccessor,
+		oa:     schemaBuilder.schema.objectAccessor,
 		prefix: prefix.Bytes(),
 		name:   name,
 	}
-	schemaBuilder.addCollection(m)
-	return m
 }
 
 func (m Map[K, V]) getName() string {
@@ -51,6 +67,41 @@ func (m Map[K, V]) getPrefix() []byte {
 	return m.prefix
 }
 
+func (m Map[K, V]) keyType() string { return m.kc.KeyType() }
+
+func (m Map[K, V]) valueType() string { return m.vc.ValueType() }
+
+func (m Map[K, V]) decodeJSON(key, value []byte) (keyJSON, valueJSON json.RawMessage, err error) {
+	read, k, err := m.kc.Decode(key)
+	if err != nil {
+		return nil, nil, err
+	}
+	if read != len(key) {
+		return nil, nil, fmt.Errorf("%w: key decoding read %d bytes out of %d", ErrEncoding, read, len(key))
+	}
+
+	keyJSON, err = m.kc.EncodeJSON(k)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if value == nil {
+		return keyJSON, nil, nil
+	}
+
+	v, err := m.vc.Decode(value)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	valueJSON, err = m.vc.EncodeJSON(v)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return keyJSON, valueJSON, nil
+}
+
 // Set maps the provided value to the provided key in the store.
 // Errors with ErrEncoding if key or value encoding fails.
 func (m Map[K, V]) Set(ctx context.Context, key K, value V) error {
@@ -59,6 +110,10 @@ func (m Map[K, V]) Set(ctx context.Context, key K, value V) error {
 		return err
 	}
 
+	if m.oa != nil {
+		return m.oa(ctx).Set(bytesKey, value)
+	}
+
 	valueBytes, err := m.vc.Encode(value)
 	if err != nil {
 		return fmt.Errorf("%w: value encode: %s", ErrEncoding, err) // TODO: use multi err wrapping in go1.20: https://github.com/golang/go/issues/53435
@@ -78,6 +133,17 @@ func (m Map[K, V]) Get(ctx context.Context, key K) (v V, err error) {
 		return v, err
 	}
 
+	if m.oa != nil {
+		value, err := m.oa(ctx).Get(bytesKey)
+		if err != nil {
+			return v, err
+		}
+		if value == nil {
+			return v, fmt.Errorf("%w: key '%s' of type %s", ErrNotFound, m.kc.Stringify(key), m.vc.ValueType())
+		}
+		return objectValue[V](value)
+	}
+
 	kvStore := m.sa(ctx)
 	valueBytes, err := kvStore.Get(bytesKey)
 	if err != nil {
@@ -101,6 +167,9 @@ func (m Map[K, V]) Has(ctx context.Context, key K) (bool, error) {
 	if err != nil {
 		return false, err
 	}
+	if m.oa != nil {
+		return m.oa(ctx).Has(bytesKey)
+	}
 	kvStore := m.sa(ctx)
 	return kvStore.Has(bytesKey)
 }
@@ -113,6 +182,9 @@ func (m Map[K, V]) Remove(ctx context.Context, key K) error {
 	if err != nil {
 		return err
 	}
+	if m.oa != nil {
+		return m.oa(ctx).Delete(bytesKey)
+	}
 	kvStore := m.sa(ctx)
 	return kvStore.Delete(bytesKey)
 }
@@ -161,19 +233,7 @@ func (m Map[K, V]) IterateRaw(ctx context.Context, start, end []byte, order Orde
 		prefixedEnd = append(m.prefix, end...)
 	}
 
-	s := m.sa(ctx)
-	var (
-		storeIter store.Iterator
-		err       error
-	)
-	switch order {
-	case OrderAscending:
-		storeIter, err = s.Iterator(prefixedStart, prefixedEnd)
-	case OrderDescending:
-		storeIter, err = s.ReverseIterator(prefixedStart, prefixedEnd)
-	default:
-		return Iterator[K, V]{}, errOrder
-	}
+	storeIter, err := m.iterator(ctx, prefixedStart, prefixedEnd, order)
 	if err != nil {
 		return Iterator[K, V]{}, err
 	}
@@ -189,6 +249,39 @@ func (m Map[K, V]) IterateRaw(ctx context.Context, start, end []byte, order Orde
 	}, nil
 }
 
+// iterator returns the store iterator over the raw range, in the given order.
+func (m Map[K, V]) iterator(ctx context.Context, start, end []byte, order Order) (store.Iterator, error) {
+	if m.oa != nil {
+		s := m.oa(ctx)
+		var (
+			iter ObjectIterator
+			err  error
+		)
+		switch order {
+		case OrderAscending:
+			iter, err = s.Iterator(start, end)
+		case OrderDescending:
+			iter, err = s.ReverseIterator(start, end)
+		default:
+			return nil, errOrder
+		}
+		if err != nil {
+			return nil, err
+		}
+		return objectIterator{iter}, nil
+	}
+
+	s := m.sa(ctx)
+	switch order {
+	case OrderAscending:
+		return s.Iterator(start, end)
+	case OrderDescending:
+		return s.ReverseIterator(start, end)
+	default:
+		return nil, errOrder
+	}
+}
+
 // KeyCodec returns the Map's KeyCodec.
 func (m Map[K, V]) KeyCodec() codec.KeyCodec[K] { return m.kc }
 
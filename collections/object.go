@@ -0,0 +1,117 @@
+package collections
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"cosmossdk.io/collections/codec"
+)
+
+// ObjectStore is a store of non-serialized values, such as the object stores
+// of the SDK, which are cleared at the end of every block.
+type ObjectStore interface {
+	// Get returns nil iff key doesn't exist. Errors on nil key.
+	Get(key []byte) (any, error)
+	// Has checks if a key exists. Errors on nil key.
+	Has(key []byte) (bool, error)
+	// Set sets the key. Errors on nil key or value.
+	Set(key []byte, value any) error
+	// Delete deletes the key. Errors on nil key.
+	Delete(key []byte) error
+	// Iterator iterates over a domain of keys in ascending order. End is exclusive.
+	Iterator(start, end []byte) (ObjectIterator, error)
+	// ReverseIterator iterates over a domain of keys in descending order. End is exclusive.
+	ReverseIterator(start, end []byte) (ObjectIterator, error)
+}
+
+// ObjectIterator is the iterator of an ObjectStore.
+type ObjectIterator interface {
+	Domain() (start, end []byte)
+	Valid() bool
+	Next()
+	Key() []byte
+	Value() any
+	Error() error
+	Close() error
+}
+
+// ObjectStoreService opens the ObjectStore of a module.
+type ObjectStoreService interface {
+	OpenObjectStore(ctx context.Context) ObjectStore
+}
+
+// NewSchemaBuilderFromObjectAccessor creates a new schema builder whose
+// collections are stored in the object store returned by the provided
+// accessor function. The values of the maps of the schema are stored as is,
+// their value codec is never used. Only Map and Item support object stores.
+func NewSchemaBuilderFromObjectAccessor(accessorFunc func(ctx context.Context) ObjectStore) *SchemaBuilder {
+	return &SchemaBuilder{
+		schema: &Schema{
+			objectAccessor:      accessorFunc,
+			collectionsByName:   map[string]collection{},
+			collectionsByPrefix: map[string]collection{},
+		},
+	}
+}
+
+// NewObjectSchemaBuilder creates a new schema builder from the provided
+// object store service.
+func NewObjectSchemaBuilder(service ObjectStoreService) *SchemaBuilder {
+	return NewSchemaBuilderFromObjectAccessor(service.OpenObjectStore)
+}
+
+// NewObjectMap returns a Map stored in the object store of the schema, which
+// does not need a value codec. Its values cannot be exported to genesis.
+func NewObjectMap[K, V any](
+	schemaBuilder *SchemaBuilder,
+	prefix Prefix,
+	name string,
+	keyCodec codec.KeyCodec[K],
+) Map[K, V] {
+	return NewMap(schemaBuilder, prefix, name, keyCodec, codec.ValueCodec[V](objectValueCodec[V]{}))
+}
+
+// objectIterator adapts an ObjectIterator to a store.Iterator, Iterator reads
+// its values with objectValue.
+type objectIterator struct {
+	ObjectIterator
+}
+
+func (objectIterator) Value() []byte { return nil }
+
+// objectValue returns the value of an object store as a V.
+func objectValue[V any](value any) (v V, err error) {
+	v, ok := value.(V)
+	if !ok {
+		return v, fmt.Errorf("%w: object of type %T is not a %T", ErrEncoding, value, v)
+	}
+	return v, nil
+}
+
+// objectValueCodec is the value codec of the maps created with NewObjectMap,
+// their values are never serialized, except to JSON.
+type objectValueCodec[V any] struct{}
+
+func (objectValueCodec[V]) Encode(V) ([]byte, error) {
+	return nil, fmt.Errorf("%w: object values cannot be encoded", ErrEncoding)
+}
+
+func (objectValueCodec[V]) Decode([]byte) (v V, err error) {
+	return v, fmt.Errorf("%w: object values cannot be decoded", ErrEncoding)
+}
+
+func (objectValueCodec[V]) EncodeJSON(value V) ([]byte, error) { return json.Marshal(value) }
+
+func (objectValueCodec[V]) DecodeJSON(b []byte) (v V, err error) {
+	err = json.Unmarshal(b, &v)
+	return v, err
+}
+
+func (objectValueCodec[V]) Stringify(value V) string { return fmt.Sprintf("%v", value) }
+
+func (objectValueCodec[V]) ValueType() string {
+	var v V
+	return fmt.Sprintf("object(%s)", reflect.TypeOf(&v).Elem())
+}
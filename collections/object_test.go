@@ -0,0 +1,118 @@
+package collections
+
+import (
+	"context"
+	"testing"
+
+	db "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+)
+
+// testObjectStore keeps the keys in a database to iterate over them, and the
+// values in a map.
+type testObjectStore struct {
+	db     db.DB
+	values map[string]any
+}
+
+func (t testObjectStore) OpenObjectStore(ctx context.Context) ObjectStore {
+	return t
+}
+
+func (t testObjectStore) Get(key []byte) (any, error) {
+	return t.values[string(key)], nil
+}
+
+func (t testObjectStore) Has(key []byte) (bool, error) {
+	_, ok := t.values[string(key)]
+	return ok, nil
+}
+
+func (t testObjectStore) Set(key []byte, value any) error {
+	t.values[string(key)] = value
+	return t.db.Set(key, []byte{})
+}
+
+func (t testObjectStore) Delete(key []byte) error {
+	delete(t.values, string(key))
+	return t.db.Delete(key)
+}
+
+func (t testObjectStore) Iterator(start, end []byte) (ObjectIterator, error) {
+	it, err := t.db.Iterator(start, end)
+	return testObjectIterator{it, t.values}, err
+}
+
+func (t testObjectStore) ReverseIterator(start, end []byte) (ObjectIterator, error) {
+	it, err := t.db.ReverseIterator(start, end)
+	return testObjectIterator{it, t.values}, err
+}
+
+type testObjectIterator struct {
+	db.Iterator
+	values map[string]any
+}
+
+func (it testObjectIterator) Value() any {
+	return it.values[string(it.Key())]
+}
+
+type accumulator struct {
+	Count int
+	Addrs []string
+}
+
+func TestObjectMap(t *testing.T) {
+	service := testObjectStore{db.NewMemDB(), map[string]any{}}
+	ctx := context.Background()
+
+	sb := NewObjectSchemaBuilder(service)
+	m := NewObjectMap[string, *accumulator](sb, NewPrefix(0), "accumulators", StringKey)
+	item := NewItem[uint64](sb, NewPrefix(1), "item", Uint64Value)
+	_, err := sb.Build()
+	require.NoError(t, err)
+
+	_, err = m.Get(ctx, "a")
+	require.ErrorIs(t, err, ErrNotFound)
+
+	// values are stored as is
+	acc := &accumulator{Count: 1}
+	require.NoError(t, m.Set(ctx, "a", acc))
+	got, err := m.Get(ctx, "a")
+	require.NoError(t, err)
+	require.Same(t, acc, got)
+	got.Addrs = append(got.Addrs, "addr")
+	got, err = m.Get(ctx, "a")
+	require.NoError(t, err)
+	require.Equal(t, []string{"addr"}, got.Addrs)
+
+	require.NoError(t, m.Set(ctx, "b", &accumulator{Count: 2}))
+	has, err := m.Has(ctx, "b")
+	require.NoError(t, err)
+	require.True(t, has)
+
+	iter, err := m.Iterate(ctx, new(Range[string]).Descending())
+	require.NoError(t, err)
+	kvs, err := iter.KeyValues()
+	require.NoError(t, err)
+	require.Len(t, kvs, 2)
+	require.Equal(t, "b", kvs[0].Key)
+	require.Equal(t, 2, kvs[0].Value.Count)
+	require.Equal(t, "a", kvs[1].Key)
+
+	require.NoError(t, m.Remove(ctx, "b"))
+	has, err = m.Has(ctx, "b")
+	require.NoError(t, err)
+	require.False(t, has)
+
+	// the codec of a map of an object store is not used
+	require.NoError(t, item.Set(ctx, 10))
+	value, err := item.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(10), value)
+
+	// values of another type are rejected
+	require.NoError(t, service.Set([]byte{0, 'c'}, "c"))
+	_, err = m.Get(ctx, "c")
+	require.ErrorIs(t, err, ErrEncoding)
+}
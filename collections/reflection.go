@@ -0,0 +1,80 @@
+package collections
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// CollectionInfo describes a collection of a Schema.
+type CollectionInfo struct {
+	// Name is the human-readable name of the collection.
+	Name string
+	// Prefix is the prefix of the collection within the store.
+	Prefix []byte
+	// KeyType is the human-readable name of the key type of the collection.
+	KeyType string
+	// ValueType is the human-readable name of the value type of the collection.
+	ValueType string
+}
+
+// ListCollections returns the description of all the collections of the schema,
+// ordered by name.
+func (s Schema) ListCollections() []CollectionInfo {
+	infos := make([]CollectionInfo, 0, len(s.collectionsOrdered))
+	for _, name := range s.collectionsOrdered {
+		coll := s.collectionsByName[name]
+		infos = append(infos, CollectionInfo{
+			Name:      name,
+			Prefix:    coll.getPrefix(),
+			KeyType:   coll.keyType(),
+			ValueType: coll.valueType(),
+		})
+	}
+	return infos
+}
+
+// DecodedPair is a raw store key and value pair decoded by a Schema.
+type DecodedPair struct {
+	// Collection is the name of the collection which the pair belongs to.
+	Collection string
+	// Key is the JSON representation of the key of the pair.
+	Key json.RawMessage
+	// Value is the JSON representation of the value of the pair, it is nil
+	// if the raw value was nil, as for deleted keys.
+	Value json.RawMessage
+}
+
+// DecodePair finds the collection which the provided raw store key belongs to,
+// and decodes the key and value into their JSON representation. This allows
+// clients, such as off-chain indexers, to decode the state changes of a module
+// store without hand-written decoders. If no collection of the schema matches
+// the key an ErrNotFound error is returned.
+func (s Schema) DecodePair(key, value []byte) (DecodedPair, error) {
+	coll, err := s.collectionByKey(key)
+	if err != nil {
+		return DecodedPair{}, err
+	}
+
+	keyJSON, valueJSON, err := coll.decodeJSON(key[len(coll.getPrefix()):], value)
+	if err != nil {
+		return DecodedPair{}, fmt.Errorf("failed to decode pair of %s: %w", coll.getName(), err)
+	}
+
+	return DecodedPair{
+		Collection: coll.getName(),
+		Key:        keyJSON,
+		Value:      valueJSON,
+	}, nil
+}
+
+// collectionByKey returns the collection whose prefix is a prefix of the key.
+// Since the prefixes of a schema do not overlap there is at most one.
+func (s Schema) collectionByKey(key []byte) (collection, error) {
+	for prefix, coll := range s.collectionsByPrefix {
+		if bytes.HasPrefix(key, []byte(prefix)) {
+			return coll, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: no collection matches key %X", ErrNotFound, key)
+}
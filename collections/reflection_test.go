@@ -0,0 +1,64 @@
+package collections
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestListCollections(t *testing.T) {
+	f := initFixture(t)
+	require.Equal(t, []CollectionInfo{
+		{Name: "item", Prefix: []byte{2}, KeyType: "no_key", ValueType: "string"},
+		{Name: "key_set", Prefix: []byte{4}, KeyType: "string", ValueType: "no_value"},
+		{Name: "map", Prefix: []byte{1}, KeyType: "string", ValueType: "uint64"},
+		{Name: "sequence", Prefix: []byte{3}, KeyType: "no_key", ValueType: "uint64"},
+		{Name: "vec", Prefix: []byte{5}, KeyType: "uint64", ValueType: "string"},
+	}, f.schema.ListCollections())
+}
+
+func TestDecodePair(t *testing.T) {
+	f := initFixture(t)
+	require.NoError(t, f.schema.InitGenesis(f.ctx, createTestGenesisSource(t)))
+
+	// we decode all the pairs of the store, which must all belong to a collection
+	store := f.schema.storeAccessor(f.ctx)
+	it, err := store.Iterator(nil, nil)
+	require.NoError(t, err)
+	defer it.Close()
+
+	decoded := map[string][]DecodedPair{}
+	for ; it.Valid(); it.Next() {
+		pair, err := f.schema.DecodePair(it.Key(), it.Value())
+		require.NoError(t, err)
+		decoded[pair.Collection] = append(decoded[pair.Collection], pair)
+	}
+
+	require.Equal(t, []DecodedPair{
+		{Collection: "map", Key: json.RawMessage(`"abc"`), Value: json.RawMessage(`"1"`)},
+		{Collection: "map", Key: json.RawMessage(`"def"`), Value: json.RawMessage(`"2"`)},
+	}, decoded["map"])
+	require.Equal(t, []DecodedPair{
+		{Collection: "item", Key: json.RawMessage(`"item"`), Value: json.RawMessage(`"superCoolItem"`)},
+	}, decoded["item"])
+	require.Equal(t, []DecodedPair{
+		{Collection: "sequence", Key: json.RawMessage(`"item"`), Value: json.RawMessage(`"1000"`)},
+	}, decoded["sequence"])
+	require.Len(t, decoded["key_set"], 3)
+	require.Equal(t, []DecodedPair{
+		{Collection: "vec", Key: json.RawMessage(`"length"`), Value: json.RawMessage(`"3"`)},
+		{Collection: "vec", Key: json.RawMessage(`"0"`), Value: json.RawMessage(`"a"`)},
+		{Collection: "vec", Key: json.RawMessage(`"1"`), Value: json.RawMessage(`"b"`)},
+		{Collection: "vec", Key: json.RawMessage(`"2"`), Value: json.RawMessage(`"c"`)},
+	}, decoded["vec"])
+
+	// deleted keys have no value
+	pair, err := f.schema.DecodePair(append([]byte{1}, "abc"...), nil)
+	require.NoError(t, err)
+	require.Equal(t, DecodedPair{Collection: "map", Key: json.RawMessage(`"abc"`)}, pair)
+
+	// unknown prefix
+	_, err = f.schema.DecodePair([]byte{0xFF}, nil)
+	require.ErrorIs(t, err, ErrNotFound)
+}
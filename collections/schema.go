@@ -127,6 +127,7 @@ var nameRegex = regexp.MustCompile("^" + NameRegex + "$")
 // clients.
 type Schema struct {
 	storeAccessor       func(context.Context) store.KVStore
+	objectAccessor      func(context.Context) ObjectStore
 	collectionsOrdered  []string
 	collectionsByPrefix map[string]collection
 	collectionsByName   map[string]collection
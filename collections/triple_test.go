@@ -0,0 +1,70 @@
+package collections_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/colltest"
+)
+
+func TestTriple(t *testing.T) {
+	kc := collections.TripleKeyCodec(collections.Uint64Key, collections.StringKey, collections.BytesKey)
+
+	t.Run("conformance", func(t *testing.T) {
+		colltest.TestKeyCodec(t, kc, collections.Join3(uint64(1), "2", []byte("3")))
+	})
+
+	t.Run("stringify", func(t *testing.T) {
+		s := kc.Stringify(collections.Join3(uint64(1), "2", []byte("3")))
+		require.Equal(t, `("1", "2", "hexBytes:33")`, s)
+		s = kc.Stringify(collections.TripleSuperPrefix[uint64, string, []byte](1, "2"))
+		require.Equal(t, `("1", "2", <nil>)`, s)
+		s = kc.Stringify(collections.TriplePrefix[uint64, string, []byte](1))
+		require.Equal(t, `("1", <nil>, <nil>)`, s)
+	})
+
+	t.Run("json", func(t *testing.T) {
+		b, err := kc.EncodeJSON(collections.Join3(uint64(1), "2", []byte("3")))
+		require.NoError(t, err)
+		require.Equal(t, `["1","2","Mw=="]`, string(b))
+
+		key, err := kc.DecodeJSON(b)
+		require.NoError(t, err)
+		require.Equal(t, collections.Join3(uint64(1), "2", []byte("3")), key)
+	})
+}
+
+func TestTripleRange(t *testing.T) {
+	sk, ctx := colltest.MockStore()
+	schema := collections.NewSchemaBuilder(sk)
+	kc := collections.TripleKeyCodec(collections.Uint64Key, collections.StringKey, collections.BytesKey)
+
+	keySet := collections.NewKeySet(schema, collections.NewPrefix(0), "triple", kc)
+
+	keys := []collections.Triple[uint64, string, []byte]{
+		collections.Join3(uint64(1), "A", []byte("1")),
+		collections.Join3(uint64(1), "A", []byte("2")),
+		collections.Join3(uint64(1), "B", []byte("3")),
+		collections.Join3(uint64(2), "B", []byte("4")),
+	}
+
+	for _, k := range keys {
+		require.NoError(t, keySet.Set(ctx, k))
+	}
+
+	// we prefix over (1) we expect 3 results
+	iter, err := keySet.Iterate(ctx, collections.NewPrefixedTripleRange[uint64, string, []byte](uint64(1)))
+	require.NoError(t, err)
+	gotKeys, err := iter.Keys()
+	require.NoError(t, err)
+	require.Equal(t, keys[:3], gotKeys)
+
+	// we super prefix over Join(1, "A") we expect 2 results
+	iter, err = keySet.Iterate(ctx, collections.NewSuperPrefixedTripleRange[uint64, string, []byte](1, "A"))
+	require.NoError(t, err)
+	gotKeys, err = iter.Keys()
+	require.NoError(t, err)
+	require.Equal(t, keys[:2], gotKeys)
+}
@@ -0,0 +1,282 @@
+package collections
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"cosmossdk.io/collections/codec"
+)
+
+var (
+	// ErrOutOfBounds is returned when accessing a Vec index which is out of bounds.
+	ErrOutOfBounds = errors.New("collections: out of bounds")
+	// ErrEmptyVec is returned when popping an element from an empty Vec.
+	ErrEmptyVec = errors.New("collections: vec is empty")
+)
+
+const (
+	vecLengthSuffix   byte = 0x00
+	vecElementsSuffix byte = 0x01
+)
+
+// Vec represents an ordered list of values V, indexed from 0 to Len - 1.
+// It is built on top of a Sequence, which tracks the length of the Vec,
+// and a Map, which maps each index to its value.
+type Vec[V any] struct {
+	length   Sequence
+	elements Map[uint64, V]
+
+	prefix []byte
+	name   string
+}
+
+// NewVec instantiates a new Vec instance given a Schema, a Prefix, a humanized
+// name for the vec and the value encoder of the vec elements V.
+// The length of the vec is stored under the Prefix followed by 0x00 and the
+// elements under the Prefix followed by 0x01, so the Prefix must not be shared
+// with other collections. Name and prefix must be unique within the schema and
+// name must match the format specified by NameRegex, or else this method will panic.
+func NewVec[V any](
+	schema *SchemaBuilder,
+	prefix Prefix,
+	name string,
+	valueCodec codec.ValueCodec[V],
+) Vec[V] {
+	v := Vec[V]{
+		length:   (Sequence)(newMap[noKey](schema, vecPrefix(prefix, vecLengthSuffix), name, noKey{}, Uint64Value)),
+		elements: newMap(schema, vecPrefix(prefix, vecElementsSuffix), name, Uint64Key, valueCodec),
+		prefix:   prefix.Bytes(),
+		name:     name,
+	}
+	schema.addCollection(v)
+	return v
+}
+
+// vecPrefix returns the prefix of a vec inner collection.
+func vecPrefix(prefix Prefix, suffix byte) Prefix {
+	p := make([]byte, len(prefix)+1)
+	copy(p, prefix)
+	p[len(prefix)] = suffix
+	return p
+}
+
+func (v Vec[V]) getName() string { return v.name }
+
+func (v Vec[V]) getPrefix() []byte { return v.prefix }
+
+func (v Vec[V]) keyType() string { return v.elements.keyType() }
+
+func (v Vec[V]) valueType() string { return v.elements.valueType() }
+
+// decodeJSON decodes the length of the vec to the "length" key and its
+// elements to their index key.
+func (v Vec[V]) decodeJSON(key, value []byte) (keyJSON, valueJSON json.RawMessage, err error) {
+	if len(key) == 0 {
+		return nil, nil, fmt.Errorf("%w: empty vec key", ErrEncoding)
+	}
+
+	switch key[0] {
+	case vecLengthSuffix:
+		_, valueJSON, err = (Map[noKey, uint64])(v.length).decodeJSON(key[1:], value)
+		if err != nil {
+			return nil, nil, err
+		}
+		return json.RawMessage(`"length"`), valueJSON, nil
+	case vecElementsSuffix:
+		return v.elements.decodeJSON(key[1:], value)
+	default:
+		return nil, nil, fmt.Errorf("%w: unknown vec key prefix %d", ErrEncoding, key[0])
+	}
+}
+
+// Push appends the value at the end of the vec.
+// Errors on encoding issues.
+func (v Vec[V]) Push(ctx context.Context, value V) error {
+	index, err := v.length.Next(ctx)
+	if err != nil {
+		return err
+	}
+	return v.elements.Set(ctx, index, value)
+}
+
+// Pop removes the last value of the vec and returns it. If the vec
+// is empty an ErrEmptyVec error is returned.
+func (v Vec[V]) Pop(ctx context.Context) (value V, err error) {
+	length, err := v.Len(ctx)
+	if err != nil {
+		return value, err
+	}
+	if length == 0 {
+		return value, ErrEmptyVec
+	}
+
+	index := length - 1
+	value, err = v.elements.Get(ctx, index)
+	if err != nil {
+		return value, err
+	}
+
+	err = v.elements.Remove(ctx, index)
+	if err != nil {
+		return value, err
+	}
+
+	return value, v.length.Set(ctx, index)
+}
+
+// Get returns the value at the provided index. If the index is out of
+// bounds an ErrOutOfBounds error is returned.
+func (v Vec[V]) Get(ctx context.Context, index uint64) (value V, err error) {
+	err = v.checkBounds(ctx, index)
+	if err != nil {
+		return value, err
+	}
+	return v.elements.Get(ctx, index)
+}
+
+// Replace replaces the value at the provided index with the provided value.
+// If the index is out of bounds an ErrOutOfBounds error is returned.
+func (v Vec[V]) Replace(ctx context.Context, index uint64, value V) error {
+	err := v.checkBounds(ctx, index)
+	if err != nil {
+		return err
+	}
+	return v.elements.Set(ctx, index, value)
+}
+
+// Len returns the number of values contained in the vec.
+func (v Vec[V]) Len(ctx context.Context) (uint64, error) {
+	return v.length.Peek(ctx)
+}
+
+// Iterate provides an Iterator over the indexes and values of the vec,
+// within the range of indexes defined by the Ranger.
+func (v Vec[V]) Iterate(ctx context.Context, ranger Ranger[uint64]) (Iterator[uint64, V], error) {
+	return v.elements.Iterate(ctx, ranger)
+}
+
+// Walk iterates over the vec given the provided range, calling walkFunc
+// on each index and value. It stops when walkFunc returns true.
+func (v Vec[V]) Walk(ctx context.Context, ranger Ranger[uint64], walkFunc func(index uint64, value V) bool) error {
+	return v.elements.Walk(ctx, ranger, walkFunc)
+}
+
+// ValueCodec returns the value codec of the vec elements.
+func (v Vec[V]) ValueCodec() codec.ValueCodec[V] { return v.elements.ValueCodec() }
+
+func (v Vec[V]) checkBounds(ctx context.Context, index uint64) error {
+	length, err := v.Len(ctx)
+	if err != nil {
+		return err
+	}
+	if index >= length {
+		return fmt.Errorf("%w: index %d, length %d", ErrOutOfBounds, index, length)
+	}
+	return nil
+}
+
+// GENESIS
+// The genesis of a vec is the JSON array of its values, in order.
+
+func (v Vec[V]) validateGenesis(reader io.Reader) error {
+	return v.doDecodeJSON(reader, func(V) error { return nil })
+}
+
+func (v Vec[V]) importGenesis(ctx context.Context, reader io.Reader) error {
+	return v.doDecodeJSON(reader, func(value V) error {
+		return v.Push(ctx, value)
+	})
+}
+
+func (v Vec[V]) exportGenesis(ctx context.Context, writer io.Writer) error {
+	_, err := writer.Write([]byte("["))
+	if err != nil {
+		return err
+	}
+
+	it, err := v.Iterate(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer it.Close()
+
+	first := true
+	for ; it.Valid(); it.Next() {
+		// add a comma before encoding the object
+		// for all objects besides the first one.
+		if !first {
+			_, err = writer.Write([]byte(","))
+			if err != nil {
+				return err
+			}
+		}
+		first = false
+
+		value, err := it.Value()
+		if err != nil {
+			return err
+		}
+
+		bz, err := v.elements.vc.EncodeJSON(value)
+		if err != nil {
+			return err
+		}
+
+		_, err = writer.Write(bz)
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err = writer.Write([]byte("]"))
+	return err
+}
+
+func (v Vec[V]) doDecodeJSON(reader io.Reader, onValue func(value V) error) error {
+	decoder := json.NewDecoder(reader)
+	token, err := decoder.Token()
+	if err != nil {
+		return err
+	}
+
+	if token != json.Delim('[') {
+		return fmt.Errorf("expected [ got %s", token)
+	}
+
+	for decoder.More() {
+		var rawJSON json.RawMessage
+		err := decoder.Decode(&rawJSON)
+		if err != nil {
+			return err
+		}
+
+		value, err := v.elements.vc.DecodeJSON(rawJSON)
+		if err != nil {
+			return err
+		}
+
+		err = onValue(value)
+		if err != nil {
+			return err
+		}
+	}
+
+	token, err = decoder.Token()
+	if err != nil {
+		return err
+	}
+
+	if token != json.Delim(']') {
+		return fmt.Errorf("expected ] got %s", token)
+	}
+
+	return nil
+}
+
+func (v Vec[V]) defaultGenesis(writer io.Writer) error {
+	_, err := writer.Write([]byte(`[]`))
+	return err
+}
@@ -0,0 +1,85 @@
+package collections
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestVec(t *testing.T) {
+	sk, ctx := deps()
+	schema := NewSchemaBuilder(sk)
+	vec := NewVec(schema, NewPrefix(0), "vec", StringValue)
+	_, err := schema.Build()
+	require.NoError(t, err)
+
+	// initially the vec is empty
+	length, err := vec.Len(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), length)
+
+	_, err = vec.Pop(ctx)
+	require.ErrorIs(t, err, ErrEmptyVec)
+
+	_, err = vec.Get(ctx, 0)
+	require.ErrorIs(t, err, ErrOutOfBounds)
+
+	// push
+	require.NoError(t, vec.Push(ctx, "a"))
+	require.NoError(t, vec.Push(ctx, "b"))
+	require.NoError(t, vec.Push(ctx, "c"))
+
+	length, err = vec.Len(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), length)
+
+	// get
+	v, err := vec.Get(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, "b", v)
+
+	_, err = vec.Get(ctx, 3)
+	require.ErrorIs(t, err, ErrOutOfBounds)
+
+	// replace
+	require.NoError(t, vec.Replace(ctx, 1, "B"))
+	v, err = vec.Get(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, "B", v)
+
+	require.ErrorIs(t, vec.Replace(ctx, 3, "d"), ErrOutOfBounds)
+
+	// iterate
+	iter, err := vec.Iterate(ctx, new(Range[uint64]).StartInclusive(1))
+	require.NoError(t, err)
+	values, err := iter.Values()
+	require.NoError(t, err)
+	require.Equal(t, []string{"B", "c"}, values)
+
+	// pop
+	v, err = vec.Pop(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "c", v)
+
+	length, err = vec.Len(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), length)
+
+	_, err = vec.Get(ctx, 2)
+	require.ErrorIs(t, err, ErrOutOfBounds)
+
+	// push after pop reuses the popped index
+	require.NoError(t, vec.Push(ctx, "d"))
+	v, err = vec.Get(ctx, 2)
+	require.NoError(t, err)
+	require.Equal(t, "d", v)
+}
+
+func TestVecPrefixOverlap(t *testing.T) {
+	sk, _ := deps()
+	schema := NewSchemaBuilder(sk)
+	NewVec(schema, NewPrefix("vec"), "vec", StringValue)
+	NewItem(schema, NewPrefix("ve"), "item", StringValue)
+	_, err := schema.Build()
+	require.ErrorContains(t, err, "overlapping prefixes")
+}
@@ -1,9 +1,13 @@
 package hd
 
 import (
+	stded25519 "crypto/ed25519"
+
 	"github.com/cosmos/go-bip39"
 
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
 	"github.com/cosmos/cosmos-sdk/crypto/types"
 )
 
@@ -16,14 +20,22 @@ const (
 	// Secp256k1Type uses the Bitcoin secp256k1 ECDSA parameters.
 	Secp256k1Type = PubKeyType("secp256k1")
 	// Ed25519Type represents the Ed25519Type signature system.
-	// It is currently not supported for end-user keys (wallets/ledgers).
 	Ed25519Type = PubKeyType("ed25519")
+	// Secp256r1Type uses the NIST P-256 ECDSA parameters.
+	Secp256r1Type = PubKeyType("secp256r1")
 	// Sr25519Type represents the Sr25519Type signature system.
 	Sr25519Type = PubKeyType("sr25519")
 )
 
-// Secp256k1 uses the Bitcoin secp256k1 ECDSA parameters.
-var Secp256k1 = secp256k1Algo{}
+var (
+	// Secp256k1 uses the Bitcoin secp256k1 ECDSA parameters.
+	Secp256k1 = secp256k1Algo{}
+	// Ed25519 derives ed25519 keys following SLIP-0010. All the indexes of the HD paths
+	// are hardened, as ed25519 only supports hardened derivation.
+	Ed25519 = ed25519Algo{}
+	// Secp256r1 derives NIST P-256 ECDSA keys following SLIP-0010.
+	Secp256r1 = secp256r1Algo{}
+)
 
 type (
 	DeriveFn   func(mnemonic, bip39Passphrase, hdPath string) ([]byte, error)
@@ -68,3 +80,65 @@ func (s secp256k1Algo) Generate() GenerateFn {
 		return &secp256k1.PrivKey{Key: bzArr}
 	}
 }
+
+type ed25519Algo struct{}
+
+func (s ed25519Algo) Name() PubKeyType {
+	return Ed25519Type
+}
+
+// Derive derives and returns the ed25519 private key seed for the given seed and HD path.
+func (s ed25519Algo) Derive() DeriveFn {
+	return func(mnemonic, bip39Passphrase, hdPath string) ([]byte, error) {
+		seed, err := bip39.NewSeedWithErrorChecking(mnemonic, bip39Passphrase)
+		if err != nil {
+			return nil, err
+		}
+
+		return slip10Ed25519.derivePrivateKeyForPath(seed, hdPath)
+	}
+}
+
+// Generate generates an ed25519 private key from the given private key seed.
+func (s ed25519Algo) Generate() GenerateFn {
+	return func(bz []byte) types.PrivKey {
+		seed := make([]byte, stded25519.SeedSize)
+		copy(seed, bz)
+
+		return &ed25519.PrivKey{Key: stded25519.NewKeyFromSeed(seed)}
+	}
+}
+
+type secp256r1Algo struct{}
+
+func (s secp256r1Algo) Name() PubKeyType {
+	return Secp256r1Type
+}
+
+// Derive derives and returns the secp256r1 private key for the given seed and HD path.
+func (s secp256r1Algo) Derive() DeriveFn {
+	return func(mnemonic, bip39Passphrase, hdPath string) ([]byte, error) {
+		seed, err := bip39.NewSeedWithErrorChecking(mnemonic, bip39Passphrase)
+		if err != nil {
+			return nil, err
+		}
+
+		return slip10Nist256p1.derivePrivateKeyForPath(seed, hdPath)
+	}
+}
+
+// Generate generates a secp256r1 private key from the given bytes.
+func (s secp256r1Algo) Generate() GenerateFn {
+	return func(bz []byte) types.PrivKey {
+		secret := make([]byte, 32)
+		copy(secret, bz)
+
+		priv, err := secp256r1.NewPrivKeyFromSecret(secret)
+		if err != nil {
+			// the derived keys are valid scalars
+			panic(err)
+		}
+
+		return priv
+	}
+}
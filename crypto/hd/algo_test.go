@@ -1,16 +1,60 @@
 package hd_test
 
 import (
+	"bytes"
 	"testing"
 
+	"github.com/cosmos/go-bip39"
+
 	"github.com/stretchr/testify/require"
 
 	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
+	"github.com/cosmos/cosmos-sdk/types"
 )
 
 func TestDefaults(t *testing.T) {
 	require.Equal(t, hd.PubKeyType("multi"), hd.MultiType)
 	require.Equal(t, hd.PubKeyType("secp256k1"), hd.Secp256k1Type)
 	require.Equal(t, hd.PubKeyType("ed25519"), hd.Ed25519Type)
+	require.Equal(t, hd.PubKeyType("secp256r1"), hd.Secp256r1Type)
 	require.Equal(t, hd.PubKeyType("sr25519"), hd.Sr25519Type)
 }
+
+func TestSLIP10Algos(t *testing.T) {
+	entropy, err := bip39.NewEntropy(256)
+	require.NoError(t, err)
+	mnemonic, err := bip39.NewMnemonic(entropy)
+	require.NoError(t, err)
+	msg := []byte("sign bytes")
+
+	for _, algo := range []interface {
+		Name() hd.PubKeyType
+		Derive() hd.DeriveFn
+		Generate() hd.GenerateFn
+	}{hd.Ed25519, hd.Secp256r1} {
+		t.Run(string(algo.Name()), func(t *testing.T) {
+			derived, err := algo.Derive()(mnemonic, "", types.FullFundraiserPath)
+			require.NoError(t, err)
+			priv := algo.Generate()(derived)
+			require.Equal(t, string(algo.Name()), priv.PubKey().Type())
+
+			// the derivation is deterministic, and depends on the path
+			derivedAgain, err := algo.Derive()(mnemonic, "", types.FullFundraiserPath)
+			require.NoError(t, err)
+			require.True(t, priv.Equals(algo.Generate()(derivedAgain)))
+			other, err := algo.Derive()(mnemonic, "", "m/44'/118'/0'/0/1")
+			require.NoError(t, err)
+			require.False(t, priv.Equals(algo.Generate()(other)))
+
+			sig, err := priv.Sign(msg)
+			require.NoError(t, err)
+			require.True(t, priv.PubKey().VerifySignature(msg, sig))
+		})
+	}
+
+	key := bytes.Repeat([]byte{1}, 32)
+	require.IsType(t, &ed25519.PrivKey{}, hd.Ed25519.Generate()(key))
+	require.IsType(t, &secp256r1.PrivKey{}, hd.Secp256r1.Generate()(key))
+}
@@ -166,10 +166,38 @@ func ComputeMastersFromSeed(seed []byte) (secret, chainCode [32]byte) {
 // DerivePrivateKeyForPath derives the private key by following the BIP 32/44 path from privKeyBytes,
 // using the given chainCode.
 func DerivePrivateKeyForPath(privKeyBytes, chainCode [32]byte, path string) ([]byte, error) {
+	indexes, err := parsePath(path)
+	if err != nil {
+		return []byte{}, err
+	}
+
+	data := privKeyBytes
+	for _, idx := range indexes {
+		data, chainCode = derivePrivateKey(data, chainCode, idx.index, idx.harden)
+	}
+
+	derivedKey := make([]byte, 32)
+	n := copy(derivedKey, data[:])
+
+	if n != 32 || len(data) != 32 {
+		return []byte{}, fmt.Errorf("expected a key of length 32, got length: %d", len(data))
+	}
+
+	return derivedKey, nil
+}
+
+// pathIndex is an index of a BIP 32 path.
+type pathIndex struct {
+	index uint32
+	// harden == private derivation, else public derivation
+	harden bool
+}
+
+// parsePath returns the indexes of a BIP 32 path.
+func parsePath(path string) ([]pathIndex, error) {
 	// First step is to trim the right end path separator lest we panic.
 	// See issue https://github.com/cosmos/cosmos-sdk/issues/8557
 	path = strings.TrimRightFunc(path, func(r rune) bool { return r == filepath.Separator })
-	data := privKeyBytes
 	parts := strings.Split(path, "/")
 
 	switch {
@@ -179,13 +207,13 @@ func DerivePrivateKeyForPath(privKeyBytes, chainCode [32]byte, path string) ([]b
 		parts = parts[1:]
 	}
 
+	indexes := make([]pathIndex, len(parts))
 	for i, part := range parts {
 		if part == "" {
 			return nil, fmt.Errorf("path %q with split element #%d is an empty string", part, i)
 		}
 		// do we have an apostrophe?
 		harden := part[len(part)-1:] == "'"
-		// harden == private derivation, else public derivation:
 		if harden {
 			part = part[:len(part)-1]
 		}
@@ -195,20 +223,13 @@ func DerivePrivateKeyForPath(privKeyBytes, chainCode [32]byte, path string) ([]b
 		// index values are in the range [0, 1<<31-1] aka [0, max(int32)]
 		idx, err := strconv.ParseUint(part, 10, 31)
 		if err != nil {
-			return []byte{}, fmt.Errorf("invalid BIP 32 path %s: %w", path, err)
+			return nil, fmt.Errorf("invalid BIP 32 path %s: %w", path, err)
 		}
 
-		data, chainCode = derivePrivateKey(data, chainCode, uint32(idx), harden)
+		indexes[i] = pathIndex{index: uint32(idx), harden: harden}
 	}
 
-	derivedKey := make([]byte, 32)
-	n := copy(derivedKey, data[:])
-
-	if n != 32 || len(data) != 32 {
-		return []byte{}, fmt.Errorf("expected a key of length 32, got length: %d", len(data))
-	}
-
-	return derivedKey, nil
+	return indexes, nil
 }
 
 // derivePrivateKey derives the private key with index and chainCode.
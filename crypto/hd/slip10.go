@@ -0,0 +1,128 @@
+package hd
+
+import (
+	"crypto/ecdh"
+	"crypto/elliptic"
+	"math/big"
+)
+
+// slip10Curve derives the keys of a curve following SLIP-0010, which generalizes the BIP 32
+// derivation to other curves than secp256k1:
+//
+//	https://github.com/satoshilabs/slips/blob/master/slip-0010.md
+type slip10Curve struct {
+	// seedKey is the HMAC key of the master key derivation.
+	seedKey []byte
+	// order is the order of the curve group. It is nil for ed25519, whose private keys
+	// are not scalars, and which only supports hardened derivation.
+	order *big.Int
+	// compressedPubKey returns the compressed public key of a private key, for the
+	// non-hardened derivation.
+	compressedPubKey func(key []byte) ([]byte, error)
+}
+
+var (
+	slip10Ed25519 = slip10Curve{
+		seedKey: []byte("ed25519 seed"),
+	}
+	slip10Nist256p1 = slip10Curve{
+		seedKey:          []byte("Nist256p1 seed"),
+		order:            elliptic.P256().Params().N,
+		compressedPubKey: p256CompressedPubKey,
+	}
+)
+
+// derivePrivateKeyForPath derives the private key of the given seed by following the given
+// BIP 32 path. As ed25519 only supports hardened derivation, all the indexes of the path are
+// hardened for ed25519, so that the default BIP 44 paths can be used.
+func (c slip10Curve) derivePrivateKeyForPath(seed []byte, path string) ([]byte, error) {
+	key, chainCode := c.master(seed)
+	if len(path) == 0 {
+		return key[:], nil
+	}
+
+	indexes, err := parsePath(path)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, idx := range indexes {
+		key, chainCode, err = c.child(key, chainCode, idx.index, idx.harden || c.order == nil)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return key[:], nil
+}
+
+// master returns the master private key and chain code of a seed.
+func (c slip10Curve) master(seed []byte) (key, chainCode [32]byte) {
+	data := seed
+	for {
+		key, chainCode = i64(c.seedKey, data)
+		if c.order == nil || c.isValidKey(new(big.Int).SetBytes(key[:])) {
+			return key, chainCode
+		}
+
+		data = append(key[:], chainCode[:]...)
+	}
+}
+
+// child returns the private key and chain code of the child of the given index.
+func (c slip10Curve) child(key, chainCode [32]byte, index uint32, harden bool) ([32]byte, [32]byte, error) {
+	var data []byte
+	if harden {
+		index |= 0x80000000
+		data = append([]byte{0}, key[:]...)
+	} else {
+		pubKey, err := c.compressedPubKey(key[:])
+		if err != nil {
+			return key, chainCode, err
+		}
+		data = pubKey
+	}
+	data = append(data, uint32ToBytes(index)...)
+
+	for {
+		il, ir := i64(chainCode[:], data)
+		if c.order == nil {
+			return il, ir, nil
+		}
+
+		childKey := new(big.Int).SetBytes(il[:])
+		if childKey.Cmp(c.order) < 0 {
+			childKey.Add(childKey, new(big.Int).SetBytes(key[:]))
+			childKey.Mod(childKey, c.order)
+			if childKey.Sign() != 0 {
+				var child [32]byte
+				childKey.FillBytes(child[:])
+				return child, ir, nil
+			}
+		}
+
+		// the derived key is invalid, derive again from the right half of the HMAC
+		data = append([]byte{1}, ir[:]...)
+		data = append(data, uint32ToBytes(index)...)
+	}
+}
+
+func (c slip10Curve) isValidKey(key *big.Int) bool {
+	return key.Sign() != 0 && key.Cmp(c.order) < 0
+}
+
+// p256CompressedPubKey returns the compressed NIST P-256 public key of a private key.
+func p256CompressedPubKey(key []byte) ([]byte, error) {
+	priv, err := ecdh.P256().NewPrivateKey(key)
+	if err != nil {
+		return nil, err
+	}
+
+	// the uncompressed point is 0x04 || x || y
+	point := priv.PublicKey().Bytes()
+	compressed := make([]byte, 33)
+	compressed[0] = 0x02 | point[64]&1
+	copy(compressed[1:], point[1:33])
+
+	return compressed, nil
+}
@@ -0,0 +1,45 @@
+package hd
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// Test vector 1 of SLIP-0010, see https://github.com/satoshilabs/slips/blob/master/slip-0010.md
+func TestSLIP10TestVector1(t *testing.T) {
+	seed, err := hex.DecodeString("000102030405060708090a0b0c0d0e0f")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name  string
+		curve slip10Curve
+		path  string
+		key   string
+	}{
+		{"ed25519 master", slip10Ed25519, "", "2b4be7f19ee27bbf30c667b642d5f4aa69fd169872f8fc3059c08ebae2eb19e7"},
+		{"ed25519", slip10Ed25519, "m/0'", "68e0fe46dfb67e368c75379acec591dad19df3cde26e63b93a8e704f1dade7a3"},
+		{"ed25519", slip10Ed25519, "m/0'/1'", "b1d0bad404bf35da785a64ca1ac54b2617211d2777696fbffaf208f746ae84f2"},
+		{"ed25519", slip10Ed25519, "m/0'/1'/2'", "92a5b23c0b8a99e37d07df3fb9966917f5d06e02ddbd909c7e184371463e9fc9"},
+		{"ed25519", slip10Ed25519, "m/0'/1'/2'/2'", "30d1dc7e5fc04c31219ab25a27ae00b50f6fd66622f6e9c913253d6511d1e662"},
+		{"ed25519", slip10Ed25519, "m/0'/1'/2'/2'/1000000000'", "8f94d394a8e8fd6b1bc2f3f49f5c47e385281d5c17e65324b0f62483e37e8793"},
+		{"ed25519 implicitly hardened", slip10Ed25519, "m/0/1/2/2/1000000000", "8f94d394a8e8fd6b1bc2f3f49f5c47e385281d5c17e65324b0f62483e37e8793"},
+		{"nist256p1 master", slip10Nist256p1, "", "612091aaa12e22dd2abef664f8a01a82cae99ad7441b7ef8110424915c268bc2"},
+		{"nist256p1", slip10Nist256p1, "m/0'", "6939694369114c67917a182c59ddb8cafc3004e63ca5d3b84403ba8613debc0c"},
+		{"nist256p1", slip10Nist256p1, "m/0'/1", "284e9d38d07d21e4e281b645089a94f4cf5a5a81369acf151a1c3a57f18b2129"},
+		{"nist256p1", slip10Nist256p1, "m/0'/1/2'", "694596e8a54f252c960eb771a3c41e7e32496d03b954aeb90f61635b8e092aa7"},
+		{"nist256p1", slip10Nist256p1, "m/0'/1/2'/2", "5996c37fd3dd2679039b23ed6f70b506c6b56b3cb5e424681fb0fa64caf82aaa"},
+		{"nist256p1", slip10Nist256p1, "m/0'/1/2'/2/1000000000", "21c4f269ef0a5fd1badf47eeacebeeaa3de22eb8e5b0adcd0f27dd99d34d0119"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name+" "+tt.path, func(t *testing.T) {
+			key, err := tt.curve.derivePrivateKeyForPath(seed, tt.path)
+			require.NoError(t, err)
+			require.Equal(t, tt.key, hex.EncodeToString(key))
+		})
+	}
+
+	_, err = slip10Ed25519.derivePrivateKeyForPath(seed, "m/0'/a")
+	require.Error(t, err)
+}
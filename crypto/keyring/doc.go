@@ -37,4 +37,13 @@
 //		be unlocked and it should be used only for testing purposes.
 //	memory	Same instance as returned by NewInMemory. This backend uses a transient storage. Keys
 //		are discarded when the process terminates or the type instance is garbage collected.
+//
+// # Remote signers
+//
+// Any backend can store references to keys held by a remote signer, with SaveRemoteKey. Such a
+// record only holds the public key of the key, along with the gRPC address of the remote signer
+// and the name of the key in it, and Sign delegates the signatures to the remote signer. Remote
+// signers implement the cosmos.crypto.remotesigner.v1.Signer service, see the remotesigner
+// package. NewRemoteSignerServer returns a reference implementation of the service signing with
+// the keys of a keyring, for testing purposes.
 package keyring
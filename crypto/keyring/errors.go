@@ -31,6 +31,8 @@ var (
 	ErrNotLedgerObj = errors.New("not a ledger object")
 	// ErrLedgerInvalidSignature is raised when ledger generates an invalid signature.
 	ErrLedgerInvalidSignature = errors.New("Ledger generated an invalid signature. Perhaps you have multiple ledgers and need to try another one")
+	// ErrRemoteSignerInvalidSignature is raised when a remote signer returns an invalid signature.
+	ErrRemoteSignerInvalidSignature = errors.New("remote signer returned an invalid signature")
 	// ErrLegacyToRecord is raised when cannot be converted to a Record
 	ErrLegacyToRecord = errors.New("unable to convert LegacyInfo to Record")
 	// ErrUnknownLegacyType is raised when a LegacyInfo type is unknown.
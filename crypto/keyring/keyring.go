@@ -18,6 +18,7 @@ import (
 	errorsmod "cosmossdk.io/errors"
 
 	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc"
 
 	"github.com/cosmos/cosmos-sdk/client/input"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -96,6 +97,10 @@ type Keyring interface {
 	// SaveMultisig stores and returns a new multsig (offline) key reference.
 	SaveMultisig(uid string, pubkey types.PubKey) (*Record, error)
 
+	// SaveRemoteKey retrieves the public key of a key of the remote signer at the given gRPC
+	// address and persists a reference to it. Signing with the key is delegated to the remote signer.
+	SaveRemoteKey(uid, address, key string) (*Record, error)
+
 	Signer
 
 	Importer
@@ -157,6 +162,9 @@ type Options struct {
 	// indicate whether Ledger should skip DER Conversion on signature,
 	// depending on which format (DER or BER) the Ledger app returns signatures
 	LedgerSigSkipDERConv bool
+	// define the gRPC dial options of the remote signers, TLS with the system
+	// root certificates by default
+	RemoteSignerDialOptions []grpc.DialOption
 }
 
 // NewInMemory creates a transient keyring useful for testing
@@ -218,7 +226,7 @@ func newKeystore(kr keyring.Keyring, cdc codec.Codec, backend string, opts ...Op
 	// Default options for keybase, these can be overwritten using the
 	// Option function
 	options := Options{
-		SupportedAlgos:       SigningAlgoList{hd.Secp256k1},
+		SupportedAlgos:       SigningAlgoList{hd.Secp256k1, hd.Ed25519, hd.Secp256r1},
 		SupportedAlgosLedger: SigningAlgoList{hd.Secp256k1},
 	}
 
@@ -383,6 +391,9 @@ func (ks keystore) Sign(uid string, msg []byte, signMode signing.SignMode) ([]by
 	case k.GetLedger() != nil:
 		return SignWithLedger(k, msg, signMode)
 
+	case k.GetRemote() != nil:
+		return ks.signWithRemote(k, msg, signMode)
+
 		// multi or offline record
 	default:
 		pub, err := k.GetPubKey()
@@ -1881,6 +1881,56 @@ func TestAltKeyring_ConstructorSupportedAlgos(t *testing.T) {
 	}
 }
 
+func TestAltKeyring_SLIP10Algos(t *testing.T) {
+	cdc := getCodec()
+	msg := []byte("sign bytes")
+
+	for _, algo := range []SignatureAlgo{hd.Ed25519, hd.Secp256r1} {
+		t.Run(string(algo.Name()), func(t *testing.T) {
+			kr, err := New(t.Name(), BackendTest, t.TempDir(), nil, cdc)
+			require.NoError(t, err)
+
+			supportedAlgos, _ := kr.SupportedAlgorithms()
+			supportedAlgo, err := NewSigningAlgoFromString(string(algo.Name()), supportedAlgos)
+			require.NoError(t, err)
+			require.Equal(t, algo, supportedAlgo)
+
+			k, mnemonic, err := kr.NewMnemonic("key", English, sdk.FullFundraiserPath, DefaultBIP39Passphrase, algo)
+			require.NoError(t, err)
+			pub, err := k.GetPubKey()
+			require.NoError(t, err)
+			require.Equal(t, string(algo.Name()), pub.Type())
+
+			sig, signPub, err := kr.Sign("key", msg, signing.SignMode_SIGN_MODE_DIRECT)
+			require.NoError(t, err)
+			require.True(t, pub.Equals(signPub))
+			require.True(t, pub.VerifySignature(msg, sig))
+
+			armor, err := kr.ExportPrivKeyArmor("key", "apassphrase")
+			require.NoError(t, err)
+			require.NoError(t, kr.Delete("key"))
+
+			// the key is recovered from the mnemonic
+			recovered, err := kr.NewAccount("recovered", mnemonic, DefaultBIP39Passphrase, sdk.FullFundraiserPath, algo)
+			require.NoError(t, err)
+			recoveredPub, err := recovered.GetPubKey()
+			require.NoError(t, err)
+			require.True(t, pub.Equals(recoveredPub))
+			require.NoError(t, kr.Delete("recovered"))
+
+			// the key is imported from its armor, and renamed
+			require.NoError(t, kr.ImportPrivKey("imported", armor, "apassphrase"))
+			require.NoError(t, kr.Rename("imported", "renamed"))
+
+			renamed, err := kr.Key("renamed")
+			require.NoError(t, err)
+			renamedPub, err := renamed.GetPubKey()
+			require.NoError(t, err)
+			require.True(t, pub.Equals(renamedPub))
+		})
+	}
+}
+
 // TODO: review it
 func TestBackendConfigConstructors(t *testing.T) {
 	backend := newKWalletBackendKeyringConfig("test", "", nil)
@@ -0,0 +1,148 @@
+package keyring
+
+import (
+	"context"
+	"crypto/tls"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/status"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keyring/remotesigner"
+	"github.com/cosmos/cosmos-sdk/crypto/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+// remoteSignerTimeout is the timeout of the requests to a remote signer. It leaves time for
+// signers requiring a manual approval.
+const remoteSignerTimeout = time.Minute
+
+func (ks keystore) SaveRemoteKey(uid, address, key string) (*Record, error) {
+	conn, err := ks.dialRemoteSigner(address)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), remoteSignerTimeout)
+	defer cancel()
+
+	res, err := remotesigner.NewSignerClient(conn).PubKey(ctx, &remotesigner.PubKeyRequest{Key: key})
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "failed to get the public key of %s from the remote signer %s", key, address)
+	}
+
+	var pk types.PubKey
+	if err := ks.cdc.UnpackAny(res.PubKey, &pk); err != nil {
+		return nil, err
+	}
+
+	return ks.writeRemoteKey(uid, pk, address, key)
+}
+
+func (ks keystore) writeRemoteKey(name string, pk types.PubKey, address, key string) (*Record, error) {
+	k, err := NewRemoteRecord(name, pk, address, key)
+	if err != nil {
+		return nil, err
+	}
+
+	return k, ks.writeRecord(k)
+}
+
+// signWithRemote signs a binary message with the remote signer referenced by a remote record
+// and returns the signed bytes and the public key. The signature is verified against the
+// public key stored in the record.
+func (ks keystore) signWithRemote(k *Record, msg []byte, signMode signing.SignMode) ([]byte, types.PubKey, error) {
+	remote := k.GetRemote()
+
+	pub, err := k.GetPubKey()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	conn, err := ks.dialRemoteSigner(remote.Address)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer conn.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), remoteSignerTimeout)
+	defer cancel()
+
+	res, err := remotesigner.NewSignerClient(conn).Sign(ctx, &remotesigner.SignRequest{
+		Key:      remote.Key,
+		Msg:      msg,
+		SignMode: signMode,
+	})
+	if err != nil {
+		return nil, nil, errorsmod.Wrapf(err, "failed to sign with %s on the remote signer %s", remote.Key, remote.Address)
+	}
+
+	if !pub.VerifySignature(msg, res.Signature) {
+		return nil, nil, ErrRemoteSignerInvalidSignature
+	}
+
+	return res.Signature, pub, nil
+}
+
+func (ks keystore) dialRemoteSigner(address string) (*grpc.ClientConn, error) {
+	opts := ks.options.RemoteSignerDialOptions
+	if opts == nil {
+		opts = []grpc.DialOption{
+			grpc.WithTransportCredentials(credentials.NewTLS(&tls.Config{MinVersion: tls.VersionTLS12})),
+		}
+	}
+
+	return grpc.Dial(address, opts...)
+}
+
+// remoteSignerServer is a reference implementation of the remote signer service, see
+// NewRemoteSignerServer.
+type remoteSignerServer struct {
+	kr Keyring
+}
+
+var _ remotesigner.SignerServer = remoteSignerServer{}
+
+// NewRemoteSignerServer returns a reference implementation of the remote signer service,
+// signing with the keys of the given keyring. It is meant for testing: it signs any bytes
+// with any key of the keyring for any client, so that a production signer should rather
+// authenticate its clients and apply its own signing policy.
+func NewRemoteSignerServer(kr Keyring) remotesigner.SignerServer {
+	return remoteSignerServer{kr: kr}
+}
+
+func (s remoteSignerServer) PubKey(_ context.Context, req *remotesigner.PubKeyRequest) (*remotesigner.PubKeyResponse, error) {
+	k, err := s.kr.Key(req.Key)
+	if err != nil {
+		return nil, remoteSignerError(err)
+	}
+
+	return &remotesigner.PubKeyResponse{PubKey: k.PubKey}, nil
+}
+
+func (s remoteSignerServer) Sign(_ context.Context, req *remotesigner.SignRequest) (*remotesigner.SignResponse, error) {
+	sig, _, err := s.kr.Sign(req.Key, req.Msg, req.SignMode)
+	if err != nil {
+		return nil, remoteSignerError(err)
+	}
+
+	return &remotesigner.SignResponse{Signature: sig}, nil
+}
+
+func remoteSignerError(err error) error {
+	switch {
+	case errors.Is(err, sdkerrors.ErrKeyNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, ErrOfflineSign):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
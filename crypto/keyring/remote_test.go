@@ -0,0 +1,97 @@
+package keyring
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring/remotesigner"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+// startRemoteSigner serves the reference remote signer of the given keyring and returns the
+// dial options of the keyrings connecting to it.
+func startRemoteSigner(t *testing.T, kr Keyring) Option {
+	t.Helper()
+
+	listener := bufconn.Listen(1024 * 1024)
+	server := grpc.NewServer()
+	remotesigner.RegisterSignerServer(server, NewRemoteSignerServer(kr))
+	go server.Serve(listener) //nolint:errcheck // the server stops with the test
+	t.Cleanup(server.Stop)
+
+	return func(options *Options) {
+		options.RemoteSignerDialOptions = []grpc.DialOption{
+			grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+				return listener.DialContext(ctx)
+			}),
+			grpc.WithTransportCredentials(insecure.NewCredentials()),
+		}
+	}
+}
+
+func TestRemoteKey(t *testing.T) {
+	cdc := getCodec()
+	signerKr := NewInMemory(cdc)
+	validator, _, err := signerKr.NewMnemonic("validator", English, sdk.FullFundraiserPath, DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+	validatorPub, err := validator.GetPubKey()
+	require.NoError(t, err)
+
+	kr, err := New("remote", BackendTest, t.TempDir(), nil, cdc, startRemoteSigner(t, signerKr))
+	require.NoError(t, err)
+
+	_, err = kr.SaveRemoteKey("operator", "bufnet", "missing")
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	k, err := kr.SaveRemoteKey("operator", "bufnet", "validator")
+	require.NoError(t, err)
+	require.Equal(t, TypeRemote, k.GetType())
+	require.Equal(t, "bufnet", k.GetRemote().Address)
+	require.Equal(t, "validator", k.GetRemote().Key)
+
+	// the record is stored along with the public key of the remote key
+	k, err = kr.Key("operator")
+	require.NoError(t, err)
+	require.Equal(t, TypeRemote, k.GetType())
+	pub, err := k.GetPubKey()
+	require.NoError(t, err)
+	require.Equal(t, validatorPub, pub)
+
+	msg := []byte("sign bytes")
+	sig, pub, err := kr.Sign("operator", msg, signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+	require.Equal(t, validatorPub, pub)
+	require.True(t, pub.VerifySignature(msg, sig))
+
+	addr, err := k.GetAddress()
+	require.NoError(t, err)
+	sig, _, err = kr.SignByAddress(addr, msg, signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+	require.True(t, validatorPub.VerifySignature(msg, sig))
+
+	// the signatures of another key are rejected
+	require.NoError(t, signerKr.Delete("validator"))
+	_, _, err = signerKr.NewMnemonic("validator", English, sdk.FullFundraiserPath, DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+	_, _, err = kr.Sign("operator", msg, signing.SignMode_SIGN_MODE_DIRECT)
+	require.ErrorIs(t, err, ErrRemoteSignerInvalidSignature)
+
+	// offline keys of the signer cannot sign
+	_, err = signerKr.SaveOfflineKey("offline", validatorPub)
+	require.NoError(t, err)
+	require.NoError(t, kr.Delete("operator"))
+	_, err = kr.SaveRemoteKey("operator", "bufnet", "offline")
+	require.NoError(t, err)
+	_, _, err = kr.Sign("operator", msg, signing.SignMode_SIGN_MODE_DIRECT)
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
@@ -0,0 +1,13 @@
+// Package remotesigner defines the cosmos.crypto.remotesigner.v1.Signer gRPC service of the
+// remote signers holding the keys of the remote records of a keyring.
+//
+// A remote signer exposes two methods. PubKey returns the public key of one of its keys, by
+// name, and is called once when the remote record is created. Sign signs the sign bytes of a
+// transaction with a key, for the given sign mode, and is called every time the keyring signs
+// with the remote record. The keyring verifies the returned signatures against the public key
+// of the record. Failures are reported with gRPC status codes: NotFound for an unknown key,
+// FailedPrecondition for a key which cannot sign.
+//
+// The keyrings connect to the remote signers over TLS by default, see the
+// RemoteSignerDialOptions keyring option.
+package remotesigner
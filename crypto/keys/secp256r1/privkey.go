@@ -11,6 +11,15 @@ func GenPrivKey() (*PrivKey, error) {
 	return &PrivKey{&ecdsaSK{key}}, err
 }
 
+// NewPrivKeyFromSecret returns the secp256r1 private key of the given 32 bytes secret scalar.
+func NewPrivKeyFromSecret(secret []byte) (*PrivKey, error) {
+	sk := &ecdsaSK{}
+	if err := sk.Unmarshal(secret); err != nil {
+		return nil, err
+	}
+	return &PrivKey{sk}, nil
+}
+
 // PubKey implements SDK PrivKey interface.
 func (m *PrivKey) PubKey() cryptotypes.PubKey {
 	return &PubKey{&ecdsaPK{m.Secret.PubKey()}}
@@ -48,6 +57,31 @@ func (m *PrivKey) Equals(other cryptotypes.LedgerPrivKey) bool {
 	return m.Secret.Equal(&sk2.Secret.PrivateKey)
 }
 
+// MarshalAmino overrides Amino binary marshaling.
+func (m PrivKey) MarshalAmino() ([]byte, error) {
+	return m.Bytes(), nil
+}
+
+// UnmarshalAmino overrides Amino binary marshaling.
+func (m *PrivKey) UnmarshalAmino(bz []byte) error {
+	sk := &ecdsaSK{}
+	if err := sk.Unmarshal(bz); err != nil {
+		return err
+	}
+	m.Secret = sk
+	return nil
+}
+
+// MarshalAminoJSON overrides Amino JSON marshaling.
+func (m PrivKey) MarshalAminoJSON() ([]byte, error) {
+	return m.MarshalAmino()
+}
+
+// UnmarshalAminoJSON overrides Amino JSON marshaling.
+func (m *PrivKey) UnmarshalAminoJSON(bz []byte) error {
+	return m.UnmarshalAmino(bz)
+}
+
 type ecdsaSK struct {
 	ecdsa.PrivKey
 }
@@ -162,6 +162,9 @@ require (
 
 // Below are the long-lived replace of the Cosmos SDK
 replace (
+	// TODO: remove after the next api and collections releases
+	cosmossdk.io/api => ./api
+	cosmossdk.io/collections => ./collections
 	cosmossdk.io/core => ./core
 	cosmossdk.io/store => ./store
 	// TODO: remove after 0.7.0 release
@@ -32,6 +32,17 @@ func (m appModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						RpcMethod: "FileDescriptors",
 						Short:     "Queries the app's protobuf file descriptors",
 					},
+					{
+						RpcMethod: "CollectionsSchemas",
+						Short:     "Queries the collections schemas of the app's modules",
+					},
+					{
+						RpcMethod:      "DecodeStorePair",
+						Use:            "decode-store-pair [module] [key] --value [value]",
+						Short:          "Decodes a raw module store key and value into JSON",
+						Long:           "Decodes a raw module store key and value into JSON, using the collections schema of the module. The key and value are hex or base64 encoded, the value is omitted for deleted keys.",
+						PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "module"}, {ProtoField: "key"}},
+					},
 				},
 			},
 		},
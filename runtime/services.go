@@ -18,7 +18,7 @@ func (a *App) registerRuntimeServices(cfg module.Configurator) error {
 	appv1alpha1.RegisterQueryServer(cfg.QueryServer(), services.NewAppQueryService(a.appConfig))
 	autocliv1.RegisterQueryServer(cfg.QueryServer(), services.NewAutoCLIQueryService(a.ModuleManager.Modules))
 
-	reflectionSvc, err := services.NewReflectionService()
+	reflectionSvc, err := services.NewReflectionService(a.ModuleManager.Modules)
 	if err != nil {
 		return err
 	}
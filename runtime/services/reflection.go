@@ -2,25 +2,63 @@ package services
 
 import (
 	"context"
+	"errors"
+	"sort"
 
 	reflectionv1 "cosmossdk.io/api/cosmos/reflection/v1"
+	"cosmossdk.io/collections"
 	"github.com/cosmos/gogoproto/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/descriptorpb"
 )
 
+// HasCollectionsSchema is the interface that modules whose state is defined
+// with collections implement, to allow the reflection service to describe and
+// decode their store.
+type HasCollectionsSchema interface {
+	CollectionsSchema() collections.Schema
+}
+
 // ReflectionService implements the cosmos.reflection.v1 service.
 type ReflectionService struct {
 	reflectionv1.UnimplementedReflectionServiceServer
 	files *descriptorpb.FileDescriptorSet
+
+	schemas     map[string]collections.Schema
+	moduleNames []string
 }
 
-func NewReflectionService() (*ReflectionService, error) {
+// NewReflectionService creates a new reflection service. The collections
+// schemas of the provided app modules implementing HasCollectionsSchema are
+// exposed by the service.
+func NewReflectionService(appModules map[string]interface{}) (*ReflectionService, error) {
 	fds, err := proto.MergedGlobalFileDescriptors()
 	if err != nil {
 		return nil, err
 	}
 
-	return &ReflectionService{files: fds}, nil
+	schemas := CollectionsSchemas(appModules)
+	moduleNames := make([]string, 0, len(schemas))
+	for name := range schemas {
+		moduleNames = append(moduleNames, name)
+	}
+	sort.Strings(moduleNames)
+
+	return &ReflectionService{files: fds, schemas: schemas, moduleNames: moduleNames}, nil
+}
+
+// CollectionsSchemas returns the collections schemas of the provided app
+// modules implementing HasCollectionsSchema, by module name.
+func CollectionsSchemas(appModules map[string]interface{}) map[string]collections.Schema {
+	schemas := map[string]collections.Schema{}
+	for name, mod := range appModules {
+		if mod, ok := mod.(HasCollectionsSchema); ok {
+			schemas[name] = mod.CollectionsSchema()
+		}
+	}
+
+	return schemas
 }
 
 func (r ReflectionService) FileDescriptors(_ context.Context, _ *reflectionv1.FileDescriptorsRequest) (*reflectionv1.FileDescriptorsResponse, error) {
@@ -29,4 +67,58 @@ func (r ReflectionService) FileDescriptors(_ context.Context, _ *reflectionv1.Fi
 	}, nil
 }
 
+func (r ReflectionService) CollectionsSchemas(_ context.Context, _ *reflectionv1.CollectionsSchemasRequest) (*reflectionv1.CollectionsSchemasResponse, error) {
+	schemas := make([]*reflectionv1.ModuleCollectionsSchema, 0, len(r.moduleNames))
+	for _, name := range r.moduleNames {
+		colls := r.schemas[name].ListCollections()
+		descriptors := make([]*reflectionv1.CollectionDescriptor, 0, len(colls))
+		for _, coll := range colls {
+			descriptors = append(descriptors, &reflectionv1.CollectionDescriptor{
+				Name:      coll.Name,
+				Prefix:    coll.Prefix,
+				KeyType:   coll.KeyType,
+				ValueType: coll.ValueType,
+			})
+		}
+
+		schemas = append(schemas, &reflectionv1.ModuleCollectionsSchema{
+			Module:      name,
+			Collections: descriptors,
+		})
+	}
+
+	return &reflectionv1.CollectionsSchemasResponse{Schemas: schemas}, nil
+}
+
+func (r ReflectionService) DecodeStorePair(_ context.Context, req *reflectionv1.DecodeStorePairRequest) (*reflectionv1.DecodeStorePairResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	schema, ok := r.schemas[req.Module]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "module %s has no collections schema", req.Module)
+	}
+
+	// deleted keys have no value
+	value := req.Value
+	if len(value) == 0 {
+		value = nil
+	}
+
+	pair, err := schema.DecodePair(req.Key, value)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &reflectionv1.DecodeStorePairResponse{
+		Collection: pair.Collection,
+		KeyJson:    string(pair.Key),
+		ValueJson:  string(pair.Value),
+	}, nil
+}
+
 var _ reflectionv1.ReflectionServiceServer = &ReflectionService{}
@@ -4,12 +4,11 @@ import (
 	"context"
 	"io"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/core/store"
 
 	storetypes "cosmossdk.io/store/types"
 
-	dbm "github.com/cosmos/cosmos-db"
-
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -41,6 +40,51 @@ func (t transientStoreService) OpenTransientStore(ctx context.Context) store.KVS
 	return newKVStore(sdk.UnwrapSDKContext(ctx).KVStore(t.key))
 }
 
+// NewObjectStoreService returns the collections.ObjectStoreService of an
+// object store.
+func NewObjectStoreService(storeKey *storetypes.ObjectStoreKey) collections.ObjectStoreService {
+	return &objectStoreService{key: storeKey}
+}
+
+type objectStoreService struct {
+	key *storetypes.ObjectStoreKey
+}
+
+func (o objectStoreService) OpenObjectStore(ctx context.Context) collections.ObjectStore {
+	return objectStore{sdk.UnwrapSDKContext(ctx).ObjectStore(o.key)}
+}
+
+// objectStore is a wrapper of an object store implementing collections.ObjectStore.
+type objectStore struct {
+	objStore storetypes.ObjKVStore
+}
+
+func (s objectStore) Get(key []byte) (any, error) {
+	return s.objStore.Get(key), nil
+}
+
+func (s objectStore) Has(key []byte) (bool, error) {
+	return s.objStore.Has(key), nil
+}
+
+func (s objectStore) Set(key []byte, value any) error {
+	s.objStore.Set(key, value)
+	return nil
+}
+
+func (s objectStore) Delete(key []byte) error {
+	s.objStore.Delete(key)
+	return nil
+}
+
+func (s objectStore) Iterator(start, end []byte) (collections.ObjectIterator, error) {
+	return s.objStore.Iterator(start, end), nil
+}
+
+func (s objectStore) ReverseIterator(start, end []byte) (collections.ObjectIterator, error) {
+	return s.objStore.ReverseIterator(start, end), nil
+}
+
 // CoreKVStore is a wrapper of Core/Store kvstore interface
 // Remove after https://github.com/cosmos/cosmos-sdk/issues/14714 is closed
 type coreKVStore struct {
@@ -143,7 +187,7 @@ func (s kvStoreAdapter) Set(key, value []byte) {
 	}
 }
 
-func (s kvStoreAdapter) Iterator(start, end []byte) dbm.Iterator {
+func (s kvStoreAdapter) Iterator(start, end []byte) storetypes.Iterator {
 	it, err := s.store.Iterator(start, end)
 	if err != nil {
 		panic(err)
@@ -151,7 +195,7 @@ func (s kvStoreAdapter) Iterator(start, end []byte) dbm.Iterator {
 	return it
 }
 
-func (s kvStoreAdapter) ReverseIterator(start, end []byte) dbm.Iterator {
+func (s kvStoreAdapter) ReverseIterator(start, end []byte) storetypes.Iterator {
 	it, err := s.store.ReverseIterator(start, end)
 	if err != nil {
 		panic(err)
@@ -91,6 +91,10 @@ type BaseConfig struct {
 	// AppDBBackend defines the type of Database to use for the application and snapshots databases.
 	// An empty string indicates that the CometBFT config's DBBackend value should be used.
 	AppDBBackend string `mapstructure:"app-db-backend"`
+
+	// TxExecutionWorkers defines the number of workers executing the transactions of a block
+	// concurrently. Zero executes them one after the other.
+	TxExecutionWorkers int `mapstructure:"tx-execution-workers"`
 }
 
 // APIConfig defines the API listener configuration.
@@ -168,11 +172,22 @@ type MempoolConfig struct {
 	MaxTxs int
 }
 
+// ArchiveConfig defines the configuration of the archive store of the historical
+// state.
+type ArchiveConfig struct {
+	// Enable defines if the archive store is enabled. The state changes of every
+	// block are then archived, and queries at heights pruned from the IAVL stores
+	// are served from the archive, without proofs.
+	Enable bool `mapstructure:"enable"`
+}
+
 // State Streaming configuration
 type (
 	// StreamingConfig defines application configuration for external streaming services
 	StreamingConfig struct {
-		ABCI ABCIListenerConfig `mapstructure:"abci"`
+		ABCI    ABCIListenerConfig    `mapstructure:"abci"`
+		File    FileListenerConfig    `mapstructure:"file"`
+		Channel ChannelListenerConfig `mapstructure:"channel"`
 	}
 	// ABCIListenerConfig defines application configuration for ABCIListener streaming service
 	ABCIListenerConfig struct {
@@ -180,6 +195,22 @@ type (
 		Plugin        string   `mapstructure:"plugin"`
 		StopNodeOnErr bool     `mapstructure:"stop-node-on-err"`
 	}
+	// FileListenerConfig defines application configuration for the file streaming service
+	FileListenerConfig struct {
+		Keys          []string `mapstructure:"keys"`
+		WriteDir      string   `mapstructure:"write-dir"`
+		Prefix        string   `mapstructure:"prefix"`
+		MaxFileSize   int64    `mapstructure:"max-file-size"`
+		Fsync         bool     `mapstructure:"fsync"`
+		StopNodeOnErr bool     `mapstructure:"stop-node-on-err"`
+	}
+	// ChannelListenerConfig defines application configuration for the in-process channel streaming service
+	ChannelListenerConfig struct {
+		Keys          []string `mapstructure:"keys"`
+		BufferSize    int      `mapstructure:"buffer-size"`
+		Blocking      bool     `mapstructure:"blocking"`
+		StopNodeOnErr bool     `mapstructure:"stop-node-on-err"`
+	}
 )
 
 // Config defines the server's top level configuration
@@ -194,6 +225,7 @@ type Config struct {
 	StateSync StateSyncConfig  `mapstructure:"state-sync"`
 	Streaming StreamingConfig  `mapstructure:"streaming"`
 	Mempool   MempoolConfig    `mapstructure:"mempool"`
+	Archive   ArchiveConfig    `mapstructure:"archive"`
 }
 
 // SetMinGasPrices sets the validator's minimum gas prices.
@@ -238,6 +270,7 @@ func DefaultConfig() *Config {
 			IAVLDisableFastNode: false,
 			IAVLLazyLoading:     false,
 			AppDBBackend:        "",
+			TxExecutionWorkers:  0,
 		},
 		Telemetry: telemetry.Config{
 			Enabled:      false,
@@ -269,10 +302,23 @@ func DefaultConfig() *Config {
 				Keys:          []string{},
 				StopNodeOnErr: true,
 			},
+			File: FileListenerConfig{
+				Keys:          []string{},
+				MaxFileSize:   100 << 20,
+				StopNodeOnErr: true,
+			},
+			Channel: ChannelListenerConfig{
+				Keys:          []string{},
+				BufferSize:    1000,
+				StopNodeOnErr: true,
+			},
 		},
 		Mempool: MempoolConfig{
 			MaxTxs: 5_000,
 		},
+		Archive: ArchiveConfig{
+			Enable: false,
+		},
 	}
 }
 
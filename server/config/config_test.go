@@ -44,6 +44,20 @@ func TestStreamingConfig(t *testing.T) {
 				Plugin:        "plugin-A",
 				StopNodeOnErr: false,
 			},
+			File: FileListenerConfig{
+				Keys:          []string{"three"},
+				WriteDir:      "data/streaming",
+				Prefix:        "pre-",
+				MaxFileSize:   1024,
+				Fsync:         true,
+				StopNodeOnErr: true,
+			},
+			Channel: ChannelListenerConfig{
+				Keys:          []string{"*"},
+				BufferSize:    10,
+				Blocking:      true,
+				StopNodeOnErr: true,
+			},
 		},
 	}
 
@@ -60,6 +74,11 @@ func TestStreamingConfig(t *testing.T) {
 		`keys = ["one", "two", ]`,
 		`plugin = "plugin-A"`,
 		`stop-node-on-err = false`,
+		`keys = ["three", ]`,
+		`write-dir = "data/streaming"`,
+		`max-file-size = 1024`,
+		`buffer-size = 10`,
+		`blocking = true`,
 	}
 
 	for _, line := range expectedLines {
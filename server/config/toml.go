@@ -87,6 +87,11 @@ iavl-lazy-loading = {{ .BaseConfig.IAVLLazyLoading }}
 # Second fallback (if the types.DBBackend also isn't set), is the db-backend value set in CometBFT's config.toml.
 app-db-backend = "{{ .BaseConfig.AppDBBackend }}"
 
+# TxExecutionWorkers defines the number of workers executing the transactions of
+# a block concurrently, retrying the ones which conflict. The results are the ones
+# of the serial execution. Zero executes them one after the other.
+tx-execution-workers = {{ .BaseConfig.TxExecutionWorkers }}
+
 ###############################################################################
 ###                         Telemetry Configuration                         ###
 ###############################################################################
@@ -224,6 +229,46 @@ plugin = "{{ .Streaming.ABCI.Plugin }}"
 # stop-node-on-err specifies whether to stop the node on message delivery error.
 stop-node-on-err = {{ .Streaming.ABCI.StopNodeOnErr }}
 
+# streaming.file specifies the configuration for the file streaming service, writing
+# the ABCI messages and state changes of every block to length-prefixed protobuf files.
+[streaming.file]
+
+# List of kv store keys to stream out to the files, with the same format as streaming.abci.keys.
+keys = [{{ range .Streaming.File.Keys }}{{ printf "%q, " . }}{{end}}]
+
+# The directory the files are written to, relative to the node home if not absolute.
+# Streaming is only enabled if this is set.
+write-dir = "{{ .Streaming.File.WriteDir }}"
+
+# The prefix of the file names.
+prefix = "{{ .Streaming.File.Prefix }}"
+
+# The size in bytes after which a new file is started, files are only rotated between blocks (0 to disable).
+max-file-size = {{ .Streaming.File.MaxFileSize }}
+
+# fsync specifies whether to sync the files to disk on every commit.
+fsync = {{ .Streaming.File.Fsync }}
+
+# stop-node-on-err specifies whether to stop the node on file write error.
+stop-node-on-err = {{ .Streaming.File.StopNodeOnErr }}
+
+# streaming.channel specifies the configuration for the in-process channel streaming service,
+# whose records are consumed by the application through BaseApp.StreamingChannelListener.
+[streaming.channel]
+
+# List of kv store keys to stream out to the channel, with the same format as streaming.abci.keys.
+# Streaming is only enabled if this is set.
+keys = [{{ range .Streaming.Channel.Keys }}{{ printf "%q, " . }}{{end}}]
+
+# The capacity of the channel.
+buffer-size = {{ .Streaming.Channel.BufferSize }}
+
+# blocking specifies whether to wait for the consumer when the channel is full, instead of failing.
+blocking = {{ .Streaming.Channel.Blocking }}
+
+# stop-node-on-err specifies whether to stop the node when a record cannot be delivered.
+stop-node-on-err = {{ .Streaming.Channel.StopNodeOnErr }}
+
 ###############################################################################
 ###                         Mempool                                         ###
 ###############################################################################
@@ -236,6 +281,18 @@ stop-node-on-err = {{ .Streaming.ABCI.StopNodeOnErr }}
 # Note, this configuration only applies to SDK built-in app-side mempool
 # implementations.
 max-txs = "{{ .Mempool.MaxTxs }}"
+
+###############################################################################
+###                         Archive                                         ###
+###############################################################################
+
+[archive]
+# Enable defines if the archive store is enabled. The state changes of every block
+# are then archived in data/archive.db, and queries at heights pruned from the IAVL
+# stores are served from the archive, without proofs. When enabled on a node with
+# an existing state, the archive starts at the next block. The node halts if the
+# archive cannot be written or does not follow the state of the node.
+enable = {{ .Archive.Enable }}
 `
 
 var configTemplate *template.Template
@@ -0,0 +1,382 @@
+package server
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+
+	snapshottypes "cosmossdk.io/store/snapshots/types"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server/types"
+)
+
+// snapshotMetadataEntry is the name of the archive entry holding the snapshot metadata, the
+// following entries hold the chunks, named by their index.
+const snapshotMetadataEntry = "metadata"
+
+// SnapshotsCmd returns the snapshots command group, managing the local state-sync snapshots
+// of a node which is not running.
+func SnapshotsCmd(appCreator types.AppCreator, defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "snapshots",
+		Short: "Manage local state-sync snapshots",
+		Long: `Manage the local state-sync snapshots of the node, stored in the data/snapshots directory.
+The node must not be running while these commands are used.`,
+	}
+	cmd.AddCommand(
+		ListSnapshotsCmd(defaultNodeHome),
+		ExportSnapshotCmd(appCreator, defaultNodeHome),
+		DeleteSnapshotCmd(defaultNodeHome),
+		DumpSnapshotCmd(defaultNodeHome),
+		LoadSnapshotCmd(defaultNodeHome),
+		RestoreSnapshotCmd(appCreator, defaultNodeHome),
+	)
+	return cmd
+}
+
+// ListSnapshotsCmd returns a command listing the local snapshots.
+func ListSnapshotsCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List local snapshots",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := GetServerContextFromCmd(cmd)
+			snapshotStore, snapshotDB, err := openSnapshotStore(ctx.Viper)
+			if err != nil {
+				return err
+			}
+			defer snapshotDB.Close()
+			snapshots, err := snapshotStore.List()
+			if err != nil {
+				return fmt.Errorf("failed to list snapshots: %w", err)
+			}
+			for _, snapshot := range snapshots {
+				fmt.Fprintf(cmd.OutOrStdout(), "height: %d format: %d chunks: %d\n", snapshot.Height, snapshot.Format, snapshot.Chunks)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	return cmd
+}
+
+// ExportSnapshotCmd returns a command taking a snapshot of the application state at a given
+// height into the local snapshot store.
+func ExportSnapshotCmd(appCreator types.AppCreator, defaultNodeHome string) *cobra.Command {
+	var height int64
+
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Export the application state at a given height to a local snapshot",
+		Long: `Export the application state at a given height to a local snapshot. The height must not be
+pruned, it defaults to the latest height.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := GetServerContextFromCmd(cmd)
+			db, err := openDB(ctx.Config.RootDir, GetAppDBBackend(ctx.Viper))
+			if err != nil {
+				return err
+			}
+			app := appCreator(ctx.Logger, db, nil, ctx.Viper)
+
+			if height == 0 {
+				height = app.CommitMultiStore().LastCommitID().Version
+			}
+			if height <= 0 {
+				return fmt.Errorf("invalid height %d", height)
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "Exporting snapshot for height %d\n", height)
+			snapshot, err := app.SnapshotManager().Create(uint64(height))
+			if err != nil {
+				return fmt.Errorf("failed to create snapshot: %w", err)
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "Snapshot created at height %d, format %d, chunks %d\n", snapshot.Height, snapshot.Format, snapshot.Chunks)
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().Int64Var(&height, FlagHeight, 0, "Height to export, defaults to the latest height")
+	return cmd
+}
+
+// DeleteSnapshotCmd returns a command deleting a local snapshot.
+func DeleteSnapshotCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete <height> <format>",
+		Short: "Delete a local snapshot",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			height, format, err := parseSnapshotArgs(args)
+			if err != nil {
+				return err
+			}
+
+			ctx := GetServerContextFromCmd(cmd)
+			snapshotStore, snapshotDB, err := openSnapshotStore(ctx.Viper)
+			if err != nil {
+				return err
+			}
+			defer snapshotDB.Close()
+			return snapshotStore.Delete(height, format)
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	return cmd
+}
+
+// DumpSnapshotCmd returns a command writing a local snapshot to a single tar.gz archive.
+func DumpSnapshotCmd(defaultNodeHome string) *cobra.Command {
+	var output string
+
+	cmd := &cobra.Command{
+		Use:   "dump <height> <format>",
+		Short: "Dump a local snapshot to a tar.gz archive",
+		Long: `Dump a local snapshot to a tar.gz archive, which can be moved to another machine and loaded
+into its snapshot store with the load command.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			height, format, err := parseSnapshotArgs(args)
+			if err != nil {
+				return err
+			}
+			outputFile := output
+			if outputFile == "" {
+				outputFile = fmt.Sprintf("%d-%d.tar.gz", height, format)
+			}
+
+			ctx := GetServerContextFromCmd(cmd)
+			snapshotStore, snapshotDB, err := openSnapshotStore(ctx.Viper)
+			if err != nil {
+				return err
+			}
+			defer snapshotDB.Close()
+			snapshot, chunks, err := snapshotStore.Load(height, format)
+			if err != nil {
+				return err
+			}
+			if snapshot == nil {
+				return fmt.Errorf("snapshot at height %d format %d does not exist", height, format)
+			}
+			defer func() {
+				for chunk := range chunks {
+					_ = chunk.Close()
+				}
+			}()
+
+			metadata, err := snapshot.Marshal()
+			if err != nil {
+				return err
+			}
+
+			file, err := os.Create(outputFile)
+			if err != nil {
+				return err
+			}
+			defer func() {
+				if cerr := file.Close(); err == nil {
+					err = cerr
+				}
+			}()
+			gzipWriter := gzip.NewWriter(file)
+			tarWriter := tar.NewWriter(gzipWriter)
+
+			if err := writeTarEntry(tarWriter, snapshotMetadataEntry, metadata); err != nil {
+				return err
+			}
+			index := 0
+			for chunk := range chunks {
+				bz, err := io.ReadAll(chunk)
+				_ = chunk.Close()
+				if err != nil {
+					return fmt.Errorf("failed to read chunk %d: %w", index, err)
+				}
+				if err := writeTarEntry(tarWriter, strconv.Itoa(index), bz); err != nil {
+					return err
+				}
+				index++
+			}
+			if index != int(snapshot.Chunks) {
+				return fmt.Errorf("read %d chunks, expected %d", index, snapshot.Chunks)
+			}
+
+			if err := tarWriter.Close(); err != nil {
+				return err
+			}
+			if err := gzipWriter.Close(); err != nil {
+				return err
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "Snapshot dumped to %s\n", outputFile)
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().StringVarP(&output, "output", "o", "", "Output file, defaults to <height>-<format>.tar.gz")
+	return cmd
+}
+
+// LoadSnapshotCmd returns a command saving a snapshot from a tar.gz archive, as written by the
+// dump command, into the local snapshot store.
+func LoadSnapshotCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "load <archive-file>",
+		Short: "Load a snapshot from a tar.gz archive into the local snapshot store",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			file, err := os.Open(args[0])
+			if err != nil {
+				return err
+			}
+			defer file.Close()
+			gzipReader, err := gzip.NewReader(file)
+			if err != nil {
+				return fmt.Errorf("failed to open archive: %w", err)
+			}
+			tarReader := tar.NewReader(gzipReader)
+
+			header, err := tarReader.Next()
+			if err != nil {
+				return fmt.Errorf("failed to read snapshot metadata: %w", err)
+			}
+			if header.Name != snapshotMetadataEntry {
+				return fmt.Errorf("invalid archive, expected metadata entry, got %q", header.Name)
+			}
+			metadata, err := io.ReadAll(tarReader)
+			if err != nil {
+				return fmt.Errorf("failed to read snapshot metadata: %w", err)
+			}
+			var snapshot snapshottypes.Snapshot
+			if err := snapshot.Unmarshal(metadata); err != nil {
+				return fmt.Errorf("failed to decode snapshot metadata: %w", err)
+			}
+
+			ctx := GetServerContextFromCmd(cmd)
+			snapshotStore, snapshotDB, err := openSnapshotStore(ctx.Viper)
+			if err != nil {
+				return err
+			}
+			defer snapshotDB.Close()
+
+			chunks := make(chan io.ReadCloser)
+			var readErr error
+			go func() {
+				defer close(chunks)
+				for i := uint32(0); i < snapshot.Chunks; i++ {
+					header, err := tarReader.Next()
+					if err != nil {
+						readErr = fmt.Errorf("failed to read chunk %d: %w", i, err)
+						return
+					}
+					if header.Name != strconv.FormatUint(uint64(i), 10) {
+						readErr = fmt.Errorf("invalid archive, expected chunk %d, got %q", i, header.Name)
+						return
+					}
+					bz, err := io.ReadAll(tarReader)
+					if err != nil {
+						readErr = fmt.Errorf("failed to read chunk %d: %w", i, err)
+						return
+					}
+					chunks <- io.NopCloser(bytes.NewReader(bz))
+				}
+			}()
+
+			saved, err := snapshotStore.Save(snapshot.Height, snapshot.Format, chunks)
+			if err != nil {
+				return fmt.Errorf("failed to save snapshot: %w", err)
+			}
+			if readErr == nil && !bytes.Equal(saved.Hash, snapshot.Hash) {
+				readErr = errors.New("invalid archive, snapshot hash mismatch")
+			}
+			if readErr != nil {
+				if err := snapshotStore.Delete(saved.Height, saved.Format); err != nil {
+					return errors.Join(readErr, err)
+				}
+				return readErr
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "Snapshot loaded at height %d, format %d, chunks %d\n", saved.Height, saved.Format, saved.Chunks)
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	return cmd
+}
+
+// RestoreSnapshotCmd returns a command restoring the application state from a local snapshot,
+// without going through CometBFT state sync.
+func RestoreSnapshotCmd(appCreator types.AppCreator, defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "restore <height> <format>",
+		Short: "Restore the application state from a local snapshot",
+		Long: `Restore the application state from a local snapshot, without any CometBFT peer. The
+application database must be empty. The CometBFT state can then be bootstrapped at the same height with
+the "comet bootstrap-state" command.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			height, format, err := parseSnapshotArgs(args)
+			if err != nil {
+				return err
+			}
+
+			ctx := GetServerContextFromCmd(cmd)
+			db, err := openDB(ctx.Config.RootDir, GetAppDBBackend(ctx.Viper))
+			if err != nil {
+				return err
+			}
+			app := appCreator(ctx.Logger, db, nil, ctx.Viper)
+
+			if err := app.SnapshotManager().RestoreLocalSnapshot(height, format); err != nil {
+				return fmt.Errorf("failed to restore snapshot: %w", err)
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "Restored application state at height %d\n", height)
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	return cmd
+}
+
+// parseSnapshotArgs parses the height and format arguments of a snapshot command.
+func parseSnapshotArgs(args []string) (height uint64, format uint32, err error) {
+	height, err = strconv.ParseUint(args[0], 10, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid height %q: %w", args[0], err)
+	}
+	format64, err := strconv.ParseUint(args[1], 10, 32)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid format %q: %w", args[1], err)
+	}
+	return height, uint32(format64), nil
+}
+
+// writeTarEntry writes a regular file entry to the archive.
+func writeTarEntry(tarWriter *tar.Writer, name string, bz []byte) error {
+	if err := tarWriter.WriteHeader(&tar.Header{
+		Name: name,
+		Mode: 0o644,
+		Size: int64(len(bz)),
+	}); err != nil {
+		return fmt.Errorf("failed to write archive entry %s: %w", name, err)
+	}
+	if _, err := tarWriter.Write(bz); err != nil {
+		return fmt.Errorf("failed to write archive entry %s: %w", name, err)
+	}
+	return nil
+}
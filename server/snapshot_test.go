@@ -0,0 +1,78 @@
+package server_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"path/filepath"
+	"testing"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/snapshots"
+	snapshottypes "cosmossdk.io/store/snapshots/types"
+	cmtcfg "github.com/cometbft/cometbft/config"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/testutil/cmdtest"
+)
+
+func TestSnapshotsCmd_DumpLoad(t *testing.T) {
+	homeDir := t.TempDir()
+	sCtx := server.NewContext(viper.New(), cmtcfg.DefaultConfig(), log.NewNopLogger())
+	sCtx.Config.SetRoot(homeDir)
+	sCtx.Viper.Set(flags.FlagHome, homeDir)
+	ctx := context.WithValue(context.Background(), server.ServerContextKey, sCtx)
+
+	sys := cmdtest.NewSystem()
+	sys.AddCommands(server.SnapshotsCmd(nil, homeDir))
+
+	// save a snapshot of two chunks into the local snapshot store
+	chunks := [][]byte{bytes.Repeat([]byte{1}, 100), bytes.Repeat([]byte{2}, 50)}
+	snapshotDir := filepath.Join(homeDir, "data", "snapshots")
+	snapshotDB, err := dbm.NewDB("metadata", dbm.GoLevelDBBackend, snapshotDir)
+	require.NoError(t, err)
+	snapshotStore, err := snapshots.NewStore(snapshotDB, snapshotDir)
+	require.NoError(t, err)
+	ch := make(chan io.ReadCloser, len(chunks))
+	for _, chunk := range chunks {
+		ch <- io.NopCloser(bytes.NewReader(chunk))
+	}
+	close(ch)
+	snapshot, err := snapshotStore.Save(5, snapshottypes.CurrentFormat, ch)
+	require.NoError(t, err)
+	require.NoError(t, snapshotDB.Close())
+
+	res := sys.MustRunC(t, ctx, "snapshots", "list")
+	require.Equal(t, "height: 5 format: 4 chunks: 2\n", res.Stdout.String())
+
+	archive := filepath.Join(t.TempDir(), "snapshot.tar.gz")
+	sys.MustRunC(t, ctx, "snapshots", "dump", "5", "4", "-o", archive)
+
+	// dumping a missing snapshot fails
+	res = sys.RunC(ctx, "snapshots", "dump", "6", "4", "-o", archive)
+	require.Error(t, res.Err)
+
+	// loading a snapshot which already exists fails
+	res = sys.RunC(ctx, "snapshots", "load", archive)
+	require.Error(t, res.Err)
+
+	sys.MustRunC(t, ctx, "snapshots", "delete", "5", "4")
+	res = sys.MustRunC(t, ctx, "snapshots", "list")
+	require.Empty(t, res.Stdout.String())
+
+	sys.MustRunC(t, ctx, "snapshots", "load", archive)
+	snapshotStore, err = server.GetSnapshotStore(sCtx.Viper)
+	require.NoError(t, err)
+	loaded, loadedChunks, err := snapshotStore.Load(5, snapshottypes.CurrentFormat)
+	require.NoError(t, err)
+	require.Equal(t, snapshot, loaded)
+	for i := range chunks {
+		bz, err := io.ReadAll(<-loadedChunks)
+		require.NoError(t, err)
+		require.Equal(t, chunks[i], bz)
+	}
+}
@@ -9,8 +9,10 @@ import (
 
 	pruningtypes "cosmossdk.io/store/pruning/types"
 	"github.com/armon/go-metrics"
+	abcicli "github.com/cometbft/cometbft/abci/client"
 	"github.com/cometbft/cometbft/abci/server"
 	cmtcmd "github.com/cometbft/cometbft/cmd/cometbft/commands"
+	cmtsync "github.com/cometbft/cometbft/libs/sync"
 	"github.com/cometbft/cometbft/node"
 	"github.com/cometbft/cometbft/p2p"
 	pvm "github.com/cometbft/cometbft/privval"
@@ -23,6 +25,7 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -60,6 +63,7 @@ const (
 	FlagIAVLCacheSize       = "iavl-cache-size"
 	FlagDisableIAVLFastNode = "iavl-disable-fastnode"
 	FlagIAVLLazyLoading     = "iavl-lazy-loading"
+	FlagTxExecutionWorkers  = "tx-execution-workers"
 
 	// state sync-related flags
 	FlagStateSyncSnapshotInterval   = "state-sync.snapshot-interval"
@@ -83,6 +87,9 @@ const (
 
 	// mempool flags
 	FlagMempoolMaxTxs = "mempool.max-txs"
+
+	// archive flags
+	FlagArchiveEnable = "archive.enable"
 )
 
 // StartCmd runs the service passed in, either stand-alone or in-process with
@@ -184,7 +191,9 @@ is performed. Note, when enabled, gRPC will also be automatically enabled.
 	cmd.Flags().Uint64(FlagStateSyncSnapshotInterval, 0, "State sync snapshot interval")
 	cmd.Flags().Uint32(FlagStateSyncSnapshotKeepRecent, 2, "State sync snapshot to keep")
 	cmd.Flags().Bool(FlagDisableIAVLFastNode, false, "Disable fast node for IAVL tree")
+	cmd.Flags().Int(FlagTxExecutionWorkers, 0, "Number of workers executing the transactions of a block concurrently, 0 executes them one after the other")
 	cmd.Flags().Int(FlagMempoolMaxTxs, mempool.DefaultMaxTx, "Sets MaxTx value for the app-side mempool")
+	cmd.Flags().Bool(FlagArchiveEnable, false, "Archive the historical state to serve queries at pruned heights")
 
 	// support old flags name for backwards compatibility
 	cmd.Flags().SetNormalizeFunc(func(f *pflag.FlagSet, name string) pflag.NormalizedName {
@@ -252,7 +261,11 @@ func startStandAlone(svrCtx *Context, appCreator types.AppCreator) error {
 		// so we can gracefully stop the ABCI server.
 		<-ctx.Done()
 		svrCtx.Logger.Info("stopping the ABCI server...")
-		return svr.Stop()
+		if err := svr.Stop(); err != nil {
+			return err
+		}
+
+		return app.Close()
 	})
 
 	return g.Wait()
@@ -325,7 +338,7 @@ func startInProcess(svrCtx *Context, clientCtx client.Context, appCreator types.
 			cfg,
 			pvm.LoadOrGenFilePV(cfg.PrivValidatorKeyFile(), cfg.PrivValidatorStateFile()),
 			nodeKey,
-			proxy.NewLocalClientCreator(app),
+			newLocalClientCreator(app, config.TxExecutionWorkers),
 			genDocProvider,
 			node.DefaultDBProvider,
 			node.DefaultMetricsProvider(cfg.Instrumentation),
@@ -462,6 +475,10 @@ func startInProcess(svrCtx *Context, clientCtx client.Context, appCreator types.
 		if traceWriterCleanup != nil {
 			traceWriterCleanup()
 		}
+
+		if err := app.Close(); err != nil {
+			svrCtx.Logger.Error("failed to close application", "err", err)
+		}
 	}()
 
 	// wait for signal capture and gracefully return
@@ -530,3 +547,27 @@ func emitServerInfoMetrics() {
 
 	telemetry.SetGaugeWithLabels([]string{"server", "info"}, 1, ls)
 }
+
+// localClientCreator creates the local ABCI clients of an application whose
+// DeliverTx requests are delivered together, so that the transactions of a
+// block are executed concurrently.
+type localClientCreator struct {
+	mtx *cmtsync.Mutex
+	app baseapp.BlockApplication
+}
+
+// newLocalClientCreator returns the ClientCreator of the local ABCI clients of
+// the app, which execute the transactions of the blocks concurrently when
+// tx-execution-workers is enabled.
+func newLocalClientCreator(app types.Application, txExecutionWorkers int) proxy.ClientCreator {
+	blockApp, ok := app.(baseapp.BlockApplication)
+	if txExecutionWorkers == 0 || !ok {
+		return proxy.NewLocalClientCreator(app)
+	}
+
+	return localClientCreator{mtx: new(cmtsync.Mutex), app: blockApp}
+}
+
+func (c localClientCreator) NewABCIClient() (abcicli.Client, error) {
+	return baseapp.NewLocalClient(c.mtx, c.app), nil
+}
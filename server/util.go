@@ -25,6 +25,7 @@ import (
 
 	"cosmossdk.io/log"
 	"cosmossdk.io/store"
+	"cosmossdk.io/store/archive"
 	"cosmossdk.io/store/snapshots"
 	snapshottypes "cosmossdk.io/store/snapshots/types"
 	storetypes "cosmossdk.io/store/types"
@@ -332,6 +333,7 @@ func AddCommands(rootCmd *cobra.Command, defaultNodeHome string, appCreator type
 		ExportCmd(appExport, defaultNodeHome),
 		version.NewVersionCommand(),
 		NewRollbackCmd(appCreator, defaultNodeHome),
+		SnapshotsCmd(appCreator, defaultNodeHome),
 	)
 }
 
@@ -428,6 +430,35 @@ func addrToIP(addr net.Addr) net.IP {
 	return ip
 }
 
+// GetSnapshotStore opens the snapshot store of the node, located in the data directory of the
+// node home.
+func GetSnapshotStore(appOpts types.AppOptions) (*snapshots.Store, error) {
+	snapshotStore, _, err := openSnapshotStore(appOpts)
+	return snapshotStore, err
+}
+
+// openSnapshotStore opens the snapshot store of the node, also returning its metadata database
+// for the caller to close.
+func openSnapshotStore(appOpts types.AppOptions) (*snapshots.Store, dbm.DB, error) {
+	homeDir := cast.ToString(appOpts.Get(flags.FlagHome))
+	snapshotDir := filepath.Join(homeDir, "data", "snapshots")
+	if err := os.MkdirAll(snapshotDir, os.ModePerm); err != nil {
+		return nil, nil, fmt.Errorf("failed to create snapshots directory: %w", err)
+	}
+
+	snapshotDB, err := dbm.NewDB("metadata", GetAppDBBackend(appOpts), snapshotDir)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	snapshotStore, err := snapshots.NewStore(snapshotDB, snapshotDir)
+	if err != nil {
+		snapshotDB.Close()
+		return nil, nil, err
+	}
+	return snapshotStore, snapshotDB, nil
+}
+
 func openDB(rootDir string, backendType dbm.BackendType) (dbm.DB, error) {
 	dataDir := filepath.Join(rootDir, "data")
 	return dbm.NewDB("application", backendType, dataDir)
@@ -469,16 +500,7 @@ func DefaultBaseappOptions(appOpts types.AppOptions) []func(*baseapp.BaseApp) {
 		chainID = appGenesis.ChainID
 	}
 
-	snapshotDir := filepath.Join(homeDir, "data", "snapshots")
-	if err = os.MkdirAll(snapshotDir, os.ModePerm); err != nil {
-		panic(fmt.Errorf("failed to create snapshots directory: %w", err))
-	}
-
-	snapshotDB, err := dbm.NewDB("metadata", GetAppDBBackend(appOpts), snapshotDir)
-	if err != nil {
-		panic(err)
-	}
-	snapshotStore, err := snapshots.NewStore(snapshotDB, snapshotDir)
+	snapshotStore, err := GetSnapshotStore(appOpts)
 	if err != nil {
 		panic(err)
 	}
@@ -497,7 +519,7 @@ func DefaultBaseappOptions(appOpts types.AppOptions) []func(*baseapp.BaseApp) {
 		)
 	}
 
-	return []func(*baseapp.BaseApp){
+	baseappOptions := []func(*baseapp.BaseApp){
 		baseapp.SetPruning(pruningOpts),
 		baseapp.SetMinGasPrices(cast.ToString(appOpts.Get(FlagMinGasPrices))),
 		baseapp.SetHaltHeight(cast.ToUint64(appOpts.Get(FlagHaltHeight))),
@@ -512,5 +534,20 @@ func DefaultBaseappOptions(appOpts types.AppOptions) []func(*baseapp.BaseApp) {
 		defaultMempool,
 		baseapp.SetIAVLLazyLoading(cast.ToBool(appOpts.Get(FlagIAVLLazyLoading))),
 		baseapp.SetChainID(chainID),
+		baseapp.SetTxExecutionWorkers(cast.ToInt(appOpts.Get(FlagTxExecutionWorkers))),
 	}
+
+	if cast.ToBool(appOpts.Get(FlagArchiveEnable)) {
+		archiveDB, err := dbm.NewDB("archive", GetAppDBBackend(appOpts), filepath.Join(homeDir, "data"))
+		if err != nil {
+			panic(fmt.Errorf("failed to open archive database: %w", err))
+		}
+		archiveStore, err := archive.NewStore(archiveDB)
+		if err != nil {
+			panic(err)
+		}
+		baseappOptions = append(baseappOptions, baseapp.SetArchive(archiveStore))
+	}
+
+	return baseappOptions
 }
@@ -0,0 +1,92 @@
+package simapp
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	circuitante "cosmossdk.io/x/circuit/ante"
+	circuitkeeper "cosmossdk.io/x/circuit/keeper"
+	feemarketante "cosmossdk.io/x/feemarket/ante"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+)
+
+// HandlerOptions are the options required for constructing a default SDK AnteHandler.
+type HandlerOptions struct {
+	ante.HandlerOptions
+	CircuitKeeper *circuitkeeper.Keeper
+}
+
+// NewAnteHandler returns an AnteHandler that checks and increments sequence
+// numbers, checks signatures & account numbers, deducts fees from the first
+// signer and rejects messages disabled by the circuit breaker.
+func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
+	if options.AccountKeeper == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "account keeper is required for ante builder")
+	}
+
+	if options.BankKeeper == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "bank keeper is required for ante builder")
+	}
+
+	if options.SignModeHandler == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "sign mode handler is required for ante builder")
+	}
+
+	if options.CircuitKeeper == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "circuit keeper is required for ante builder")
+	}
+
+	maxUnorderedTxTimeoutDelta := options.MaxUnorderedTxTimeoutDelta
+	if maxUnorderedTxTimeoutDelta == 0 {
+		maxUnorderedTxTimeoutDelta = ante.DefaultMaxUnorderedTxTimeoutDelta
+	}
+
+	maxUnorderedTxTimeoutDuration := options.MaxUnorderedTxTimeoutDuration
+	if maxUnorderedTxTimeoutDuration == 0 {
+		maxUnorderedTxTimeoutDuration = ante.DefaultMaxUnorderedTxTimeoutDuration
+	}
+
+	anteDecorators := []sdk.AnteDecorator{
+		ante.NewSetUpContextDecorator(), // outermost AnteDecorator. SetUpContext must be called first
+		circuitante.NewCircuitBreakerDecorator(options.CircuitKeeper),
+		ante.NewExtensionOptionsDecorator(options.ExtensionOptionChecker),
+		ante.NewValidateBasicDecorator(),
+		ante.NewTxTimeoutHeightDecorator(),
+		ante.NewUnorderedTxDecorator(maxUnorderedTxTimeoutDelta, maxUnorderedTxTimeoutDuration, options.UnorderedTxKeeper),
+		ante.NewValidateMemoDecorator(options.AccountKeeper),
+		ante.NewConsumeGasForTxSizeDecorator(options.AccountKeeper),
+		ante.NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper, options.TxFeeChecker),
+		ante.NewSetPubKeyDecorator(options.AccountKeeper), // SetPubKeyDecorator must be called before all signature verification decorators
+		ante.NewValidateSigCountDecorator(options.AccountKeeper),
+		ante.NewSigGasConsumeDecorator(options.AccountKeeper, options.SigGasConsumer),
+		ante.NewSigVerificationDecorator(options.AccountKeeper, options.SignModeHandler),
+		ante.NewIncrementSequenceDecorator(options.AccountKeeper),
+	}
+
+	return sdk.ChainAnteDecorators(anteDecorators...), nil
+}
+
+func (app *SimApp) setAnteHandler(txConfig client.TxConfig, sigGasConsumer ante.SignatureVerificationGasConsumer) {
+	anteHandler, err := NewAnteHandler(
+		HandlerOptions{
+			ante.HandlerOptions{
+				AccountKeeper:     app.AccountKeeper,
+				BankKeeper:        app.BankKeeper,
+				SignModeHandler:   txConfig.SignModeHandler(),
+				FeegrantKeeper:    app.FeeGrantKeeper,
+				SigGasConsumer:    sigGasConsumer,
+				TxFeeChecker:      feemarketante.NewDynamicFeeChecker(&app.FeeMarketKeeper),
+				UnorderedTxKeeper: app.AccountKeeper,
+			},
+			&app.CircuitKeeper,
+		},
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	// Set the AnteHandler for the app
+	app.SetAnteHandler(anteHandler)
+}
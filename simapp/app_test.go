@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"cosmossdk.io/x/evidence"
+	"cosmossdk.io/x/feemarket"
 	"cosmossdk.io/x/upgrade"
 	abci "github.com/cometbft/cometbft/abci/types"
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
@@ -24,6 +25,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
 	"github.com/cosmos/cosmos-sdk/x/auth"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/vesting"
 	authzmodule "github.com/cosmos/cosmos-sdk/x/authz/module"
 	"github.com/cosmos/cosmos-sdk/x/bank"
@@ -199,6 +201,7 @@ func TestRunMigrations(t *testing.T) {
 					"evidence":     evidence.AppModule{}.ConsensusVersion(),
 					"crisis":       crisis.AppModule{}.ConsensusVersion(),
 					"genutil":      genutil.AppModule{}.ConsensusVersion(),
+					"feemarket":    feemarket.AppModule{}.ConsensusVersion(),
 				},
 			)
 			if tc.expRunErr {
@@ -287,3 +290,20 @@ func TestProtoAnnotations(t *testing.T) {
 	err = msgservice.ValidateProtoAnnotations(r)
 	require.NoError(t, err)
 }
+
+func TestCircuitBreakerRouting(t *testing.T) {
+	app := Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, cmtproto.Header{})
+
+	// the messages dispatched by modules, e.g. by a group or a gov proposal,
+	// are routed without going through the ante handler
+	msg := &banktypes.MsgSend{
+		FromAddress: authtypes.NewModuleAddress("from").String(),
+		ToAddress:   authtypes.NewModuleAddress("to").String(),
+		Amount:      sdk.NewCoins(),
+	}
+	require.NoError(t, app.CircuitKeeper.DisableList.Set(ctx, sdk.MsgTypeURL(msg)))
+
+	_, err := app.MsgServiceRouter().Handler(msg)(ctx, msg)
+	require.ErrorContains(t, err, "circuit breaker disables execution of this message")
+}
@@ -12,8 +12,10 @@ import (
 
 	"cosmossdk.io/depinject"
 	storetypes "cosmossdk.io/store/types"
+	circuitkeeper "cosmossdk.io/x/circuit/keeper"
 	evidencekeeper "cosmossdk.io/x/evidence/keeper"
 	feegrantkeeper "cosmossdk.io/x/feegrant/keeper"
+	feemarketkeeper "cosmossdk.io/x/feemarket/keeper"
 	nftkeeper "cosmossdk.io/x/nft/keeper"
 	upgradekeeper "cosmossdk.io/x/upgrade/keeper"
 
@@ -29,6 +31,7 @@ import (
 	testdata_pulsar "github.com/cosmos/cosmos-sdk/testutil/testdata/testpb"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
 	authsims "github.com/cosmos/cosmos-sdk/x/auth/simulation"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -81,6 +84,8 @@ type SimApp struct {
 	GroupKeeper           groupkeeper.Keeper
 	NFTKeeper             nftkeeper.Keeper
 	ConsensusParamsKeeper consensuskeeper.Keeper
+	CircuitKeeper         circuitkeeper.Keeper
+	FeeMarketKeeper       feemarketkeeper.Keeper
 
 	// simulation manager
 	sm *module.SimulationManager
@@ -107,6 +112,9 @@ func NewSimApp(
 	var (
 		app        = &SimApp{}
 		appBuilder *runtime.AppBuilder
+		// the signature gas consumer accepts the account key types of the auth
+		// module config
+		sigGasConsumer ante.SignatureVerificationGasConsumer
 
 		// merge the AppConfig and other configuration in one config
 		appConfig = depinject.Configs(
@@ -165,6 +173,9 @@ func NewSimApp(
 		&app.GroupKeeper,
 		&app.NFTKeeper,
 		&app.ConsensusParamsKeeper,
+		&app.CircuitKeeper,
+		&app.FeeMarketKeeper,
+		&sigGasConsumer,
 	); err != nil {
 		panic(err)
 	}
@@ -223,6 +234,16 @@ func NewSimApp(
 
 	app.sm.RegisterStoreDecoders()
 
+	// The default ante handler provided by the tx module is skipped in the app
+	// config, so that the circuit breaker decorator and the feemarket fee
+	// checker can be added to the chain.
+	app.setAnteHandler(app.txConfig, sigGasConsumer)
+
+	// The circuit breaker is checked by the msg service router, so that the
+	// disabled messages dispatched by modules, e.g. by x/gov or x/group, are
+	// rejected as well.
+	app.SetCircuitBreaker(&app.CircuitKeeper)
+
 	// A custom InitChainer can be set if extra pre-init-genesis logic is required.
 	// By default, when using app wiring enabled module, this is not required.
 	// For instance, the upgrade module will set automatically the module version map in its init genesis thanks to app wiring.
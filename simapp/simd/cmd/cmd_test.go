@@ -40,3 +40,14 @@ func TestHomeFlagRegistration(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, result, homeDir)
 }
+
+func TestNFTTxCommands(t *testing.T) {
+	rootCmd := cmd.NewRootCmd()
+
+	// the nft transaction commands are generated by autocli
+	for _, name := range []string{"send", "save-class", "update-class", "mint", "burn", "update-nft"} {
+		subCmd, _, err := rootCmd.Find([]string{"tx", "nft", name})
+		require.NoError(t, err)
+		require.Equal(t, name, subCmd.Name())
+	}
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/spf13/viper"
 
 	"cosmossdk.io/client/v2/autocli"
+	"cosmossdk.io/collections"
 	"cosmossdk.io/depinject"
 	"cosmossdk.io/log"
 	"cosmossdk.io/simapp"
@@ -27,6 +28,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/rpc"
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	runtimeservices "github.com/cosmos/cosmos-sdk/runtime/services"
 	"github.com/cosmos/cosmos-sdk/server"
 	serverconfig "github.com/cosmos/cosmos-sdk/server/config"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
@@ -113,7 +115,11 @@ func NewRootCmd() *cobra.Command {
 		},
 	}
 
-	initRootCmd(rootCmd, txConfig, interfaceRegistry, appCodec, moduleBasicManager)
+	appModules := make(map[string]interface{}, len(autoCliOpts.Modules))
+	for name, mod := range autoCliOpts.Modules {
+		appModules[name] = mod
+	}
+	initRootCmd(rootCmd, txConfig, interfaceRegistry, appCodec, moduleBasicManager, runtimeservices.CollectionsSchemas(appModules))
 
 	if err := autoCliOpts.EnhanceRootCommand(rootCmd); err != nil {
 		panic(err)
@@ -197,6 +203,7 @@ func initRootCmd(
 	interfaceRegistry codectypes.InterfaceRegistry,
 	appCodec codec.Codec,
 	basicManager module.BasicManager,
+	schemas map[string]collections.Schema,
 ) {
 	cfg := sdk.GetConfig()
 	cfg.Seal()
@@ -204,7 +211,7 @@ func initRootCmd(
 	rootCmd.AddCommand(
 		genutilcli.InitCmd(basicManager, simapp.DefaultNodeHome),
 		NewTestnetCmd(basicManager, banktypes.GenesisBalancesIterator{}),
-		debug.Cmd(),
+		debug.CmdWithCollectionsSchemas(schemas),
 		confixcmd.ConfigCommand(),
 		pruning.Cmd(newApp),
 	)
@@ -272,9 +279,11 @@ func txCommand() *cobra.Command {
 		authcmd.GetSignCommand(),
 		authcmd.GetSignBatchCommand(),
 		authcmd.GetMultiSignCommand(),
+		authcmd.GetMultiSignStatusCommand(),
 		authcmd.GetMultiSignBatchCmd(),
 		authcmd.GetValidateSignaturesCommand(),
 		authcmd.GetBroadcastCommand(),
+		authcmd.GetBatchCommand(),
 		authcmd.GetEncodeCommand(),
 		authcmd.GetDecodeCommand(),
 		authcmd.GetAuxToFeeCommand(),
@@ -0,0 +1,257 @@
+package archive
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"sync"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	dbm "github.com/cosmos/cosmos-db"
+
+	"cosmossdk.io/errors"
+	"cosmossdk.io/store/types"
+)
+
+const (
+	// batchSize is the number of writes after which an import batch is flushed.
+	batchSize = 10000
+
+	valueSet    byte = 0
+	valueDelete byte = 1
+)
+
+var (
+	dataPrefix   = []byte{'d'}
+	startKey     = []byte("m/start")
+	latestKey    = []byte("m/latest")
+	keyDelimiter = []byte{0, 0}
+
+	_ types.ABCIListener = (*Store)(nil)
+)
+
+// Store is a flat versioned key-value archive of the state of the stores of a multistore. Every
+// write of a version is kept, so that the state of any version between the first and the latest
+// archived versions can be read, regardless of the pruning of the multistore. It does not
+// support proofs.
+//
+// The archive is fed as an ABCIListener from the state changes streamed at every commit, the
+// archived versions must therefore be contiguous.
+type Store struct {
+	db dbm.DB
+
+	mtx    sync.RWMutex
+	start  int64
+	latest int64
+
+	// height is the height of the block being streamed.
+	height int64
+}
+
+// NewStore returns an archive store persisted in the given database.
+func NewStore(db dbm.DB) (*Store, error) {
+	start, err := getVersion(db, startKey)
+	if err != nil {
+		return nil, err
+	}
+	latest, err := getVersion(db, latestKey)
+	if err != nil {
+		return nil, err
+	}
+	return &Store{db: db, start: start, latest: latest}, nil
+}
+
+// Versions returns the first and the latest archived versions, both are zero if the archive is
+// empty.
+func (s *Store) Versions() (start, latest int64) {
+	s.mtx.RLock()
+	defer s.mtx.RUnlock()
+	return s.start, s.latest
+}
+
+// IsEmpty returns whether no version has been archived yet.
+func (s *Store) IsEmpty() bool {
+	_, latest := s.Versions()
+	return latest == 0
+}
+
+// HasVersion returns whether the state of the given version can be read from the archive.
+func (s *Store) HasVersion(version int64) bool {
+	start, latest := s.Versions()
+	return latest > 0 && start <= version && version <= latest
+}
+
+// Import writes the full state of a store at the given version, taken from the iterator, into
+// an empty archive. It is used to seed the archive from an existing state, before committing the
+// version.
+func (s *Store) Import(version int64, storeName string, iterator types.Iterator) error {
+	defer iterator.Close()
+	if !s.IsEmpty() {
+		return errors.Wrap(types.ErrLogic, "cannot import into a non empty archive")
+	}
+
+	batch := s.db.NewBatch()
+	defer func() { _ = batch.Close() }()
+	n := 0
+	for ; iterator.Valid(); iterator.Next() {
+		if err := batch.Set(dataKey(storeName, iterator.Key(), version), dataValue(false, iterator.Value())); err != nil {
+			return err
+		}
+		n++
+		if n%batchSize == 0 {
+			if err := batch.Write(); err != nil {
+				return err
+			}
+			_ = batch.Close()
+			batch = s.db.NewBatch()
+		}
+	}
+	if err := iterator.Error(); err != nil {
+		return err
+	}
+	return batch.Write()
+}
+
+// Commit archives the state changes of a version. The version must follow the latest archived
+// version, unless the archive is empty.
+func (s *Store) Commit(version int64, changeSet []*types.StoreKVPair) error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	if s.latest != 0 && version != s.latest+1 {
+		return fmt.Errorf("cannot archive version %d, the latest archived version is %d", version, s.latest)
+	}
+
+	batch := s.db.NewBatch()
+	defer batch.Close()
+	for _, pair := range changeSet {
+		if err := batch.Set(dataKey(pair.StoreKey, pair.Key, version), dataValue(pair.Delete, pair.Value)); err != nil {
+			return err
+		}
+	}
+	start := s.start
+	if s.latest == 0 {
+		start = version
+		if err := batch.Set(startKey, encodeVersion(version)); err != nil {
+			return err
+		}
+	}
+	if err := batch.Set(latestKey, encodeVersion(version)); err != nil {
+		return err
+	}
+	if err := batch.WriteSync(); err != nil {
+		return err
+	}
+
+	s.start, s.latest = start, version
+	return nil
+}
+
+// KVStore returns a read-only view of a store at the given version, which must be archived.
+func (s *Store) KVStore(storeName string, version int64) types.KVStore {
+	return &versionedStore{archive: s, prefix: storePrefix(storeName), version: version}
+}
+
+// ListenBeginBlock implements types.ABCIListener, it tracks the height of the block.
+func (s *Store) ListenBeginBlock(_ context.Context, req abci.RequestBeginBlock, _ abci.ResponseBeginBlock) error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	s.height = req.Header.Height
+	return nil
+}
+
+// ListenEndBlock implements types.ABCIListener.
+func (s *Store) ListenEndBlock(context.Context, abci.RequestEndBlock, abci.ResponseEndBlock) error {
+	return nil
+}
+
+// ListenDeliverTx implements types.ABCIListener.
+func (s *Store) ListenDeliverTx(context.Context, abci.RequestDeliverTx, abci.ResponseDeliverTx) error {
+	return nil
+}
+
+// ListenCommit implements types.ABCIListener, it archives the state changes of the block.
+func (s *Store) ListenCommit(_ context.Context, _ abci.ResponseCommit, changeSet []*types.StoreKVPair) error {
+	return s.Commit(s.Height(), changeSet)
+}
+
+// Close closes the database of the archive.
+func (s *Store) Close() error {
+	return s.db.Close()
+}
+
+// Height returns the height of the block being streamed.
+func (s *Store) Height() int64 {
+	s.mtx.RLock()
+	defer s.mtx.RUnlock()
+	return s.height
+}
+
+func getVersion(db dbm.DB, key []byte) (int64, error) {
+	bz, err := db.Get(key)
+	if err != nil || bz == nil {
+		return 0, err
+	}
+	if len(bz) != 8 {
+		return 0, fmt.Errorf("invalid archive version %x", bz)
+	}
+	return int64(binary.BigEndian.Uint64(bz)), nil
+}
+
+func encodeVersion(version int64) []byte {
+	return binary.BigEndian.AppendUint64(nil, uint64(version))
+}
+
+// storePrefix returns the prefix of the data keys of a store.
+func storePrefix(storeName string) []byte {
+	prefix := make([]byte, 0, len(dataPrefix)+1+len(storeName))
+	prefix = append(prefix, dataPrefix...)
+	prefix = append(prefix, byte(len(storeName)))
+	return append(prefix, storeName...)
+}
+
+// dataKey returns the data key of a store key at a version. The key is escaped such that the
+// data keys of a store are ordered by key, then by version.
+func dataKey(storeName string, key []byte, version int64) []byte {
+	return binary.BigEndian.AppendUint64(escapeKey(storePrefix(storeName), key), uint64(version))
+}
+
+// escapeKey appends the key to bz, escaping its zero bytes as 0x00 0xff and terminating it with
+// 0x00 0x00, which preserves the ordering of the keys.
+func escapeKey(bz, key []byte) []byte {
+	for _, b := range key {
+		bz = append(bz, b)
+		if b == 0 {
+			bz = append(bz, 0xff)
+		}
+	}
+	return append(bz, keyDelimiter...)
+}
+
+// splitDataKey returns the escaped key and the version of a data key without its store prefix.
+func splitDataKey(bz []byte) (escapedKey []byte, version int64, err error) {
+	if len(bz) < len(keyDelimiter)+8 {
+		return nil, 0, fmt.Errorf("invalid archive key %x", bz)
+	}
+	n := len(bz) - 8
+	return bz[:n], int64(binary.BigEndian.Uint64(bz[n:])), nil
+}
+
+// unescapeKey returns the key of an escaped key.
+func unescapeKey(escaped []byte) []byte {
+	key := make([]byte, 0, len(escaped)-len(keyDelimiter))
+	for i := 0; i < len(escaped)-len(keyDelimiter); i++ {
+		key = append(key, escaped[i])
+		if escaped[i] == 0 {
+			i++
+		}
+	}
+	return key
+}
+
+func dataValue(deleted bool, value []byte) []byte {
+	if deleted {
+		return []byte{valueDelete}
+	}
+	return append([]byte{valueSet}, value...)
+}
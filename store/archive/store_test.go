@@ -0,0 +1,126 @@
+package archive_test
+
+import (
+	"testing"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/store/archive"
+	"cosmossdk.io/store/types"
+)
+
+func set(store, key, value string) *types.StoreKVPair {
+	return &types.StoreKVPair{StoreKey: store, Key: []byte(key), Value: []byte(value)}
+}
+
+func del(store, key string) *types.StoreKVPair {
+	return &types.StoreKVPair{StoreKey: store, Key: []byte(key), Delete: true}
+}
+
+func iterate(it types.Iterator) []string {
+	defer it.Close()
+	var kvs []string
+	for ; it.Valid(); it.Next() {
+		kvs = append(kvs, string(it.Key())+"="+string(it.Value()))
+	}
+	return kvs
+}
+
+func TestStore(t *testing.T) {
+	db := dbm.NewMemDB()
+	store, err := archive.NewStore(db)
+	require.NoError(t, err)
+	require.True(t, store.IsEmpty())
+	require.False(t, store.HasVersion(1))
+
+	require.NoError(t, store.Commit(3, []*types.StoreKVPair{
+		set("bank", "a", "1"),
+		set("bank", "b", "1"),
+		set("bank", "a\x00", "1"),
+		set("acc", "a", "acc"),
+	}))
+	require.NoError(t, store.Commit(4, []*types.StoreKVPair{
+		set("bank", "a", "2"),
+		del("bank", "b"),
+		set("bank", "c", "2"),
+	}))
+	require.NoError(t, store.Commit(5, nil))
+	require.NoError(t, store.Commit(6, []*types.StoreKVPair{
+		set("bank", "b", "6"),
+		del("bank", "a"),
+		set("bank", "a", "6"),
+		del("bank", "c"),
+	}))
+
+	// versions must be contiguous
+	require.Error(t, store.Commit(8, nil))
+	require.Error(t, store.Commit(6, nil))
+
+	start, latest := store.Versions()
+	require.Equal(t, int64(3), start)
+	require.Equal(t, int64(6), latest)
+	require.False(t, store.HasVersion(2))
+	require.True(t, store.HasVersion(5))
+	require.False(t, store.HasVersion(7))
+
+	bank := store.KVStore("bank", 3)
+	require.Equal(t, []byte("1"), bank.Get([]byte("a")))
+	require.Equal(t, []byte("1"), bank.Get([]byte("b")))
+	require.Nil(t, bank.Get([]byte("c")))
+	require.Equal(t, []string{"a=1", "a\x00=1", "b=1"}, iterate(bank.Iterator(nil, nil)))
+	require.Equal(t, []string{"b=1", "a\x00=1", "a=1"}, iterate(bank.ReverseIterator(nil, nil)))
+
+	bank = store.KVStore("bank", 5)
+	require.Equal(t, []byte("2"), bank.Get([]byte("a")))
+	require.False(t, bank.Has([]byte("b")))
+	require.Equal(t, []string{"a=2", "a\x00=1", "c=2"}, iterate(bank.Iterator(nil, nil)))
+	require.Equal(t, []string{"c=2", "a\x00=1", "a=2"}, iterate(bank.ReverseIterator(nil, nil)))
+	require.Equal(t, []string{"a\x00=1"}, iterate(bank.Iterator([]byte("a\x00"), []byte("c"))))
+	require.Equal(t, []string{"a\x00=1"}, iterate(bank.ReverseIterator([]byte("a\x00"), []byte("c"))))
+	require.Panics(t, func() { bank.Set([]byte("a"), []byte("3")) })
+
+	bank = store.KVStore("bank", 6)
+	require.Equal(t, []string{"a=6", "a\x00=1", "b=6"}, iterate(bank.Iterator(nil, nil)))
+	require.Equal(t, []string{"b=6", "a\x00=1", "a=6"}, iterate(bank.ReverseIterator(nil, nil)))
+
+	require.Equal(t, []string{"a=acc"}, iterate(store.KVStore("acc", 6).Iterator(nil, nil)))
+	require.Empty(t, iterate(store.KVStore("gov", 6).Iterator(nil, nil)))
+
+	// writes are branched by the cache wrapper
+	cache := store.KVStore("bank", 6).CacheWrap().(types.CacheKVStore)
+	cache.Set([]byte("d"), []byte("7"))
+	require.Equal(t, []byte("7"), cache.Get([]byte("d")))
+	require.Nil(t, store.KVStore("bank", 6).Get([]byte("d")))
+
+	// the archive is persisted
+	store, err = archive.NewStore(db)
+	require.NoError(t, err)
+	start, latest = store.Versions()
+	require.Equal(t, int64(3), start)
+	require.Equal(t, int64(6), latest)
+	require.Equal(t, []byte("6"), store.KVStore("bank", 6).Get([]byte("b")))
+}
+
+func TestStoreImport(t *testing.T) {
+	source := dbm.NewMemDB()
+	require.NoError(t, source.Set([]byte("a"), []byte("1")))
+	require.NoError(t, source.Set([]byte("b"), []byte("2")))
+
+	store, err := archive.NewStore(dbm.NewMemDB())
+	require.NoError(t, err)
+
+	it, err := source.Iterator(nil, nil)
+	require.NoError(t, err)
+	require.NoError(t, store.Import(10, "bank", it))
+	require.NoError(t, store.Commit(10, nil))
+	require.NoError(t, store.Commit(11, []*types.StoreKVPair{del("bank", "a")}))
+
+	require.Equal(t, []string{"a=1", "b=2"}, iterate(store.KVStore("bank", 10).Iterator(nil, nil)))
+	require.Equal(t, []string{"b=2"}, iterate(store.KVStore("bank", 11).Iterator(nil, nil)))
+
+	// only an empty archive can be seeded
+	it, err = source.Iterator(nil, nil)
+	require.NoError(t, err)
+	require.Error(t, store.Import(12, "bank", it))
+}
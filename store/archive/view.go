@@ -0,0 +1,226 @@
+package archive
+
+import (
+	"bytes"
+	"io"
+
+	dbm "github.com/cosmos/cosmos-db"
+
+	"cosmossdk.io/store/cachekv"
+	"cosmossdk.io/store/tracekv"
+	"cosmossdk.io/store/types"
+)
+
+var _ types.KVStore = (*versionedStore)(nil)
+
+// versionedStore is a read-only view of an archived store at a version.
+type versionedStore struct {
+	archive *Store
+	prefix  []byte
+	version int64
+}
+
+// GetStoreType implements types.Store.
+func (vs *versionedStore) GetStoreType() types.StoreType {
+	return types.StoreTypeDB
+}
+
+// CacheWrap implements types.CacheWrapper.
+func (vs *versionedStore) CacheWrap() types.CacheWrap {
+	return cachekv.NewStore(vs)
+}
+
+// CacheWrapWithTrace implements types.CacheWrapper.
+func (vs *versionedStore) CacheWrapWithTrace(w io.Writer, tc types.TraceContext) types.CacheWrap {
+	return cachekv.NewStore(tracekv.NewStore(vs, w, tc))
+}
+
+// Get implements types.KVStore, returning the latest value of the key at the version.
+func (vs *versionedStore) Get(key []byte) []byte {
+	types.AssertValidKey(key)
+
+	escaped := escapeKey(vs.prefix, key)
+	it, err := vs.archive.db.ReverseIterator(
+		append(bytes.Clone(escaped), encodeVersion(0)...),
+		append(escaped, encodeVersion(vs.version+1)...),
+	)
+	if err != nil {
+		panic(err)
+	}
+	defer it.Close()
+	if !it.Valid() {
+		return nil
+	}
+	return decodeValue(it.Value())
+}
+
+// Has implements types.KVStore.
+func (vs *versionedStore) Has(key []byte) bool {
+	return vs.Get(key) != nil
+}
+
+// Set implements types.KVStore, it panics as the archive is read-only.
+func (vs *versionedStore) Set(_, _ []byte) {
+	panic("cannot write to an archived store")
+}
+
+// Delete implements types.KVStore, it panics as the archive is read-only.
+func (vs *versionedStore) Delete(_ []byte) {
+	panic("cannot delete from an archived store")
+}
+
+// Iterator implements types.KVStore.
+func (vs *versionedStore) Iterator(start, end []byte) types.Iterator {
+	return vs.iterator(start, end, false)
+}
+
+// ReverseIterator implements types.KVStore.
+func (vs *versionedStore) ReverseIterator(start, end []byte) types.Iterator {
+	return vs.iterator(start, end, true)
+}
+
+func (vs *versionedStore) iterator(start, end []byte, reverse bool) types.Iterator {
+	lower := vs.prefix
+	if start != nil {
+		lower = escapeKey(bytes.Clone(vs.prefix), start)
+	}
+	var upper []byte
+	if end != nil {
+		upper = escapeKey(bytes.Clone(vs.prefix), end)
+	} else {
+		upper = types.PrefixEndBytes(vs.prefix)
+	}
+
+	var (
+		parent dbm.Iterator
+		err    error
+	)
+	if reverse {
+		parent, err = vs.archive.db.ReverseIterator(lower, upper)
+	} else {
+		parent, err = vs.archive.db.Iterator(lower, upper)
+	}
+	if err != nil {
+		panic(err)
+	}
+
+	it := &versionedIterator{
+		parent:  parent,
+		prefix:  vs.prefix,
+		version: vs.version,
+		reverse: reverse,
+		start:   start,
+		end:     end,
+	}
+	it.next()
+	return it
+}
+
+// versionedIterator iterates over the latest values of the keys of an archived store at a
+// version. The parent iterator yields, for every key, the entries of all its versions in
+// ascending order of versions (descending if reverse).
+type versionedIterator struct {
+	parent  dbm.Iterator
+	prefix  []byte
+	version int64
+	reverse bool
+
+	start, end []byte
+
+	key, value []byte
+	valid      bool
+	err        error
+}
+
+var _ types.Iterator = (*versionedIterator)(nil)
+
+// next moves to the next key having a value at the version.
+func (it *versionedIterator) next() {
+	for it.parent.Valid() {
+		escaped, _, err := splitDataKey(it.parent.Key()[len(it.prefix):])
+		if err != nil {
+			it.err, it.valid = err, false
+			return
+		}
+		escaped = bytes.Clone(escaped)
+
+		// find the entry of the key at the version among all its versions
+		var value []byte
+		found := false
+		for ; it.parent.Valid(); it.parent.Next() {
+			entryKey, version, err := splitDataKey(it.parent.Key()[len(it.prefix):])
+			if err != nil {
+				it.err, it.valid = err, false
+				return
+			}
+			if !bytes.Equal(entryKey, escaped) {
+				break
+			}
+			if version > it.version || (it.reverse && found) {
+				continue
+			}
+			value, found = decodeValue(it.parent.Value()), true
+		}
+
+		if value != nil {
+			it.key, it.value, it.valid = unescapeKey(escaped), value, true
+			return
+		}
+	}
+	it.key, it.value, it.valid = nil, nil, false
+}
+
+// Domain implements types.Iterator.
+func (it *versionedIterator) Domain() (start, end []byte) {
+	return it.start, it.end
+}
+
+// Valid implements types.Iterator.
+func (it *versionedIterator) Valid() bool {
+	return it.valid
+}
+
+// Next implements types.Iterator.
+func (it *versionedIterator) Next() {
+	if !it.valid {
+		panic("iterator is invalid")
+	}
+	it.next()
+}
+
+// Key implements types.Iterator.
+func (it *versionedIterator) Key() []byte {
+	if !it.valid {
+		panic("iterator is invalid")
+	}
+	return it.key
+}
+
+// Value implements types.Iterator.
+func (it *versionedIterator) Value() []byte {
+	if !it.valid {
+		panic("iterator is invalid")
+	}
+	return it.value
+}
+
+// Error implements types.Iterator.
+func (it *versionedIterator) Error() error {
+	if it.err != nil {
+		return it.err
+	}
+	return it.parent.Error()
+}
+
+// Close implements types.Iterator.
+func (it *versionedIterator) Close() error {
+	return it.parent.Close()
+}
+
+// decodeValue returns the value of a data entry, nil if the key was deleted.
+func decodeValue(bz []byte) []byte {
+	if len(bz) == 0 || bz[0] == valueDelete {
+		return nil
+	}
+	return bz[1:]
+}
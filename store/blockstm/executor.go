@@ -0,0 +1,135 @@
+package blockstm
+
+import (
+	"fmt"
+	"sync"
+
+	"cosmossdk.io/store/types"
+)
+
+// ExecuteFn executes the transaction of the given index of the block on the
+// given multistore.
+type ExecuteFn func(txIndex int, ms types.MultiStore)
+
+// ExecuteBlock executes the transactions of a block optimistically and
+// concurrently with the given number of workers, following Block-STM. Every
+// transaction is executed on a multistore through which it reads the values
+// written by the lower transactions, and its reads and writes are recorded. A
+// transaction is executed again whenever the values it read may have changed,
+// until the reads of all the transactions are valid, so that the outcome is the
+// one of their sequential execution.
+//
+// The multistore the block is executed on is only read from. It returns the
+// write sets of the transactions, the caller applies them to the multistore in
+// the order of the block. A transaction may be executed several times, but
+// never concurrently, the outcome of its latest execution is the valid one. If
+// the latest execution of a transaction panics, ExecuteBlock panics with the
+// value of the lowest such transaction once the execution of the block is done.
+func ExecuteBlock(blockSize int, ms types.MultiStore, workers int, execute ExecuteFn) []*WriteSet {
+	if workers < 1 {
+		panic(fmt.Sprintf("invalid number of workers: %d", workers))
+	}
+
+	e := &executor{
+		base:      ms,
+		mv:        newMVMemory(blockSize),
+		scheduler: newScheduler(blockSize),
+		execute:   execute,
+		writeSets: make([]*WriteSet, blockSize),
+		panics:    make([]any, blockSize),
+	}
+	if blockSize == 0 {
+		return e.writeSets
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			e.run()
+		}()
+	}
+	wg.Wait()
+
+	for _, r := range e.panics {
+		if r != nil {
+			panic(r)
+		}
+	}
+	return e.writeSets
+}
+
+type executor struct {
+	base      types.MultiStore
+	mv        *mvMemory
+	scheduler *scheduler
+	execute   ExecuteFn
+
+	writeSets []*WriteSet
+	// panics holds the values the latest executions of the transactions
+	// panicked with, an execution may panic on values which are not valid.
+	panics []any
+}
+
+// run runs tasks until the execution of the block is done.
+func (e *executor) run() {
+	t := noTask
+	for !e.scheduler.done.Load() {
+		switch t.kind {
+		case taskExecution:
+			t = e.tryExecute(t.version)
+		case taskValidation:
+			t = e.validate(t.version)
+		default:
+			t = e.scheduler.nextTask()
+		}
+	}
+}
+
+// tryExecute executes an incarnation, it is suspended if it reads a value
+// estimated to be written by a lower transaction.
+func (e *executor) tryExecute(v version) task {
+	for {
+		ms := newMultiStore(e.base, e.mv, v)
+		r := e.executeOn(v.txIndex, ms)
+
+		if ms.blockingTxIndex >= 0 {
+			if e.scheduler.addDependency(v.txIndex, ms.blockingTxIndex) {
+				return noTask
+			}
+			// the blocking transaction was executed in the meantime
+			continue
+		}
+
+		wroteNewLocation := e.mv.record(v, ms.reads, ms.writes)
+		e.writeSets[v.txIndex] = ms.writes
+		e.panics[v.txIndex] = r
+		return e.scheduler.finishExecution(v, wroteNewLocation)
+	}
+}
+
+// executeOn executes a transaction, recovering from the abort of its
+// incarnation. It returns the value the execution panicked with, if any.
+func (e *executor) executeOn(txIndex int, ms *multiStore) (panicked any) {
+	defer func() {
+		if r := recover(); r != nil {
+			if _, ok := r.(abortError); !ok {
+				panicked = r
+			}
+		}
+	}()
+	e.execute(txIndex, ms)
+	return nil
+}
+
+// validate validates the reads of an incarnation, it is aborted and executed
+// again if they are not valid anymore.
+func (e *executor) validate(v version) task {
+	valid := e.mv.validateReadSet(v.txIndex)
+	aborted := !valid && e.scheduler.tryValidationAbort(v)
+	if aborted {
+		e.mv.convertWritesToEstimates(v.txIndex)
+	}
+	return e.scheduler.finishValidation(v.txIndex, aborted)
+}
@@ -0,0 +1,203 @@
+package blockstm
+
+import (
+	"encoding/binary"
+	"fmt"
+	"testing"
+
+	"cosmossdk.io/log"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/store/metrics"
+	"cosmossdk.io/store/rootmulti"
+	"cosmossdk.io/store/types"
+)
+
+const numAccounts = 8
+
+var (
+	keyBank  = types.NewKVStoreKey("bank")
+	keyStats = types.NewKVStoreKey("stats")
+	keyObj   = types.NewObjectStoreKey("obj")
+)
+
+func accountKey(i int) []byte {
+	return []byte(fmt.Sprintf("acc/%02d", i%numAccounts))
+}
+
+func encode(n uint64) []byte {
+	return binary.BigEndian.AppendUint64(nil, n)
+}
+
+func decode(bz []byte) uint64 {
+	if bz == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+// newBase returns a committed multistore holding the initial balances.
+func newBase(t *testing.T) *rootmulti.Store {
+	t.Helper()
+
+	db := dbm.NewMemDB()
+	store := rootmulti.NewStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics())
+	store.MountStoreWithDB(keyBank, types.StoreTypeIAVL, nil)
+	store.MountStoreWithDB(keyStats, types.StoreTypeIAVL, nil)
+	store.MountStoreWithDB(keyObj, types.StoreTypeObject, nil)
+	require.NoError(t, store.LoadLatestVersion())
+
+	bank := store.GetKVStore(keyBank)
+	for i := 0; i < numAccounts; i++ {
+		bank.Set(accountKey(i), encode(1000))
+	}
+	store.Commit()
+	return store
+}
+
+// executeTx is a transaction of the workload: it transfers between accounts,
+// increments a shared counter, deletes accounts and records the total of the
+// balances, which conflicts with most of the other transactions.
+func executeTx(txIndex int, ms types.MultiStore) {
+	// the transactions are executed on a branch, as in the baseapp
+	msCache := ms.CacheMultiStore()
+	bank := msCache.GetKVStore(keyBank)
+	stats := msCache.GetKVStore(keyStats)
+
+	from, to := accountKey(txIndex), accountKey(txIndex*3+1)
+	if balance := decode(bank.Get(from)); balance >= 10 {
+		bank.Set(from, encode(balance-10))
+		bank.Set(to, encode(decode(bank.Get(to))+10))
+	}
+
+	stats.Set([]byte("count"), encode(decode(stats.Get([]byte("count")))+1))
+
+	if txIndex%7 == 3 {
+		bank.Delete(accountKey(txIndex + 2))
+	}
+
+	if txIndex%4 == 0 {
+		var total uint64
+		it := bank.Iterator(nil, nil)
+		for ; it.Valid(); it.Next() {
+			total += decode(it.Value())
+		}
+		it.Close()
+
+		rit := bank.ReverseIterator(accountKey(1), accountKey(5))
+		if rit.Valid() {
+			stats.Set([]byte(fmt.Sprintf("last/%03d", txIndex)), rit.Key())
+		}
+		rit.Close()
+
+		stats.Set([]byte(fmt.Sprintf("total/%03d", txIndex)), encode(total))
+	}
+
+	msCache.Write()
+}
+
+// dump returns the pairs of a store.
+func dump(t *testing.T, store types.KVStore) [][2]string {
+	t.Helper()
+
+	var pairs [][2]string
+	it := store.Iterator(nil, nil)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		pairs = append(pairs, [2]string{string(it.Key()), string(it.Value())})
+	}
+	return pairs
+}
+
+func executeSerially(blockSize int, ms types.CacheMultiStore, execute ExecuteFn) {
+	for i := 0; i < blockSize; i++ {
+		execute(i, ms)
+	}
+	ms.Write()
+}
+
+func executeConcurrently(blockSize int, ms types.CacheMultiStore, workers int, execute ExecuteFn) {
+	for _, ws := range ExecuteBlock(blockSize, ms, workers, execute) {
+		ws.Write(ms)
+	}
+	ms.Write()
+}
+
+func TestExecuteBlock(t *testing.T) {
+	for _, blockSize := range []int{0, 1, 10, 100} {
+		for _, workers := range []int{1, 2, 4, 16} {
+			t.Run(fmt.Sprintf("block size %d, %d workers", blockSize, workers), func(t *testing.T) {
+				serial, concurrent := newBase(t), newBase(t)
+
+				executeSerially(blockSize, serial.CacheMultiStore(), executeTx)
+				executeConcurrently(blockSize, concurrent.CacheMultiStore(), workers, executeTx)
+
+				for _, key := range []types.StoreKey{keyBank, keyStats} {
+					require.Equal(t, dump(t, serial.GetKVStore(key)), dump(t, concurrent.GetKVStore(key)))
+				}
+				require.Equal(t, serial.Commit(), concurrent.Commit())
+			})
+		}
+	}
+}
+
+func TestExecuteBlockSequential(t *testing.T) {
+	base := newBase(t)
+	writeSets := ExecuteBlock(4, base.CacheMultiStore(), 2, func(txIndex int, ms types.MultiStore) {
+		if txIndex == 2 {
+			ms.GetObjKVStore(keyObj).Set([]byte("key"), txIndex)
+		}
+		executeTx(txIndex, ms)
+	})
+
+	for i, ws := range writeSets {
+		require.Equal(t, i == 2, ws.Sequential())
+	}
+}
+
+func TestExecuteBlockPanic(t *testing.T) {
+	base := newBase(t)
+	require.PanicsWithValue(t, "failure", func() {
+		ExecuteBlock(1, base.CacheMultiStore(), 1, func(int, types.MultiStore) {
+			panic("failure")
+		})
+	})
+}
+
+func TestMergeIterator(t *testing.T) {
+	base := newBase(t)
+	bank := base.GetKVStore(keyBank)
+
+	entries := []keyEntry{
+		{key: accountKey(1), entry: entry{value: encode(1)}},
+		{key: accountKey(2)},
+		{key: []byte("acc/035"), entry: entry{value: encode(10)}},
+	}
+	it := newMergeIterator(bank, accountKey(0), accountKey(4), entries, true)
+	defer it.Close()
+
+	var pairs [][2][]byte
+	for ; it.Valid(); it.Next() {
+		pairs = append(pairs, [2][]byte{it.Key(), it.Value()})
+	}
+	require.Equal(t, [][2][]byte{
+		{accountKey(0), encode(1000)},
+		{accountKey(1), encode(1)},
+		{accountKey(3), encode(1000)},
+		{[]byte("acc/035"), encode(10)},
+	}, pairs)
+
+	reversed := make([]keyEntry, len(entries))
+	for i, e := range entries {
+		reversed[len(entries)-1-i] = e
+	}
+	rit := newMergeIterator(bank, accountKey(0), accountKey(4), reversed, false)
+	defer rit.Close()
+
+	var keys []string
+	for ; rit.Valid(); rit.Next() {
+		keys = append(keys, string(rit.Key()))
+	}
+	require.Equal(t, []string{"acc/035", "acc/03", "acc/01", "acc/00"}, keys)
+}
@@ -0,0 +1,157 @@
+package blockstm
+
+import (
+	"bytes"
+
+	"cosmossdk.io/store/types"
+)
+
+var _ types.Iterator = (*mergeIterator)(nil)
+
+// mergeIterator iterates over the pairs of a domain of a store as seen by a
+// transaction: the entries written by the lower transactions override the
+// pairs of the multistore the block is executed on.
+type mergeIterator struct {
+	base       types.Iterator
+	entries    []keyEntry
+	start, end []byte
+	ascending  bool
+
+	// the next entry
+	pos int
+	// the current pair comes from the base and/or the entries
+	fromBase, fromEntry bool
+}
+
+func newMergeIterator(store types.KVStore, start, end []byte, entries []keyEntry, ascending bool) *mergeIterator {
+	var base types.Iterator
+	if ascending {
+		base = store.Iterator(start, end)
+	} else {
+		base = store.ReverseIterator(start, end)
+	}
+
+	it := &mergeIterator{base: base, entries: entries, start: start, end: end, ascending: ascending}
+	it.seek()
+	return it
+}
+
+// seek moves to the next pair which is not deleted.
+func (it *mergeIterator) seek() {
+	for {
+		it.fromBase, it.fromEntry = false, false
+		baseValid, entryValid := it.base.Valid(), it.pos < len(it.entries)
+
+		switch {
+		case !baseValid && !entryValid:
+			return
+		case !entryValid:
+			it.fromBase = true
+		case !baseValid:
+			it.fromEntry = true
+		default:
+			cmp := bytes.Compare(it.base.Key(), it.entries[it.pos].key)
+			if !it.ascending {
+				cmp = -cmp
+			}
+			it.fromBase, it.fromEntry = cmp <= 0, cmp >= 0
+		}
+
+		if !it.fromEntry || it.entries[it.pos].value != nil {
+			return
+		}
+		// skip deleted keys
+		it.advance()
+	}
+}
+
+func (it *mergeIterator) advance() {
+	if it.fromBase {
+		it.base.Next()
+	}
+	if it.fromEntry {
+		it.pos++
+	}
+}
+
+// Domain implements types.Iterator.
+func (it *mergeIterator) Domain() (start, end []byte) {
+	return it.start, it.end
+}
+
+// Valid implements types.Iterator.
+func (it *mergeIterator) Valid() bool {
+	return it.fromBase || it.fromEntry
+}
+
+// Next implements types.Iterator.
+func (it *mergeIterator) Next() {
+	if !it.Valid() {
+		panic("iterator is invalid")
+	}
+	it.advance()
+	it.seek()
+}
+
+// Key implements types.Iterator.
+func (it *mergeIterator) Key() []byte {
+	switch {
+	case it.fromEntry:
+		return it.entries[it.pos].key
+	case it.fromBase:
+		return it.base.Key()
+	default:
+		panic("iterator is invalid")
+	}
+}
+
+// Value implements types.Iterator.
+func (it *mergeIterator) Value() []byte {
+	switch {
+	case it.fromEntry:
+		return it.entries[it.pos].value
+	case it.fromBase:
+		return it.base.Value()
+	default:
+		panic("iterator is invalid")
+	}
+}
+
+// Error implements types.Iterator.
+func (it *mergeIterator) Error() error {
+	return it.base.Error()
+}
+
+// Close implements types.Iterator.
+func (it *mergeIterator) Close() error {
+	return it.base.Close()
+}
+
+// observedIterator records the pairs an iterator goes through in the read set.
+type observedIterator struct {
+	types.Iterator
+	read *iteratorRead
+}
+
+func newObservedIterator(it types.Iterator, read *iteratorRead) *observedIterator {
+	oi := &observedIterator{Iterator: it, read: read}
+	oi.observe()
+	return oi
+}
+
+func (it *observedIterator) observe() {
+	if !it.Iterator.Valid() {
+		it.read.exhausted = true
+		return
+	}
+	it.read.pairs = append(it.read.pairs, [2][]byte{
+		bytes.Clone(it.Iterator.Key()),
+		bytes.Clone(it.Iterator.Value()),
+	})
+}
+
+// Next implements types.Iterator.
+func (it *observedIterator) Next() {
+	it.Iterator.Next()
+	it.observe()
+}
@@ -0,0 +1,273 @@
+package blockstm
+
+import (
+	"sort"
+	"sync"
+
+	"cosmossdk.io/store/internal/btree"
+	"cosmossdk.io/store/types"
+)
+
+// version identifies an incarnation of a transaction. The values read from
+// the multistore the block is executed on have the base version.
+type version struct {
+	txIndex     int
+	incarnation int
+}
+
+var baseVersion = version{txIndex: -1}
+
+// entry is the value of a key written by an incarnation of a transaction.
+type entry struct {
+	version
+	// value is nil if the key was deleted.
+	value []byte
+	// estimate marks the values written by an aborted incarnation, which are
+	// likely to be written again by the next one.
+	estimate bool
+}
+
+// keyEntry is an entry along with its key.
+type keyEntry struct {
+	key []byte
+	entry
+}
+
+// mvKey holds the entries of a key, in ascending order of transactions.
+type mvKey struct {
+	entries []entry
+}
+
+// search returns the index of the first entry of a transaction not lower than txIndex.
+func (k *mvKey) search(txIndex int) int {
+	return sort.Search(len(k.entries), func(i int) bool { return k.entries[i].txIndex >= txIndex })
+}
+
+// read returns the entry written by the highest transaction lower than txIndex.
+func (k *mvKey) read(txIndex int) (entry, bool) {
+	i := k.search(txIndex)
+	if i == 0 {
+		return entry{}, false
+	}
+	return k.entries[i-1], true
+}
+
+func (k *mvKey) write(e entry) {
+	i := k.search(e.txIndex)
+	if i < len(k.entries) && k.entries[i].txIndex == e.txIndex {
+		k.entries[i] = e
+		return
+	}
+	k.entries = append(k.entries, entry{})
+	copy(k.entries[i+1:], k.entries[i:])
+	k.entries[i] = e
+}
+
+func (k *mvKey) remove(txIndex int) {
+	i := k.search(txIndex)
+	if i < len(k.entries) && k.entries[i].txIndex == txIndex {
+		k.entries = append(k.entries[:i], k.entries[i+1:]...)
+	}
+}
+
+func (k *mvKey) markEstimate(txIndex int) {
+	i := k.search(txIndex)
+	if i < len(k.entries) && k.entries[i].txIndex == txIndex {
+		k.entries[i].estimate = true
+	}
+}
+
+// mvStore is the multi-version memory of a store, it holds the values written
+// by the transactions of the block to the keys of the store.
+type mvStore struct {
+	mtx  sync.RWMutex
+	keys btree.BTree[*mvKey]
+}
+
+func newMVStore() *mvStore {
+	return &mvStore{keys: btree.NewBTree[*mvKey]()}
+}
+
+// read returns the entry of the key visible to the transaction, written by
+// the highest lower transaction.
+func (s *mvStore) read(key []byte, txIndex int) (entry, bool) {
+	s.mtx.RLock()
+	defer s.mtx.RUnlock()
+
+	k := s.keys.Get(key)
+	if k == nil {
+		return entry{}, false
+	}
+	return k.read(txIndex)
+}
+
+func (s *mvStore) write(key []byte, e entry) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	k := s.keys.Get(key)
+	if k == nil {
+		k = &mvKey{}
+		s.keys.Set(key, k)
+	}
+	k.write(e)
+}
+
+func (s *mvStore) remove(key []byte, txIndex int) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	k := s.keys.Get(key)
+	if k == nil {
+		return
+	}
+	k.remove(txIndex)
+	if len(k.entries) == 0 {
+		s.keys.Delete(key)
+	}
+}
+
+func (s *mvStore) markEstimate(key []byte, txIndex int) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	if k := s.keys.Get(key); k != nil {
+		k.markEstimate(txIndex)
+	}
+}
+
+// snapshot returns the entries of the keys of the domain visible to the
+// transaction, in the order of iteration.
+func (s *mvStore) snapshot(start, end []byte, txIndex int, ascending bool) []keyEntry {
+	s.mtx.RLock()
+	defer s.mtx.RUnlock()
+
+	var (
+		it  types.GIterator[*mvKey]
+		err error
+	)
+	if ascending {
+		it, err = s.keys.Iterator(start, end)
+	} else {
+		it, err = s.keys.ReverseIterator(start, end)
+	}
+	if err != nil {
+		panic(err)
+	}
+	defer it.Close()
+
+	var entries []keyEntry
+	for ; it.Valid(); it.Next() {
+		if e, ok := it.Value().read(txIndex); ok {
+			entries = append(entries, keyEntry{key: it.Key(), entry: e})
+		}
+	}
+	return entries
+}
+
+// location is a key of a store.
+type location struct {
+	store *mvStore
+	key   string
+}
+
+// txMemory holds the read set and the locations written by the latest
+// incarnation of a transaction.
+type txMemory struct {
+	mtx     sync.Mutex
+	reads   *readSet
+	written []location
+}
+
+// mvMemory is the multi-version memory of the stores of a block, through which
+// the transactions read the values written by the lower transactions.
+type mvMemory struct {
+	mtx    sync.Mutex
+	stores map[types.StoreKey]*mvStore
+
+	txs []txMemory
+}
+
+func newMVMemory(blockSize int) *mvMemory {
+	return &mvMemory{
+		stores: make(map[types.StoreKey]*mvStore),
+		txs:    make([]txMemory, blockSize),
+	}
+}
+
+// store returns the multi-version memory of a store.
+func (mv *mvMemory) store(key types.StoreKey) *mvStore {
+	mv.mtx.Lock()
+	defer mv.mtx.Unlock()
+
+	s, ok := mv.stores[key]
+	if !ok {
+		s = newMVStore()
+		mv.stores[key] = s
+	}
+	return s
+}
+
+// record records the read set and the writes of an incarnation, removing the
+// values written by the previous incarnation to the keys it did not write. It
+// returns whether the incarnation wrote to a key the previous one did not.
+func (mv *mvMemory) record(v version, reads *readSet, writes *WriteSet) bool {
+	values := make(map[location][]byte)
+	var written []location
+	for _, w := range writes.writes {
+		loc := location{store: mv.store(w.storeKey), key: string(w.key)}
+		if _, ok := values[loc]; !ok {
+			written = append(written, loc)
+		}
+		values[loc] = w.value
+	}
+
+	tx := &mv.txs[v.txIndex]
+	tx.mtx.Lock()
+	defer tx.mtx.Unlock()
+
+	for _, loc := range written {
+		loc.store.write([]byte(loc.key), entry{version: v, value: values[loc]})
+	}
+
+	wroteNew := false
+	previous := make(map[location]bool, len(tx.written))
+	for _, loc := range tx.written {
+		previous[loc] = true
+		if _, ok := values[loc]; !ok {
+			loc.store.remove([]byte(loc.key), v.txIndex)
+		}
+	}
+	for _, loc := range written {
+		if !previous[loc] {
+			wroteNew = true
+			break
+		}
+	}
+
+	tx.reads, tx.written = reads, written
+	return wroteNew
+}
+
+// convertWritesToEstimates marks the values written by the latest incarnation
+// of an aborted transaction as estimates.
+func (mv *mvMemory) convertWritesToEstimates(txIndex int) {
+	tx := &mv.txs[txIndex]
+	tx.mtx.Lock()
+	defer tx.mtx.Unlock()
+
+	for _, loc := range tx.written {
+		loc.store.markEstimate([]byte(loc.key), txIndex)
+	}
+}
+
+// validateReadSet returns whether the values read by the latest incarnation of
+// a transaction are still the ones it would read.
+func (mv *mvMemory) validateReadSet(txIndex int) bool {
+	tx := &mv.txs[txIndex]
+	tx.mtx.Lock()
+	reads := tx.reads
+	tx.mtx.Unlock()
+
+	return reads.validate(txIndex)
+}
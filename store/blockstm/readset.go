@@ -0,0 +1,78 @@
+package blockstm
+
+import (
+	"bytes"
+
+	"cosmossdk.io/store/types"
+)
+
+// readSet holds the values read by an incarnation of a transaction.
+type readSet struct {
+	reads     []read
+	iterators []*iteratorRead
+}
+
+// read is a value read from a key, along with its version.
+type read struct {
+	store   *mvStore
+	key     []byte
+	version version
+}
+
+// iteratorRead is an iteration over a domain of a store, holding the pairs it
+// went through.
+type iteratorRead struct {
+	store      *mvStore
+	base       types.KVStore
+	start, end []byte
+	ascending  bool
+
+	pairs [][2][]byte
+	// exhausted is set if the iterator was seen invalid.
+	exhausted bool
+}
+
+// validate returns whether the values read by a transaction are still the
+// ones it would read.
+func (rs *readSet) validate(txIndex int) bool {
+	for _, r := range rs.reads {
+		e, ok := r.store.read(r.key, txIndex)
+		if !ok {
+			if r.version != baseVersion {
+				return false
+			}
+			continue
+		}
+		if e.estimate || e.version != r.version {
+			return false
+		}
+	}
+
+	for _, ir := range rs.iterators {
+		if !ir.validate(txIndex) {
+			return false
+		}
+	}
+	return true
+}
+
+// validate iterates again over the domain and returns whether it goes through
+// the same pairs.
+func (ir *iteratorRead) validate(txIndex int) bool {
+	entries := ir.store.snapshot(ir.start, ir.end, txIndex, ir.ascending)
+	for _, e := range entries {
+		if e.estimate {
+			return false
+		}
+	}
+
+	it := newMergeIterator(ir.base, ir.start, ir.end, entries, ir.ascending)
+	defer it.Close()
+	for _, pair := range ir.pairs {
+		if !it.Valid() || !bytes.Equal(it.Key(), pair[0]) || !bytes.Equal(it.Value(), pair[1]) {
+			return false
+		}
+		it.Next()
+	}
+	return !ir.exhausted || !it.Valid()
+}
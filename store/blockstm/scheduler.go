@@ -0,0 +1,261 @@
+package blockstm
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+type status int
+
+const (
+	statusReadyToExecute status = iota
+	statusExecuting
+	statusExecuted
+	statusAborting
+)
+
+type taskKind int
+
+const (
+	taskNone taskKind = iota
+	taskExecution
+	taskValidation
+)
+
+// task is an execution or a validation of an incarnation.
+type task struct {
+	kind    taskKind
+	version version
+}
+
+var noTask = task{}
+
+// txStatus is the status of the latest incarnation of a transaction.
+type txStatus struct {
+	mtx         sync.Mutex
+	incarnation int
+	status      status
+}
+
+// txDependencies holds the transactions waiting for the execution of a
+// transaction.
+type txDependencies struct {
+	mtx        sync.Mutex
+	dependents []int
+}
+
+// scheduler dispatches the executions and validations of the incarnations of
+// the transactions of a block to the workers, following the collaborative
+// scheduler of Block-STM: the lowest transactions are executed and validated
+// first, and the transactions following an aborted one are validated again.
+type scheduler struct {
+	blockSize int
+
+	executionIdx   atomic.Int64
+	validationIdx  atomic.Int64
+	decreaseCnt    atomic.Int64
+	numActiveTasks atomic.Int64
+	done           atomic.Bool
+
+	statuses     []txStatus
+	dependencies []txDependencies
+}
+
+func newScheduler(blockSize int) *scheduler {
+	return &scheduler{
+		blockSize:    blockSize,
+		statuses:     make([]txStatus, blockSize),
+		dependencies: make([]txDependencies, blockSize),
+	}
+}
+
+// fetchMin sets the value to target if it is lower.
+func fetchMin(value *atomic.Int64, target int64) {
+	for {
+		current := value.Load()
+		if current <= target || value.CompareAndSwap(current, target) {
+			return
+		}
+	}
+}
+
+func (s *scheduler) decreaseExecutionIdx(target int) {
+	fetchMin(&s.executionIdx, int64(target))
+	s.decreaseCnt.Add(1)
+}
+
+func (s *scheduler) decreaseValidationIdx(target int) {
+	fetchMin(&s.validationIdx, int64(target))
+	s.decreaseCnt.Add(1)
+}
+
+// checkDone marks the execution of the block done once all the transactions
+// are executed and validated and no task is in progress.
+func (s *scheduler) checkDone() {
+	observedCnt := s.decreaseCnt.Load()
+	if s.executionIdx.Load() >= int64(s.blockSize) && s.validationIdx.Load() >= int64(s.blockSize) &&
+		s.numActiveTasks.Load() == 0 && observedCnt == s.decreaseCnt.Load() {
+		s.done.Store(true)
+	}
+}
+
+// tryIncarnate starts the execution of the next incarnation of a transaction,
+// if it is ready to be executed.
+func (s *scheduler) tryIncarnate(txIndex int) task {
+	if txIndex < s.blockSize {
+		st := &s.statuses[txIndex]
+		st.mtx.Lock()
+		if st.status == statusReadyToExecute {
+			st.status = statusExecuting
+			t := task{kind: taskExecution, version: version{txIndex: txIndex, incarnation: st.incarnation}}
+			st.mtx.Unlock()
+			return t
+		}
+		st.mtx.Unlock()
+	}
+	s.numActiveTasks.Add(-1)
+	return noTask
+}
+
+func (s *scheduler) nextVersionToExecute() task {
+	if s.executionIdx.Load() >= int64(s.blockSize) {
+		s.checkDone()
+		return noTask
+	}
+	s.numActiveTasks.Add(1)
+	return s.tryIncarnate(int(s.executionIdx.Add(1) - 1))
+}
+
+func (s *scheduler) nextVersionToValidate() task {
+	if s.validationIdx.Load() >= int64(s.blockSize) {
+		s.checkDone()
+		return noTask
+	}
+	s.numActiveTasks.Add(1)
+	txIndex := int(s.validationIdx.Add(1) - 1)
+	if txIndex < s.blockSize {
+		st := &s.statuses[txIndex]
+		st.mtx.Lock()
+		if st.status == statusExecuted {
+			t := task{kind: taskValidation, version: version{txIndex: txIndex, incarnation: st.incarnation}}
+			st.mtx.Unlock()
+			return t
+		}
+		st.mtx.Unlock()
+	}
+	s.numActiveTasks.Add(-1)
+	return noTask
+}
+
+// nextTask returns the next task, validations first.
+func (s *scheduler) nextTask() task {
+	if s.validationIdx.Load() < s.executionIdx.Load() {
+		return s.nextVersionToValidate()
+	}
+	return s.nextVersionToExecute()
+}
+
+// addDependency suspends a transaction until the blocking transaction is
+// executed, it returns false if it already was.
+func (s *scheduler) addDependency(txIndex, blockingTxIndex int) bool {
+	deps := &s.dependencies[blockingTxIndex]
+	deps.mtx.Lock()
+
+	blocking := &s.statuses[blockingTxIndex]
+	blocking.mtx.Lock()
+	executed := blocking.status == statusExecuted
+	blocking.mtx.Unlock()
+	if executed {
+		deps.mtx.Unlock()
+		return false
+	}
+
+	st := &s.statuses[txIndex]
+	st.mtx.Lock()
+	st.status = statusAborting
+	st.mtx.Unlock()
+
+	deps.dependents = append(deps.dependents, txIndex)
+	deps.mtx.Unlock()
+
+	s.numActiveTasks.Add(-1)
+	return true
+}
+
+// setReady makes the next incarnation of an aborted transaction ready to be
+// executed.
+func (s *scheduler) setReady(txIndex int) {
+	st := &s.statuses[txIndex]
+	st.mtx.Lock()
+	st.incarnation++
+	st.status = statusReadyToExecute
+	st.mtx.Unlock()
+}
+
+func (s *scheduler) resumeDependencies(dependents []int) {
+	if len(dependents) == 0 {
+		return
+	}
+	minIndex := dependents[0]
+	for _, txIndex := range dependents {
+		s.setReady(txIndex)
+		if txIndex < minIndex {
+			minIndex = txIndex
+		}
+	}
+	s.decreaseExecutionIdx(minIndex)
+}
+
+// finishExecution marks an incarnation executed, it returns its validation if
+// it can be done right away.
+func (s *scheduler) finishExecution(v version, wroteNewLocation bool) task {
+	st := &s.statuses[v.txIndex]
+	st.mtx.Lock()
+	st.status = statusExecuted
+	st.mtx.Unlock()
+
+	deps := &s.dependencies[v.txIndex]
+	deps.mtx.Lock()
+	dependents := deps.dependents
+	deps.dependents = nil
+	deps.mtx.Unlock()
+	s.resumeDependencies(dependents)
+
+	if s.validationIdx.Load() > int64(v.txIndex) {
+		if !wroteNewLocation {
+			return task{kind: taskValidation, version: v}
+		}
+		// the higher transactions may have read the keys it did not write before
+		s.decreaseValidationIdx(v.txIndex)
+	}
+	s.numActiveTasks.Add(-1)
+	return noTask
+}
+
+// tryValidationAbort aborts an incarnation which failed its validation, it
+// returns false if it was already aborted.
+func (s *scheduler) tryValidationAbort(v version) bool {
+	st := &s.statuses[v.txIndex]
+	st.mtx.Lock()
+	defer st.mtx.Unlock()
+
+	if st.incarnation == v.incarnation && st.status == statusExecuted {
+		st.status = statusAborting
+		return true
+	}
+	return false
+}
+
+// finishValidation returns the execution of the next incarnation of an aborted
+// transaction, if it can be done right away.
+func (s *scheduler) finishValidation(txIndex int, aborted bool) task {
+	if aborted {
+		s.setReady(txIndex)
+		s.decreaseValidationIdx(txIndex + 1)
+		if s.executionIdx.Load() > int64(txIndex) {
+			return s.tryIncarnate(txIndex)
+		}
+	}
+	s.numActiveTasks.Add(-1)
+	return noTask
+}
@@ -0,0 +1,404 @@
+package blockstm
+
+import (
+	"bytes"
+	"errors"
+	"io"
+
+	"cosmossdk.io/store/cachekv"
+	"cosmossdk.io/store/tracekv"
+	"cosmossdk.io/store/types"
+)
+
+// write is a write to a key of a store, value is nil if the key is deleted.
+type write struct {
+	storeKey types.StoreKey
+	key      []byte
+	value    []byte
+}
+
+// WriteSet is the log of the writes of the latest incarnation of a
+// transaction, in order.
+type WriteSet struct {
+	writes []write
+	// sequential is set if the transaction accessed stores which are not
+	// versioned.
+	sequential bool
+}
+
+// Write applies the writes to the multistore, in the order they were made.
+func (ws *WriteSet) Write(ms types.MultiStore) {
+	for _, w := range ws.writes {
+		store := ms.GetKVStore(w.storeKey)
+		if w.value == nil {
+			store.Delete(w.key)
+		} else {
+			store.Set(w.key, w.value)
+		}
+	}
+}
+
+// Sequential returns whether the transaction accessed stores which are not
+// versioned, such as the object stores, whose values may be shared between
+// transactions. Such a transaction must be executed again sequentially, as well
+// as the ones following it.
+func (ws *WriteSet) Sequential() bool {
+	return ws.sequential
+}
+
+// abortError is the panic raised when a transaction reads a value estimated to
+// be written by a lower transaction, its incarnation is then aborted until the
+// lower transaction is executed again.
+type abortError struct {
+	blockingTxIndex int
+}
+
+func (abortError) Error() string {
+	return "transaction execution aborted on a dependency"
+}
+
+var _ types.MultiStore = (*multiStore)(nil)
+
+// multiStore is the multistore an incarnation of a transaction is executed on.
+// Its reads go through the multi-version memory to the multistore the block is
+// executed on, and are recorded in the read set of the incarnation along with
+// its writes.
+type multiStore struct {
+	base    types.MultiStore
+	mv      *mvMemory
+	version version
+
+	reads     *readSet
+	writes    *WriteSet
+	stores    map[types.StoreKey]*kvStore
+	objStores map[types.StoreKey]types.ObjKVStore
+
+	// blockingTxIndex is the index of the transaction the incarnation was
+	// aborted on, -1 if it was not.
+	blockingTxIndex int
+}
+
+func newMultiStore(base types.MultiStore, mv *mvMemory, v version) *multiStore {
+	return &multiStore{
+		base:            base,
+		mv:              mv,
+		version:         v,
+		reads:           &readSet{},
+		writes:          &WriteSet{},
+		stores:          make(map[types.StoreKey]*kvStore),
+		objStores:       make(map[types.StoreKey]types.ObjKVStore),
+		blockingTxIndex: -1,
+	}
+}
+
+// abort aborts the incarnation on a dependency.
+func (ms *multiStore) abort(blockingTxIndex int) {
+	if ms.blockingTxIndex < 0 {
+		ms.blockingTxIndex = blockingTxIndex
+	}
+	panic(abortError{blockingTxIndex: ms.blockingTxIndex})
+}
+
+// checkAborted panics again on every read of an aborted incarnation, in case
+// the panic was recovered during the execution of the transaction.
+func (ms *multiStore) checkAborted() {
+	if ms.blockingTxIndex >= 0 {
+		ms.abort(ms.blockingTxIndex)
+	}
+}
+
+// GetStoreType implements types.Store.
+func (ms *multiStore) GetStoreType() types.StoreType {
+	return ms.base.GetStoreType()
+}
+
+// CacheWrap implements types.CacheWrapper.
+func (ms *multiStore) CacheWrap() types.CacheWrap {
+	return ms.CacheMultiStore()
+}
+
+// CacheWrapWithTrace implements types.CacheWrapper, the writes are not traced.
+func (ms *multiStore) CacheWrapWithTrace(_ io.Writer, _ types.TraceContext) types.CacheWrap {
+	return ms.CacheWrap()
+}
+
+// CacheMultiStore implements types.MultiStore.
+func (ms *multiStore) CacheMultiStore() types.CacheMultiStore {
+	return newCacheMultiStore(ms)
+}
+
+// CacheMultiStoreWithVersion implements types.MultiStore.
+func (ms *multiStore) CacheMultiStoreWithVersion(_ int64) (types.CacheMultiStore, error) {
+	return nil, errors.New("cannot branch a versioned multistore at a version")
+}
+
+// GetStore implements types.MultiStore.
+func (ms *multiStore) GetStore(key types.StoreKey) types.Store {
+	return ms.GetKVStore(key)
+}
+
+// GetKVStore implements types.MultiStore.
+func (ms *multiStore) GetKVStore(key types.StoreKey) types.KVStore {
+	store, ok := ms.stores[key]
+	if !ok {
+		store = &kvStore{
+			Store: cachekv.NewStore(&readStore{
+				ms:   ms,
+				mv:   ms.mv.store(key),
+				base: ms.base.GetKVStore(key),
+			}),
+			storeKey: key,
+			writes:   ms.writes,
+		}
+		ms.stores[key] = store
+	}
+	return store
+}
+
+// GetObjKVStore implements types.MultiStore. The object stores are not
+// versioned, the transaction is marked to be executed again sequentially and
+// its writes are discarded.
+func (ms *multiStore) GetObjKVStore(key types.StoreKey) types.ObjKVStore {
+	ms.writes.sequential = true
+	store, ok := ms.objStores[key]
+	if !ok {
+		store = ms.base.GetObjKVStore(key).CacheWrap().(types.ObjKVStore)
+		ms.objStores[key] = store
+	}
+	return store
+}
+
+// TracingEnabled implements types.MultiStore.
+func (ms *multiStore) TracingEnabled() bool {
+	return false
+}
+
+// SetTracer implements types.MultiStore, the writes are not traced.
+func (ms *multiStore) SetTracer(_ io.Writer) types.MultiStore {
+	return ms
+}
+
+// SetTracingContext implements types.MultiStore, the writes are not traced.
+func (ms *multiStore) SetTracingContext(_ types.TraceContext) types.MultiStore {
+	return ms
+}
+
+// LatestVersion implements types.MultiStore.
+func (ms *multiStore) LatestVersion() int64 {
+	return ms.base.LatestVersion()
+}
+
+var _ types.KVStore = (*kvStore)(nil)
+
+// kvStore is a store of the multistore of an incarnation, it holds the writes
+// of the incarnation and logs them in its write set.
+type kvStore struct {
+	*cachekv.Store
+	storeKey types.StoreKey
+	writes   *WriteSet
+}
+
+// Set implements types.KVStore.
+func (s *kvStore) Set(key, value []byte) {
+	s.Store.Set(key, value)
+	s.writes.writes = append(s.writes.writes, write{storeKey: s.storeKey, key: bytes.Clone(key), value: bytes.Clone(value)})
+}
+
+// Delete implements types.KVStore.
+func (s *kvStore) Delete(key []byte) {
+	s.Store.Delete(key)
+	s.writes.writes = append(s.writes.writes, write{storeKey: s.storeKey, key: bytes.Clone(key)})
+}
+
+// Write panics, the writes of an incarnation are applied from its write set.
+func (s *kvStore) Write() {
+	panic("cannot write a versioned store")
+}
+
+// CacheWrap implements types.CacheWrapper.
+func (s *kvStore) CacheWrap() types.CacheWrap {
+	return cachekv.NewStore(s)
+}
+
+// CacheWrapWithTrace implements types.CacheWrapper.
+func (s *kvStore) CacheWrapWithTrace(w io.Writer, tc types.TraceContext) types.CacheWrap {
+	return cachekv.NewStore(tracekv.NewStore(s, w, tc))
+}
+
+var _ types.KVStore = (*readStore)(nil)
+
+// readStore reads the values of a store visible to an incarnation and records
+// them in its read set.
+type readStore struct {
+	ms   *multiStore
+	mv   *mvStore
+	base types.KVStore
+}
+
+// GetStoreType implements types.Store.
+func (s *readStore) GetStoreType() types.StoreType {
+	return s.base.GetStoreType()
+}
+
+// CacheWrap implements types.CacheWrapper.
+func (s *readStore) CacheWrap() types.CacheWrap {
+	return cachekv.NewStore(s)
+}
+
+// CacheWrapWithTrace implements types.CacheWrapper.
+func (s *readStore) CacheWrapWithTrace(w io.Writer, tc types.TraceContext) types.CacheWrap {
+	return cachekv.NewStore(tracekv.NewStore(s, w, tc))
+}
+
+// Get implements types.KVStore.
+func (s *readStore) Get(key []byte) []byte {
+	s.ms.checkAborted()
+
+	key = bytes.Clone(key)
+	e, ok := s.mv.read(key, s.ms.version.txIndex)
+	if !ok {
+		s.ms.reads.reads = append(s.ms.reads.reads, read{store: s.mv, key: key, version: baseVersion})
+		return s.base.Get(key)
+	}
+	if e.estimate {
+		s.ms.abort(e.txIndex)
+	}
+	s.ms.reads.reads = append(s.ms.reads.reads, read{store: s.mv, key: key, version: e.version})
+	return e.value
+}
+
+// Has implements types.KVStore.
+func (s *readStore) Has(key []byte) bool {
+	return s.Get(key) != nil
+}
+
+// Set implements types.KVStore, the writes are held by the cache above.
+func (s *readStore) Set(_, _ []byte) {
+	panic("cannot write to a read store")
+}
+
+// Delete implements types.KVStore, the writes are held by the cache above.
+func (s *readStore) Delete(_ []byte) {
+	panic("cannot delete from a read store")
+}
+
+// Iterator implements types.KVStore.
+func (s *readStore) Iterator(start, end []byte) types.Iterator {
+	return s.iterator(start, end, true)
+}
+
+// ReverseIterator implements types.KVStore.
+func (s *readStore) ReverseIterator(start, end []byte) types.Iterator {
+	return s.iterator(start, end, false)
+}
+
+func (s *readStore) iterator(start, end []byte, ascending bool) types.Iterator {
+	s.ms.checkAborted()
+
+	entries := s.mv.snapshot(start, end, s.ms.version.txIndex, ascending)
+	for _, e := range entries {
+		if e.estimate {
+			s.ms.abort(e.txIndex)
+		}
+	}
+
+	ir := &iteratorRead{store: s.mv, base: s.base, start: start, end: end, ascending: ascending}
+	s.ms.reads.iterators = append(s.ms.reads.iterators, ir)
+	return newObservedIterator(newMergeIterator(s.base, start, end, entries, ascending), ir)
+}
+
+var _ types.CacheMultiStore = (*cacheMultiStore)(nil)
+
+// cacheMultiStore is a branch of the multistore of an incarnation, its stores
+// are branched when they are first used.
+type cacheMultiStore struct {
+	parent types.MultiStore
+	stores map[types.StoreKey]types.CacheWrap
+	// keys holds the order in which the stores were branched.
+	keys []types.StoreKey
+}
+
+func newCacheMultiStore(parent types.MultiStore) *cacheMultiStore {
+	return &cacheMultiStore{parent: parent, stores: make(map[types.StoreKey]types.CacheWrap)}
+}
+
+func (cms *cacheMultiStore) branch(key types.StoreKey, branch func() types.CacheWrap) types.CacheWrap {
+	store, ok := cms.stores[key]
+	if !ok {
+		store = branch()
+		cms.stores[key] = store
+		cms.keys = append(cms.keys, key)
+	}
+	return store
+}
+
+// Write implements types.CacheMultiStore.
+func (cms *cacheMultiStore) Write() {
+	for _, key := range cms.keys {
+		cms.stores[key].Write()
+	}
+}
+
+// GetStoreType implements types.Store.
+func (cms *cacheMultiStore) GetStoreType() types.StoreType {
+	return cms.parent.GetStoreType()
+}
+
+// CacheWrap implements types.CacheWrapper.
+func (cms *cacheMultiStore) CacheWrap() types.CacheWrap {
+	return cms.CacheMultiStore()
+}
+
+// CacheWrapWithTrace implements types.CacheWrapper, the writes are not traced.
+func (cms *cacheMultiStore) CacheWrapWithTrace(_ io.Writer, _ types.TraceContext) types.CacheWrap {
+	return cms.CacheWrap()
+}
+
+// CacheMultiStore implements types.MultiStore.
+func (cms *cacheMultiStore) CacheMultiStore() types.CacheMultiStore {
+	return newCacheMultiStore(cms)
+}
+
+// CacheMultiStoreWithVersion implements types.MultiStore.
+func (cms *cacheMultiStore) CacheMultiStoreWithVersion(_ int64) (types.CacheMultiStore, error) {
+	return nil, errors.New("cannot branch a versioned multistore at a version")
+}
+
+// GetStore implements types.MultiStore.
+func (cms *cacheMultiStore) GetStore(key types.StoreKey) types.Store {
+	return cms.GetKVStore(key)
+}
+
+// GetKVStore implements types.MultiStore.
+func (cms *cacheMultiStore) GetKVStore(key types.StoreKey) types.KVStore {
+	return cms.branch(key, func() types.CacheWrap {
+		return cms.parent.GetKVStore(key).CacheWrap()
+	}).(types.KVStore)
+}
+
+// GetObjKVStore implements types.MultiStore.
+func (cms *cacheMultiStore) GetObjKVStore(key types.StoreKey) types.ObjKVStore {
+	return cms.branch(key, func() types.CacheWrap {
+		return cms.parent.GetObjKVStore(key).CacheWrap()
+	}).(types.ObjKVStore)
+}
+
+// TracingEnabled implements types.MultiStore.
+func (cms *cacheMultiStore) TracingEnabled() bool {
+	return false
+}
+
+// SetTracer implements types.MultiStore, the writes are not traced.
+func (cms *cacheMultiStore) SetTracer(_ io.Writer) types.MultiStore {
+	return cms
+}
+
+// SetTracingContext implements types.MultiStore, the writes are not traced.
+func (cms *cacheMultiStore) SetTracingContext(_ types.TraceContext) types.MultiStore {
+	return cms
+}
+
+// LatestVersion implements types.MultiStore.
+func (cms *cacheMultiStore) LatestVersion() int64 {
+	return cms.parent.LatestVersion()
+}
@@ -14,21 +14,27 @@ import (
 // cache shadows (overrides) the parent.
 //
 // TODO: Optimize by memoizing.
-type cacheMergeIterator struct {
-	parent    types.Iterator
-	cache     types.Iterator
+type cacheMergeIterator[V any] struct {
+	parent    types.GIterator[V]
+	cache     types.GIterator[V]
 	ascending bool
 
 	valid bool
+
+	// isZero returns whether a cache value is a deletion.
+	isZero func(V) bool
 }
 
-var _ types.Iterator = (*cacheMergeIterator)(nil)
+var _ types.Iterator = (*cacheMergeIterator[[]byte])(nil)
 
-func NewCacheMergeIterator(parent, cache types.Iterator, ascending bool) types.Iterator {
-	iter := &cacheMergeIterator{
+// NewCacheMergeIterator merges the parent and cache iterators, isZero returns
+// whether a cache value is a deletion.
+func NewCacheMergeIterator[V any](parent, cache types.GIterator[V], ascending bool, isZero func(V) bool) types.GIterator[V] {
+	iter := &cacheMergeIterator[V]{
 		parent:    parent,
 		cache:     cache,
 		ascending: ascending,
+		isZero:    isZero,
 	}
 
 	iter.valid = iter.skipUntilExistsOrInvalid()
@@ -37,17 +43,17 @@ func NewCacheMergeIterator(parent, cache types.Iterator, ascending bool) types.I
 
 // Domain implements Iterator.
 // Returns parent domain because cache and parent domains are the same.
-func (iter *cacheMergeIterator) Domain() (start, end []byte) {
+func (iter *cacheMergeIterator[V]) Domain() (start, end []byte) {
 	return iter.parent.Domain()
 }
 
 // Valid implements Iterator.
-func (iter *cacheMergeIterator) Valid() bool {
+func (iter *cacheMergeIterator[V]) Valid() bool {
 	return iter.valid
 }
 
 // Next implements Iterator
-func (iter *cacheMergeIterator) Next() {
+func (iter *cacheMergeIterator[V]) Next() {
 	iter.assertValid()
 
 	switch {
@@ -74,7 +80,7 @@ func (iter *cacheMergeIterator) Next() {
 }
 
 // Key implements Iterator
-func (iter *cacheMergeIterator) Key() []byte {
+func (iter *cacheMergeIterator[V]) Key() []byte {
 	iter.assertValid()
 
 	// If parent is invalid, get the cache key.
@@ -104,7 +110,7 @@ func (iter *cacheMergeIterator) Key() []byte {
 }
 
 // Value implements Iterator
-func (iter *cacheMergeIterator) Value() []byte {
+func (iter *cacheMergeIterator[V]) Value() V {
 	iter.assertValid()
 
 	// If parent is invalid, get the cache value.
@@ -134,7 +140,7 @@ func (iter *cacheMergeIterator) Value() []byte {
 }
 
 // Close implements Iterator
-func (iter *cacheMergeIterator) Close() error {
+func (iter *cacheMergeIterator[V]) Close() error {
 	err1 := iter.cache.Close()
 	if err := iter.parent.Close(); err != nil {
 		return err
@@ -145,7 +151,7 @@ func (iter *cacheMergeIterator) Close() error {
 
 // Error returns an error if the cacheMergeIterator is invalid defined by the
 // Valid method.
-func (iter *cacheMergeIterator) Error() error {
+func (iter *cacheMergeIterator[V]) Error() error {
 	if !iter.Valid() {
 		return errors.New("invalid cacheMergeIterator")
 	}
@@ -155,14 +161,14 @@ func (iter *cacheMergeIterator) Error() error {
 
 // If not valid, panics.
 // NOTE: May have side-effect of iterating over cache.
-func (iter *cacheMergeIterator) assertValid() {
+func (iter *cacheMergeIterator[V]) assertValid() {
 	if err := iter.Error(); err != nil {
 		panic(err)
 	}
 }
 
 // Like bytes.Compare but opposite if not ascending.
-func (iter *cacheMergeIterator) compare(a, b []byte) int {
+func (iter *cacheMergeIterator[V]) compare(a, b []byte) int {
 	if iter.ascending {
 		return bytes.Compare(a, b)
 	}
@@ -175,9 +181,9 @@ func (iter *cacheMergeIterator) compare(a, b []byte) int {
 // If the current cache item is not a delete item, does nothing.
 // If `until` is nil, there is no limit, and cache may end up invalid.
 // CONTRACT: cache is valid.
-func (iter *cacheMergeIterator) skipCacheDeletes(until []byte) {
+func (iter *cacheMergeIterator[V]) skipCacheDeletes(until []byte) {
 	for iter.cache.Valid() &&
-		iter.cache.Value() == nil &&
+		iter.isZero(iter.cache.Value()) &&
 		(until == nil || iter.compare(iter.cache.Key(), until) < 0) {
 		iter.cache.Next()
 	}
@@ -186,7 +192,7 @@ func (iter *cacheMergeIterator) skipCacheDeletes(until []byte) {
 // Fast forwards cache (or parent+cache in case of deleted items) until current
 // item exists, or until iterator becomes invalid.
 // Returns whether the iterator is valid.
-func (iter *cacheMergeIterator) skipUntilExistsOrInvalid() bool {
+func (iter *cacheMergeIterator[V]) skipUntilExistsOrInvalid() bool {
 	for {
 		// If parent is invalid, fast-forward cache.
 		if !iter.parent.Valid() {
@@ -211,7 +217,7 @@ func (iter *cacheMergeIterator) skipUntilExistsOrInvalid() bool {
 		case 0: // parent == cache.
 			// Skip over if cache item is a delete.
 			valueC := iter.cache.Value()
-			if valueC == nil {
+			if iter.isZero(valueC) {
 				iter.parent.Next()
 				iter.cache.Next()
 
@@ -223,7 +229,7 @@ func (iter *cacheMergeIterator) skipUntilExistsOrInvalid() bool {
 		case 1: // cache < parent
 			// Skip over if cache item is a delete.
 			valueC := iter.cache.Value()
-			if valueC == nil {
+			if iter.isZero(valueC) {
 				iter.skipCacheDeletes(keyP)
 				continue
 			}
@@ -10,47 +10,79 @@ import (
 	dbm "github.com/cosmos/cosmos-db"
 
 	"cosmossdk.io/store/cachekv/internal"
+	"cosmossdk.io/store/internal/btree"
 	"cosmossdk.io/store/internal/conv"
-	"cosmossdk.io/store/internal/kv"
 	"cosmossdk.io/store/tracekv"
 	"cosmossdk.io/store/types"
 )
 
 // cValue represents a cached value.
 // If dirty is true, it indicates the cached value is different from the underlying value.
-type cValue struct {
-	value []byte
+type cValue[V any] struct {
+	value V
 	dirty bool
 }
 
+// kvPair is a key-value pair of the cache.
+type kvPair[V any] struct {
+	key   []byte
+	value V
+}
+
 // Store wraps an in-memory cache around an underlying types.KVStore.
-type Store struct {
+type Store = GStore[[]byte]
+
+// ObjStore wraps an in-memory cache around an underlying types.ObjKVStore.
+type ObjStore = GStore[any]
+
+// GStore wraps an in-memory cache around an underlying types.GKVStore, it is
+// generic over the type of the values.
+type GStore[V any] struct {
 	mtx           sync.Mutex
-	cache         map[string]*cValue
+	cache         map[string]*cValue[V]
 	unsortedCache map[string]struct{}
-	sortedCache   internal.BTree // always ascending sorted
-	parent        types.KVStore
+	sortedCache   btree.BTree[V] // always ascending sorted
+	parent        types.GKVStore[V]
+
+	// isZero returns whether a value is the zero value of its type, which is
+	// not a valid value and is used by the cache to represent a deletion.
+	isZero func(V) bool
 }
 
-var _ types.CacheKVStore = (*Store)(nil)
+var (
+	_ types.CacheKVStore    = (*Store)(nil)
+	_ types.CacheObjKVStore = (*ObjStore)(nil)
+)
 
 // NewStore creates a new Store object
 func NewStore(parent types.KVStore) *Store {
-	return &Store{
-		cache:         make(map[string]*cValue),
+	return NewGStore(parent, func(v []byte) bool { return v == nil })
+}
+
+// NewObjStore creates a new ObjStore object
+func NewObjStore(parent types.ObjKVStore) *ObjStore {
+	return NewGStore(parent, func(v any) bool { return v == nil })
+}
+
+// NewGStore creates a new GStore object, isZero returns whether a value is
+// the zero value of its type.
+func NewGStore[V any](parent types.GKVStore[V], isZero func(V) bool) *GStore[V] {
+	return &GStore[V]{
+		cache:         make(map[string]*cValue[V]),
 		unsortedCache: make(map[string]struct{}),
-		sortedCache:   internal.NewBTree(),
+		sortedCache:   btree.NewBTree[V](),
 		parent:        parent,
+		isZero:        isZero,
 	}
 }
 
 // GetStoreType implements Store.
-func (store *Store) GetStoreType() types.StoreType {
+func (store *GStore[V]) GetStoreType() types.StoreType {
 	return store.parent.GetStoreType()
 }
 
 // Get implements types.KVStore.
-func (store *Store) Get(key []byte) (value []byte) {
+func (store *GStore[V]) Get(key []byte) (value V) {
 	store.mtx.Lock()
 	defer store.mtx.Unlock()
 
@@ -68,9 +100,14 @@ func (store *Store) Get(key []byte) (value []byte) {
 }
 
 // Set implements types.KVStore.
-func (store *Store) Set(key, value []byte) {
+func (store *GStore[V]) Set(key []byte, value V) {
 	types.AssertValidKey(key)
-	types.AssertValidValue(value)
+	if store.isZero(value) {
+		panic("value is nil")
+	}
+	if bz, ok := any(value).([]byte); ok {
+		types.AssertValidValue(bz)
+	}
 
 	store.mtx.Lock()
 	defer store.mtx.Unlock()
@@ -78,28 +115,29 @@ func (store *Store) Set(key, value []byte) {
 }
 
 // Has implements types.KVStore.
-func (store *Store) Has(key []byte) bool {
+func (store *GStore[V]) Has(key []byte) bool {
 	value := store.Get(key)
-	return value != nil
+	return !store.isZero(value)
 }
 
 // Delete implements types.KVStore.
-func (store *Store) Delete(key []byte) {
+func (store *GStore[V]) Delete(key []byte) {
 	types.AssertValidKey(key)
 
 	store.mtx.Lock()
 	defer store.mtx.Unlock()
 
-	store.setCacheValue(key, nil, true)
+	var empty V
+	store.setCacheValue(key, empty, true)
 }
 
 // Implements Cachetypes.KVStore.
-func (store *Store) Write() {
+func (store *GStore[V]) Write() {
 	store.mtx.Lock()
 	defer store.mtx.Unlock()
 
 	if len(store.cache) == 0 && len(store.unsortedCache) == 0 {
-		store.sortedCache = internal.NewBTree()
+		store.sortedCache = btree.NewBTree[V]()
 		return
 	}
 
@@ -123,7 +161,7 @@ func (store *Store) Write() {
 		// not. Once we get confirmation that .Delete is guaranteed not to
 		// save the byteslice, then we can assume only a read-only copy is sufficient.
 		cacheValue := store.cache[key]
-		if cacheValue.value != nil {
+		if !store.isZero(cacheValue.value) {
 			// It already exists in the parent, hence update it.
 			store.parent.Set([]byte(key), cacheValue.value)
 		} else {
@@ -140,33 +178,37 @@ func (store *Store) Write() {
 	for key := range store.unsortedCache {
 		delete(store.unsortedCache, key)
 	}
-	store.sortedCache = internal.NewBTree()
+	store.sortedCache = btree.NewBTree[V]()
 }
 
 // CacheWrap implements CacheWrapper.
-func (store *Store) CacheWrap() types.CacheWrap {
-	return NewStore(store)
+func (store *GStore[V]) CacheWrap() types.CacheWrap {
+	return NewGStore[V](store, store.isZero)
 }
 
-// CacheWrapWithTrace implements the CacheWrapper interface.
-func (store *Store) CacheWrapWithTrace(w io.Writer, tc types.TraceContext) types.CacheWrap {
-	return NewStore(tracekv.NewStore(store, w, tc))
+// CacheWrapWithTrace implements the CacheWrapper interface. Only the stores of
+// []byte values are traced.
+func (store *GStore[V]) CacheWrapWithTrace(w io.Writer, tc types.TraceContext) types.CacheWrap {
+	if store, ok := any(store).(*Store); ok {
+		return NewStore(tracekv.NewStore(store, w, tc))
+	}
+	return store.CacheWrap()
 }
 
 //----------------------------------------
 // Iteration
 
 // Iterator implements types.KVStore.
-func (store *Store) Iterator(start, end []byte) types.Iterator {
+func (store *GStore[V]) Iterator(start, end []byte) types.GIterator[V] {
 	return store.iterator(start, end, true)
 }
 
 // ReverseIterator implements types.KVStore.
-func (store *Store) ReverseIterator(start, end []byte) types.Iterator {
+func (store *GStore[V]) ReverseIterator(start, end []byte) types.GIterator[V] {
 	return store.iterator(start, end, false)
 }
 
-func (store *Store) iterator(start, end []byte, ascending bool) types.Iterator {
+func (store *GStore[V]) iterator(start, end []byte, ascending bool) types.GIterator[V] {
 	store.mtx.Lock()
 	defer store.mtx.Unlock()
 
@@ -175,7 +217,7 @@ func (store *Store) iterator(start, end []byte, ascending bool) types.Iterator {
 
 	var (
 		err           error
-		parent, cache types.Iterator
+		parent, cache types.GIterator[V]
 	)
 
 	if ascending {
@@ -189,7 +231,7 @@ func (store *Store) iterator(start, end []byte, ascending bool) types.Iterator {
 		panic(err)
 	}
 
-	return internal.NewCacheMergeIterator(parent, cache, ascending)
+	return internal.NewCacheMergeIterator(parent, cache, ascending, store.isZero)
 }
 
 func findStartIndex(strL []string, startQ string) int {
@@ -275,7 +317,7 @@ const (
 const minSortSize = 1024
 
 // Constructs a slice of dirty items, to use w/ memIterator.
-func (store *Store) dirtyItems(start, end []byte) {
+func (store *GStore[V]) dirtyItems(start, end []byte) {
 	startStr, endStr := conv.UnsafeBytesToStr(start), conv.UnsafeBytesToStr(end)
 	if end != nil && startStr > endStr {
 		// Nothing to do here.
@@ -283,7 +325,7 @@ func (store *Store) dirtyItems(start, end []byte) {
 	}
 
 	n := len(store.unsortedCache)
-	unsorted := make([]*kv.Pair, 0)
+	unsorted := make([]*kvPair[V], 0)
 	// If the unsortedCache is too big, its costs too much to determine
 	// whats in the subset we are concerned about.
 	// If you are interleaving iterator calls with writes, this can easily become an
@@ -295,7 +337,7 @@ func (store *Store) dirtyItems(start, end []byte) {
 			// dbm.IsKeyInDomain is nil safe and returns true iff key is greater than start
 			if dbm.IsKeyInDomain(conv.UnsafeStrToBytes(key), start, end) {
 				cacheValue := store.cache[key]
-				unsorted = append(unsorted, &kv.Pair{Key: []byte(key), Value: cacheValue.value})
+				unsorted = append(unsorted, &kvPair[V]{key: []byte(key), value: cacheValue.value})
 			}
 		}
 		store.clearUnsortedCacheSubset(unsorted, stateUnsorted)
@@ -338,18 +380,18 @@ func (store *Store) dirtyItems(start, end []byte) {
 		}
 	}
 
-	kvL := make([]*kv.Pair, 0, 1+endIndex-startIndex)
+	kvL := make([]*kvPair[V], 0, 1+endIndex-startIndex)
 	for i := startIndex; i <= endIndex; i++ {
 		key := strL[i]
 		cacheValue := store.cache[key]
-		kvL = append(kvL, &kv.Pair{Key: []byte(key), Value: cacheValue.value})
+		kvL = append(kvL, &kvPair[V]{key: []byte(key), value: cacheValue.value})
 	}
 
 	// kvL was already sorted so pass it in as is.
 	store.clearUnsortedCacheSubset(kvL, stateAlreadySorted)
 }
 
-func (store *Store) clearUnsortedCacheSubset(unsorted []*kv.Pair, sortState sortState) {
+func (store *GStore[V]) clearUnsortedCacheSubset(unsorted []*kvPair[V], sortState sortState) {
 	n := len(store.unsortedCache)
 	if len(unsorted) == n { // This pattern allows the Go compiler to emit the map clearing idiom for the entire map.
 		for key := range store.unsortedCache {
@@ -357,19 +399,19 @@ func (store *Store) clearUnsortedCacheSubset(unsorted []*kv.Pair, sortState sort
 		}
 	} else { // Otherwise, normally delete the unsorted keys from the map.
 		for _, kv := range unsorted {
-			delete(store.unsortedCache, conv.UnsafeBytesToStr(kv.Key))
+			delete(store.unsortedCache, conv.UnsafeBytesToStr(kv.key))
 		}
 	}
 
 	if sortState == stateUnsorted {
 		sort.Slice(unsorted, func(i, j int) bool {
-			return bytes.Compare(unsorted[i].Key, unsorted[j].Key) < 0
+			return bytes.Compare(unsorted[i].key, unsorted[j].key) < 0
 		})
 	}
 
 	for _, item := range unsorted {
 		// sortedCache is able to store `nil` value to represent deleted items.
-		store.sortedCache.Set(item.Key, item.Value)
+		store.sortedCache.Set(item.key, item.value)
 	}
 }
 
@@ -377,10 +419,10 @@ func (store *Store) clearUnsortedCacheSubset(unsorted []*kv.Pair, sortState sort
 // etc
 
 // Only entrypoint to mutate store.cache.
-// A `nil` value means a deletion.
-func (store *Store) setCacheValue(key, value []byte, dirty bool) {
+// A zero value means a deletion.
+func (store *GStore[V]) setCacheValue(key []byte, value V, dirty bool) {
 	keyStr := conv.UnsafeBytesToStr(key)
-	store.cache[keyStr] = &cValue{
+	store.cache[keyStr] = &cValue[V]{
 		value: value,
 		dirty: dirty,
 	}
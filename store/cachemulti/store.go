@@ -50,6 +50,12 @@ func NewFromKVStore(
 	}
 
 	for key, store := range stores {
+		// the object stores are branched without tracing
+		if objStore, ok := store.(types.ObjKVStore); ok {
+			cms.stores[key] = cachekv.NewObjStore(objStore)
+			continue
+		}
+
 		if cms.TracingEnabled() {
 			tctx := cms.traceContext.Clone().Merge(types.TraceContext{
 				storeNameCtxKey: key.Name(),
@@ -168,3 +174,16 @@ func (cms Store) GetKVStore(key types.StoreKey) types.KVStore {
 	}
 	return store.(types.KVStore)
 }
+
+// GetObjKVStore returns an underlying ObjKVStore by key.
+func (cms Store) GetObjKVStore(key types.StoreKey) types.ObjKVStore {
+	store := cms.stores[key]
+	if key == nil || store == nil {
+		panic(fmt.Sprintf("object store with key %v has not been registered in stores", key))
+	}
+	objStore, ok := store.(types.ObjKVStore)
+	if !ok {
+		panic(fmt.Sprintf("store with key %v is not an object store", key))
+	}
+	return objStore
+}
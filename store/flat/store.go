@@ -0,0 +1,739 @@
+package flat
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtprotocrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
+	dbm "github.com/cosmos/cosmos-db"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/cachekv"
+	"cosmossdk.io/store/dbadapter"
+	"cosmossdk.io/store/internal/kv"
+	sdkmaps "cosmossdk.io/store/internal/maps"
+	pruningtypes "cosmossdk.io/store/pruning/types"
+	"cosmossdk.io/store/tracekv"
+	"cosmossdk.io/store/types"
+)
+
+const (
+	dataPrefix = 'd' // dataPrefix | key -> value of the latest version
+	undoPrefix = 'u' // undoPrefix | version | key -> value of the key before the version
+	hashPrefix = 'h' // hashPrefix | version -> hash of the version
+)
+
+// ErrVersionDoesNotExist is returned when reading a version which was never committed or
+// has been pruned.
+var ErrVersionDoesNotExist = errors.New("version does not exist")
+
+var (
+	_ types.KVStore         = (*Store)(nil)
+	_ types.CommitKVStore   = (*Store)(nil)
+	_ types.Queryable       = (*Store)(nil)
+	_ types.CommitmentStore = (*Store)(nil)
+)
+
+// Store is a reference commitment backend, which keeps the pairs of its latest version as
+// is in its database. Its hash is the simple merkle root of all its pairs, the same as the
+// one of the commit info of the root multistore, so that the existence of a pair is proven
+// with a ProofOpSimpleMerkleCommitment. The hash is computed again from all the pairs on
+// each commit, so the store is meant to experiment with commitment backends rather than to
+// hold a large state.
+//
+// Older versions are read by undoing the writes of the newer ones from the latest version,
+// so the previous values of the keys written by a version are kept until no older version
+// is left.
+type Store struct {
+	db   dbm.DB
+	data dbm.DB
+	// cache holds the writes of the version being built.
+	cache *cachekv.Store
+
+	mtx            sync.RWMutex
+	version        int64
+	hash           []byte
+	initialVersion int64
+
+	// batch collects the writes of the version being committed.
+	batch        dbm.Batch
+	batchVersion int64
+}
+
+// LoadStore loads a flat store from the provided DB at the version of id, which must be
+// its latest version. A zero version loads the latest version.
+func LoadStore(db dbm.DB, id types.CommitID, initialVersion int64) (*Store, error) {
+	s := &Store{
+		db:             db,
+		data:           dbm.NewPrefixDB(db, []byte{dataPrefix}),
+		initialVersion: initialVersion,
+	}
+	s.cache = cachekv.NewStore(committedStore{Store: dbadapter.Store{DB: s.data}, s: s})
+
+	latest, err := s.lastVersion(nil)
+	if err != nil {
+		return nil, err
+	}
+	if id.Version > latest {
+		return nil, errorsmod.Wrapf(ErrVersionDoesNotExist, "cannot load version %d, latest is %d", id.Version, latest)
+	}
+	if id.Version != 0 && id.Version != latest {
+		return nil, fmt.Errorf("flat store can only be loaded at its latest version %d, got %d", latest, id.Version)
+	}
+
+	s.version = latest
+	if latest == 0 {
+		s.hash = sdkmaps.HashFromMap(nil)
+	} else if s.hash, err = db.Get(hashKey(latest)); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// committedStore is the parent of the cache of the version being built: it reads the pairs
+// of the latest version, and writes the ones of the version being committed into its batch.
+type committedStore struct {
+	dbadapter.Store
+	s *Store
+}
+
+func (cs committedStore) Set(key, value []byte) {
+	cs.s.write(key, value)
+}
+
+func (cs committedStore) Delete(key []byte) {
+	cs.s.write(key, nil)
+}
+
+// write writes a pair of the version being committed along with its previous value.
+func (s *Store) write(key, value []byte) {
+	prev, err := s.data.Get(key)
+	if err != nil {
+		panic(err)
+	}
+
+	undo := []byte{0}
+	if prev != nil {
+		undo = append([]byte{1}, prev...)
+	}
+	if err := s.batch.Set(undoKey(s.batchVersion, key), undo); err != nil {
+		panic(err)
+	}
+
+	if value == nil {
+		err = s.batch.Delete(dataKey(key))
+	} else {
+		err = s.batch.Set(dataKey(key), value)
+	}
+	if err != nil {
+		panic(err)
+	}
+}
+
+// Commit implements Committer.
+func (s *Store) Commit() types.CommitID {
+	hash := s.WorkingHash()
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	version := s.version + 1
+	if s.version == 0 && s.initialVersion > 1 {
+		version = s.initialVersion
+	}
+
+	s.batch, s.batchVersion = s.db.NewBatch(), version
+	defer func() {
+		s.batch.Close()
+		s.batch = nil
+	}()
+
+	s.cache.Write()
+	if err := s.batch.Set(hashKey(version), hash); err != nil {
+		panic(err)
+	}
+	if err := s.batch.WriteSync(); err != nil {
+		panic(err)
+	}
+
+	s.version, s.hash = version, hash
+	return types.CommitID{
+		Version: version,
+		Hash:    hash,
+	}
+}
+
+// WorkingHash implements Committer, returning the hash of all the pairs of the store.
+func (s *Store) WorkingHash() []byte {
+	return sdkmaps.HashFromMap(iteratorPairs(s.cache.Iterator(nil, nil)))
+}
+
+// LastCommitID implements Committer.
+func (s *Store) LastCommitID() types.CommitID {
+	s.mtx.RLock()
+	defer s.mtx.RUnlock()
+
+	return types.CommitID{
+		Version: s.version,
+		Hash:    s.hash,
+	}
+}
+
+// SetPruning panics as the versions of the store are pruned by the root multistore.
+func (s *Store) SetPruning(_ pruningtypes.PruningOptions) {
+	panic("cannot set pruning options on a flat store")
+}
+
+// GetPruning panics as the versions of the store are pruned by the root multistore.
+func (s *Store) GetPruning() pruningtypes.PruningOptions {
+	panic("cannot get pruning options on a flat store")
+}
+
+// SetInitialVersion implements StoreWithInitialVersion.
+func (s *Store) SetInitialVersion(version int64) {
+	s.initialVersion = version
+}
+
+// Implements Store.
+func (s *Store) GetStoreType() types.StoreType {
+	return types.StoreTypeFlat
+}
+
+// Implements Store.
+func (s *Store) CacheWrap() types.CacheWrap {
+	return cachekv.NewStore(s)
+}
+
+// CacheWrapWithTrace implements the Store interface.
+func (s *Store) CacheWrapWithTrace(w io.Writer, tc types.TraceContext) types.CacheWrap {
+	return cachekv.NewStore(tracekv.NewStore(s, w, tc))
+}
+
+// Implements types.KVStore.
+func (s *Store) Get(key []byte) []byte {
+	return s.cache.Get(key)
+}
+
+// Implements types.KVStore.
+func (s *Store) Has(key []byte) bool {
+	return s.cache.Has(key)
+}
+
+// Set implements types.KVStore. Empty keys are not supported by the merkle root of the
+// store.
+func (s *Store) Set(key, value []byte) {
+	if len(key) == 0 {
+		panic("key is empty")
+	}
+	s.cache.Set(key, value)
+}
+
+// Implements types.KVStore.
+func (s *Store) Delete(key []byte) {
+	s.cache.Delete(key)
+}
+
+// Implements types.KVStore.
+func (s *Store) Iterator(start, end []byte) types.Iterator {
+	return iterator{s.cache.Iterator(start, end)}
+}
+
+// Implements types.KVStore.
+func (s *Store) ReverseIterator(start, end []byte) types.Iterator {
+	return iterator{s.cache.ReverseIterator(start, end)}
+}
+
+// iterator is an iterator of the cache of the store, which unlike the cache iterators is
+// not in error once exhausted.
+type iterator struct {
+	types.Iterator
+}
+
+func (it iterator) Error() error {
+	if !it.Valid() {
+		return nil
+	}
+	return it.Iterator.Error()
+}
+
+// VersionExists implements types.CommitmentStore.
+func (s *Store) VersionExists(version int64) bool {
+	s.mtx.RLock()
+	defer s.mtx.RUnlock()
+
+	return s.versionExists(version)
+}
+
+func (s *Store) versionExists(version int64) bool {
+	if version <= 0 || version > s.version {
+		return false
+	}
+	ok, err := s.db.Has(hashKey(version))
+	if err != nil {
+		panic(err)
+	}
+	return ok
+}
+
+// GetImmutableKVStore implements types.CommitmentStore, returning a copy of the pairs of the
+// version. Any write to the returned store results in a panic.
+func (s *Store) GetImmutableKVStore(version int64) (types.KVStore, error) {
+	s.mtx.RLock()
+	defer s.mtx.RUnlock()
+
+	state, err := s.stateAt(version)
+	if err != nil {
+		return nil, err
+	}
+	return immutableStore{dbadapter.Store{DB: state}}, nil
+}
+
+// immutableStore is a read-only store of a past version.
+type immutableStore struct {
+	dbadapter.Store
+}
+
+func (immutableStore) Set(_, _ []byte) {
+	panic("cannot write to an immutable flat store")
+}
+
+func (immutableStore) Delete(_ []byte) {
+	panic("cannot write to an immutable flat store")
+}
+
+// DeleteVersions implements types.CommitmentStore. The previous values written by the
+// versions up to the oldest version left are deleted along with them.
+func (s *Store) DeleteVersions(versions ...int64) error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	batch := s.db.NewBatch()
+	defer batch.Close()
+
+	for _, version := range versions {
+		if version == s.version {
+			return fmt.Errorf("cannot delete latest saved version (%d)", version)
+		}
+		if !s.versionExists(version) {
+			return errorsmod.Wrapf(ErrVersionDoesNotExist, "cannot delete version %d", version)
+		}
+		if err := batch.Delete(hashKey(version)); err != nil {
+			return err
+		}
+	}
+	if err := batch.Write(); err != nil {
+		return err
+	}
+
+	// the previous values written by a version are needed to read the older versions only
+	oldest, err := s.firstVersion()
+	if err != nil {
+		return err
+	}
+	return s.deleteRange(undoKey(0, nil), undoKey(oldest+1, nil))
+}
+
+// LoadVersionForOverwriting implements types.CommitmentStore, undoing the writes of the
+// versions above the loaded one. The uncommitted writes are discarded.
+func (s *Store) LoadVersionForOverwriting(targetVersion int64) (int64, error) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	version, err := s.lastVersion(hashKey(targetVersion + 1))
+	if err != nil {
+		return 0, err
+	}
+	if version == 0 && s.version > 0 {
+		return 0, errorsmod.Wrapf(ErrVersionDoesNotExist, "no version at or below %d", targetVersion)
+	}
+
+	batch := s.db.NewBatch()
+	defer batch.Close()
+
+	for v := s.version; v > version; v-- {
+		err := s.iterateUndo(v, func(key, prev []byte, existed bool) error {
+			if err := batch.Delete(undoKey(v, key)); err != nil {
+				return err
+			}
+			if existed {
+				return batch.Set(dataKey(key), prev)
+			}
+			return batch.Delete(dataKey(key))
+		})
+		if err != nil {
+			return 0, err
+		}
+		if err := batch.Delete(hashKey(v)); err != nil {
+			return 0, err
+		}
+	}
+	if err := batch.WriteSync(); err != nil {
+		return 0, err
+	}
+
+	s.version = version
+	if version == 0 {
+		s.hash = sdkmaps.HashFromMap(nil)
+	} else if s.hash, err = s.db.Get(hashKey(version)); err != nil {
+		return 0, err
+	}
+	s.cache = cachekv.NewStore(committedStore{Store: dbadapter.Store{DB: s.data}, s: s})
+
+	return version, nil
+}
+
+// SnapshotExporter implements types.CommitmentStore, exporting the pairs of the version as
+// leaf nodes in key order.
+func (s *Store) SnapshotExporter(version int64) (types.CommitmentExporter, error) {
+	s.mtx.RLock()
+	defer s.mtx.RUnlock()
+
+	state, err := s.stateAt(version)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "flat export failed for version %v", version)
+	}
+	it, err := state.Iterator(nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &exporter{it: it, version: version}, nil
+}
+
+// SnapshotImporter implements types.CommitmentStore, importing the leaf nodes exported by
+// a flat store.
+func (s *Store) SnapshotImporter(version int64) (types.CommitmentImporter, error) {
+	s.mtx.RLock()
+	empty := s.version == 0
+	s.mtx.RUnlock()
+
+	if empty {
+		it, err := s.data.Iterator(nil, nil)
+		if err != nil {
+			return nil, err
+		}
+		empty = !it.Valid()
+		it.Close()
+	}
+	if !empty {
+		return nil, errors.New("flat import failed: store is not empty")
+	}
+
+	return &importer{
+		store:   s,
+		version: version,
+		batch:   s.db.NewBatch(),
+		pairs:   map[string][]byte{},
+	}, nil
+}
+
+type exporter struct {
+	it      dbm.Iterator
+	version int64
+}
+
+func (e *exporter) Next() (*types.CommitmentNode, error) {
+	if !e.it.Valid() {
+		return nil, types.ErrExportDone
+	}
+	node := &types.CommitmentNode{
+		Key:     e.it.Key(),
+		Value:   e.it.Value(),
+		Version: e.version,
+	}
+	e.it.Next()
+	return node, nil
+}
+
+func (e *exporter) Close() {
+	e.it.Close()
+}
+
+type importer struct {
+	store   *Store
+	version int64
+	batch   dbm.Batch
+	pairs   map[string][]byte
+}
+
+func (i *importer) Add(node *types.CommitmentNode) error {
+	if node.Height != 0 {
+		return fmt.Errorf("flat store cannot import a node of height %d", node.Height)
+	}
+	if len(node.Key) == 0 {
+		return errors.New("flat store cannot import an empty key")
+	}
+	i.pairs[string(node.Key)] = node.Value
+	return i.batch.Set(dataKey(node.Key), node.Value)
+}
+
+func (i *importer) Commit() error {
+	hash := sdkmaps.HashFromMap(i.pairs)
+	if err := i.batch.Set(hashKey(i.version), hash); err != nil {
+		return err
+	}
+	if err := i.batch.WriteSync(); err != nil {
+		return err
+	}
+
+	i.store.mtx.Lock()
+	defer i.store.mtx.Unlock()
+	i.store.version, i.store.hash = i.version, hash
+	return nil
+}
+
+func (i *importer) Close() {
+	i.batch.Close()
+}
+
+// Query implements ABCI interface, allows queries. As for an IAVL store, a zero height
+// queries the version before the latest one if it exists. Only the existence of a key can
+// be proven.
+func (s *Store) Query(req abci.RequestQuery) (res abci.ResponseQuery) {
+	if len(req.Data) == 0 {
+		return types.QueryResult(errorsmod.Wrap(types.ErrTxDecode, "query cannot be zero length"), false)
+	}
+
+	s.mtx.RLock()
+	defer s.mtx.RUnlock()
+
+	res.Height = req.Height
+	if res.Height == 0 {
+		res.Height = s.version
+		if s.versionExists(s.version - 1) {
+			res.Height = s.version - 1
+		}
+	}
+
+	switch req.Path {
+	case "/key": // get by key
+		key := req.Data // data holds the key bytes
+
+		res.Key = key
+		if !s.versionExists(res.Height) {
+			res.Log = ErrVersionDoesNotExist.Error()
+			break
+		}
+
+		if !req.Prove {
+			value, err := s.getAt(res.Height, key)
+			if err != nil {
+				panic(err)
+			}
+			res.Value = value
+			break
+		}
+
+		state, err := s.stateAt(res.Height)
+		if err != nil {
+			panic(err)
+		}
+		it, err := state.Iterator(nil, nil)
+		if err != nil {
+			panic(err)
+		}
+		pairs := iteratorPairs(it)
+
+		res.Value = pairs[string(key)]
+		if res.Value == nil {
+			return types.QueryResult(errorsmod.Wrap(types.ErrInvalidRequest, "flat store cannot prove the absence of a key"), false)
+		}
+		op, err := types.ProofOpFromMap(pairs, string(key))
+		if err != nil {
+			panic(err)
+		}
+		res.ProofOps = &cmtprotocrypto.ProofOps{Ops: []cmtprotocrypto.ProofOp{op}}
+
+	case "/subspace":
+		pairs := kv.Pairs{
+			Pairs: make([]kv.Pair, 0),
+		}
+
+		subspace := req.Data
+		res.Key = subspace
+
+		state, err := s.stateAt(res.Height)
+		if err != nil {
+			res.Log = err.Error()
+			break
+		}
+		iterator := types.KVStorePrefixIterator(dbadapter.Store{DB: state}, subspace)
+		for ; iterator.Valid(); iterator.Next() {
+			pairs.Pairs = append(pairs.Pairs, kv.Pair{Key: iterator.Key(), Value: iterator.Value()})
+		}
+		iterator.Close()
+
+		bz, err := pairs.Marshal()
+		if err != nil {
+			panic(fmt.Errorf("failed to marshal KV pairs: %w", err))
+		}
+
+		res.Value = bz
+
+	default:
+		return types.QueryResult(errorsmod.Wrapf(types.ErrUnknownRequest, "unexpected query path: %v", req.Path), false)
+	}
+
+	return res
+}
+
+// stateAt returns a copy of the pairs of the given version. The caller must hold the lock.
+func (s *Store) stateAt(version int64) (dbm.DB, error) {
+	if !s.versionExists(version) {
+		return nil, errorsmod.Wrapf(ErrVersionDoesNotExist, "version %d", version)
+	}
+
+	state := dbm.NewMemDB()
+	it, err := s.data.Iterator(nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		if err := state.Set(it.Key(), it.Value()); err != nil {
+			return nil, err
+		}
+	}
+
+	for v := s.version; v > version; v-- {
+		err := s.iterateUndo(v, func(key, prev []byte, existed bool) error {
+			if existed {
+				return state.Set(key, prev)
+			}
+			return state.Delete(key)
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return state, nil
+}
+
+// getAt returns the value of a key at the given version. The caller must hold the lock.
+func (s *Store) getAt(version int64, key []byte) ([]byte, error) {
+	value, err := s.data.Get(key)
+	if err != nil {
+		return nil, err
+	}
+
+	// the oldest write above the version holds the value of the version
+	for v := s.version; v > version; v-- {
+		undo, err := s.db.Get(undoKey(v, key))
+		if err != nil {
+			return nil, err
+		}
+		if undo != nil {
+			value = nil
+			if undo[0] == 1 {
+				value = undo[1:]
+			}
+		}
+	}
+
+	return value, nil
+}
+
+// iterateUndo calls fn with the previous values of the keys written by the given version.
+func (s *Store) iterateUndo(version int64, fn func(key, prev []byte, existed bool) error) error {
+	prefix := undoKey(version, nil)
+	it, err := s.db.Iterator(prefix, types.PrefixEndBytes(prefix))
+	if err != nil {
+		return err
+	}
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		undo := it.Value()
+		if err := fn(it.Key()[len(prefix):], undo[1:], undo[0] == 1); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// firstVersion returns the oldest version stored, or 0 if there is none.
+func (s *Store) firstVersion() (int64, error) {
+	it, err := s.db.Iterator([]byte{hashPrefix}, []byte{hashPrefix + 1})
+	if err != nil {
+		return 0, err
+	}
+	defer it.Close()
+
+	if !it.Valid() {
+		return 0, nil
+	}
+	return int64(binary.BigEndian.Uint64(it.Key()[1:])), nil
+}
+
+// lastVersion returns the latest version stored below end, or 0 if there is none. A nil end
+// returns the latest version.
+func (s *Store) lastVersion(end []byte) (int64, error) {
+	if end == nil {
+		end = []byte{hashPrefix + 1}
+	}
+	it, err := s.db.ReverseIterator([]byte{hashPrefix}, end)
+	if err != nil {
+		return 0, err
+	}
+	defer it.Close()
+
+	if !it.Valid() {
+		return 0, nil
+	}
+	return int64(binary.BigEndian.Uint64(it.Key()[1:])), nil
+}
+
+// deleteRange deletes all the keys of the database in [start, end).
+func (s *Store) deleteRange(start, end []byte) error {
+	batch := s.db.NewBatch()
+	defer batch.Close()
+
+	it, err := s.db.Iterator(start, end)
+	if err != nil {
+		return err
+	}
+	for ; it.Valid(); it.Next() {
+		if err := batch.Delete(it.Key()); err != nil {
+			it.Close()
+			return err
+		}
+	}
+	if err := it.Close(); err != nil {
+		return err
+	}
+	return batch.Write()
+}
+
+// iteratorPairs collects the pairs of an iterator, closing it.
+func iteratorPairs(it dbm.Iterator) map[string][]byte {
+	defer it.Close()
+
+	pairs := map[string][]byte{}
+	for ; it.Valid(); it.Next() {
+		pairs[string(it.Key())] = it.Value()
+	}
+	return pairs
+}
+
+func dataKey(key []byte) []byte {
+	return append([]byte{dataPrefix}, key...)
+}
+
+func undoKey(version int64, key []byte) []byte {
+	bz := make([]byte, 9, 9+len(key))
+	bz[0] = undoPrefix
+	binary.BigEndian.PutUint64(bz[1:], uint64(version))
+	return append(bz, key...)
+}
+
+func hashKey(version int64) []byte {
+	bz := make([]byte, 9)
+	bz[0] = hashPrefix
+	binary.BigEndian.PutUint64(bz[1:], uint64(version))
+	return bz
+}
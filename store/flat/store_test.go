@@ -0,0 +1,159 @@
+package flat
+
+import (
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/store/types"
+)
+
+func newStore(t *testing.T, db dbm.DB) *Store {
+	t.Helper()
+	store, err := LoadStore(db, types.CommitID{}, 0)
+	require.NoError(t, err)
+	return store
+}
+
+func TestStoreVersions(t *testing.T) {
+	db := dbm.NewMemDB()
+	store := newStore(t, db)
+	require.Equal(t, int64(0), store.LastCommitID().Version)
+
+	store.Set([]byte("a"), []byte("1"))
+	store.Set([]byte("b"), []byte("1"))
+	cid1 := store.Commit()
+	require.Equal(t, int64(1), cid1.Version)
+
+	store.Set([]byte("a"), []byte("2"))
+	store.Delete([]byte("b"))
+	store.Set([]byte("c"), []byte("2"))
+	require.NotEqual(t, cid1.Hash, store.WorkingHash())
+	cid2 := store.Commit()
+	require.Equal(t, store.WorkingHash(), cid2.Hash)
+
+	store.Set([]byte("a"), []byte("3"))
+	cid3 := store.Commit()
+
+	v1, err := store.GetImmutableKVStore(1)
+	require.NoError(t, err)
+	require.Equal(t, []byte("1"), v1.Get([]byte("a")))
+	require.Equal(t, []byte("1"), v1.Get([]byte("b")))
+	require.Nil(t, v1.Get([]byte("c")))
+	require.Panics(t, func() { v1.Set([]byte("a"), []byte("x")) })
+
+	v2, err := store.GetImmutableKVStore(2)
+	require.NoError(t, err)
+	require.Equal(t, []byte("2"), v2.Get([]byte("a")))
+	require.Nil(t, v2.Get([]byte("b")))
+
+	_, err = store.GetImmutableKVStore(4)
+	require.ErrorIs(t, err, ErrVersionDoesNotExist)
+
+	// the store is loaded again at its latest version only
+	reloaded, err := LoadStore(db, cid3, 0)
+	require.NoError(t, err)
+	require.Equal(t, cid3, reloaded.LastCommitID())
+	_, err = LoadStore(db, cid2, 0)
+	require.Error(t, err)
+
+	// pruning
+	require.Error(t, store.DeleteVersions(3))
+	require.NoError(t, store.DeleteVersions(1))
+	require.False(t, store.VersionExists(1))
+	require.True(t, store.VersionExists(2))
+	v2, err = store.GetImmutableKVStore(2)
+	require.NoError(t, err)
+	require.Equal(t, []byte("2"), v2.Get([]byte("a")))
+	require.ErrorIs(t, store.DeleteVersions(1), ErrVersionDoesNotExist)
+
+	// rollback, discarding the uncommitted writes
+	store.Set([]byte("d"), []byte("4"))
+	version, err := store.LoadVersionForOverwriting(2)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), version)
+	require.Equal(t, cid2, store.LastCommitID())
+	require.False(t, store.VersionExists(3))
+	require.Equal(t, []byte("2"), store.Get([]byte("a")))
+	require.Nil(t, store.Get([]byte("d")))
+
+	store.Set([]byte("a"), []byte("3"))
+	require.Equal(t, cid3, store.Commit())
+}
+
+func TestStoreInitialVersion(t *testing.T) {
+	store, err := LoadStore(dbm.NewMemDB(), types.CommitID{}, 5)
+	require.NoError(t, err)
+
+	store.Set([]byte("a"), []byte("1"))
+	require.Equal(t, int64(5), store.Commit().Version)
+	require.Equal(t, int64(6), store.Commit().Version)
+}
+
+func TestStoreQuery(t *testing.T) {
+	store := newStore(t, dbm.NewMemDB())
+	store.Set([]byte("a"), []byte("1"))
+	store.Set([]byte("b"), []byte("1"))
+	cid1 := store.Commit()
+	store.Set([]byte("a"), []byte("2"))
+	store.Commit()
+
+	// the latest version but one is queried by default
+	res := store.Query(abci.RequestQuery{Path: "/key", Data: []byte("a")})
+	require.Equal(t, int64(1), res.Height)
+	require.Equal(t, []byte("1"), res.Value)
+
+	res = store.Query(abci.RequestQuery{Path: "/key", Data: []byte("a"), Height: 2})
+	require.Equal(t, []byte("2"), res.Value)
+
+	res = store.Query(abci.RequestQuery{Path: "/key", Data: []byte("b"), Height: 1, Prove: true})
+	require.Equal(t, []byte("1"), res.Value)
+	require.Len(t, res.ProofOps.Ops, 1)
+	op, err := types.CommitmentOpDecoder(res.ProofOps.Ops[0])
+	require.NoError(t, err)
+	root, err := op.Run([][]byte{[]byte("1")})
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{cid1.Hash}, root)
+
+	res = store.Query(abci.RequestQuery{Path: "/key", Data: []byte("c"), Height: 1, Prove: true})
+	require.NotZero(t, res.Code)
+
+	res = store.Query(abci.RequestQuery{Path: "/key", Data: []byte("a"), Height: 3})
+	require.Nil(t, res.Value)
+	require.NotEmpty(t, res.Log)
+}
+
+func TestStoreSnapshot(t *testing.T) {
+	source := newStore(t, dbm.NewMemDB())
+	source.Set([]byte("a"), []byte("1"))
+	source.Set([]byte("b"), []byte("1"))
+	source.Commit()
+	source.Set([]byte("c"), []byte("2"))
+	cid := source.Commit()
+
+	exporter, err := source.SnapshotExporter(cid.Version)
+	require.NoError(t, err)
+	defer exporter.Close()
+
+	target := newStore(t, dbm.NewMemDB())
+	importer, err := target.SnapshotImporter(cid.Version)
+	require.NoError(t, err)
+	defer importer.Close()
+
+	for {
+		node, err := exporter.Next()
+		if err == types.ErrExportDone {
+			break
+		}
+		require.NoError(t, err)
+		require.NoError(t, importer.Add(node))
+	}
+	require.NoError(t, importer.Commit())
+	require.Equal(t, cid, target.LastCommitID())
+	require.Equal(t, []byte("2"), target.Get([]byte("c")))
+
+	_, err = target.SnapshotImporter(cid.Version)
+	require.Error(t, err)
+}
@@ -0,0 +1,84 @@
+package iavl
+
+import (
+	"github.com/cosmos/iavl"
+
+	"cosmossdk.io/store/types"
+)
+
+var _ types.CommitmentStore = (*Store)(nil)
+
+// GetImmutableKVStore implements types.CommitmentStore, see GetImmutable.
+func (st *Store) GetImmutableKVStore(version int64) (types.KVStore, error) {
+	store, err := st.GetImmutable(version)
+	if err != nil {
+		return nil, err
+	}
+	return store, nil
+}
+
+// SnapshotExporter implements types.CommitmentStore, exporting the nodes of the tree at the
+// given version in the order of Export.
+func (st *Store) SnapshotExporter(version int64) (types.CommitmentExporter, error) {
+	exporter, err := st.Export(version)
+	if err != nil {
+		return nil, err
+	}
+	return commitmentExporter{exporter}, nil
+}
+
+// SnapshotImporter implements types.CommitmentStore, importing the nodes of a tree with
+// Import.
+func (st *Store) SnapshotImporter(version int64) (types.CommitmentImporter, error) {
+	importer, err := st.Import(version)
+	if err != nil {
+		return nil, err
+	}
+	return commitmentImporter{importer}, nil
+}
+
+// commitmentExporter adapts an iavl.Exporter to types.CommitmentExporter.
+type commitmentExporter struct {
+	exporter *iavl.Exporter
+}
+
+func (e commitmentExporter) Next() (*types.CommitmentNode, error) {
+	node, err := e.exporter.Next()
+	if err == iavl.ErrorExportDone {
+		return nil, types.ErrExportDone
+	} else if err != nil {
+		return nil, err
+	}
+	return &types.CommitmentNode{
+		Key:     node.Key,
+		Value:   node.Value,
+		Version: node.Version,
+		Height:  node.Height,
+	}, nil
+}
+
+func (e commitmentExporter) Close() {
+	e.exporter.Close()
+}
+
+// commitmentImporter adapts an iavl.Importer to types.CommitmentImporter.
+type commitmentImporter struct {
+	importer *iavl.Importer
+}
+
+func (i commitmentImporter) Add(node *types.CommitmentNode) error {
+	return i.importer.Add(&iavl.ExportNode{
+		Key:     node.Key,
+		Value:   node.Value,
+		Version: node.Version,
+		Height:  node.Height,
+	})
+}
+
+func (i commitmentImporter) Commit() error {
+	return i.importer.Commit()
+}
+
+func (i commitmentImporter) Close() {
+	i.importer.Close()
+}
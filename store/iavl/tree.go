@@ -3,7 +3,7 @@ package iavl
 import (
 	"fmt"
 
-	"cosmossdk.io/store/types"
+	dbm "github.com/cosmos/cosmos-db"
 	"github.com/cosmos/iavl"
 )
 
@@ -32,7 +32,7 @@ type (
 		GetVersioned(key []byte, version int64) ([]byte, error)
 		GetImmutable(version int64) (*iavl.ImmutableTree, error)
 		SetInitialVersion(version uint64)
-		Iterator(start, end []byte, ascending bool) (types.Iterator, error)
+		Iterator(start, end []byte, ascending bool) (dbm.Iterator, error)
 		AvailableVersions() []int
 		LoadVersionForOverwriting(targetVersion int64) (int64, error)
 		LazyLoadVersionForOverwriting(targetVersion int64) (int64, error)
@@ -1,4 +1,4 @@
-package internal
+package btree
 
 import (
 	"bytes"
@@ -8,13 +8,13 @@ import (
 	"github.com/tidwall/btree"
 )
 
-var _ types.Iterator = (*memIterator)(nil)
+var _ types.Iterator = (*memIterator[[]byte])(nil)
 
 // memIterator iterates over iterKVCache items.
 // if value is nil, means it was deleted.
 // Implements Iterator.
-type memIterator struct {
-	iter btree.IterG[item]
+type memIterator[V any] struct {
+	iter btree.IterG[item[V]]
 
 	start     []byte
 	end       []byte
@@ -22,18 +22,21 @@ type memIterator struct {
 	valid     bool
 }
 
-func newMemIterator(start, end []byte, items BTree, ascending bool) *memIterator {
+func newMemIterator[V any](start, end []byte, items BTree[V], ascending bool) *memIterator[V] {
 	iter := items.tree.Iter()
-	var valid bool
+	var (
+		valid bool
+		empty V
+	)
 	if ascending {
 		if start != nil {
-			valid = iter.Seek(newItem(start, nil))
+			valid = iter.Seek(newItem(start, empty))
 		} else {
 			valid = iter.First()
 		}
 	} else {
 		if end != nil {
-			valid = iter.Seek(newItem(end, nil))
+			valid = iter.Seek(newItem(end, empty))
 			if !valid {
 				valid = iter.Last()
 			} else {
@@ -45,7 +48,7 @@ func newMemIterator(start, end []byte, items BTree, ascending bool) *memIterator
 		}
 	}
 
-	mi := &memIterator{
+	mi := &memIterator[V]{
 		iter:      iter,
 		start:     start,
 		end:       end,
@@ -60,27 +63,27 @@ func newMemIterator(start, end []byte, items BTree, ascending bool) *memIterator
 	return mi
 }
 
-func (mi *memIterator) Domain() (start, end []byte) {
+func (mi *memIterator[V]) Domain() (start, end []byte) {
 	return mi.start, mi.end
 }
 
-func (mi *memIterator) Close() error {
+func (mi *memIterator[V]) Close() error {
 	mi.iter.Release()
 	return nil
 }
 
-func (mi *memIterator) Error() error {
+func (mi *memIterator[V]) Error() error {
 	if !mi.Valid() {
 		return errors.New("invalid memIterator")
 	}
 	return nil
 }
 
-func (mi *memIterator) Valid() bool {
+func (mi *memIterator[V]) Valid() bool {
 	return mi.valid
 }
 
-func (mi *memIterator) Next() {
+func (mi *memIterator[V]) Next() {
 	mi.assertValid()
 
 	if mi.ascending {
@@ -94,7 +97,7 @@ func (mi *memIterator) Next() {
 	}
 }
 
-func (mi *memIterator) keyInRange(key []byte) bool {
+func (mi *memIterator[V]) keyInRange(key []byte) bool {
 	if mi.ascending && mi.end != nil && bytes.Compare(key, mi.end) >= 0 {
 		return false
 	}
@@ -104,15 +107,15 @@ func (mi *memIterator) keyInRange(key []byte) bool {
 	return true
 }
 
-func (mi *memIterator) Key() []byte {
+func (mi *memIterator[V]) Key() []byte {
 	return mi.iter.Item().key
 }
 
-func (mi *memIterator) Value() []byte {
+func (mi *memIterator[V]) Value() V {
 	return mi.iter.Item().value
 }
 
-func (mi *memIterator) assertValid() {
+func (mi *memIterator[V]) assertValid() {
 	if err := mi.Error(); err != nil {
 		panic(err)
 	}
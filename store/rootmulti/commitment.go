@@ -0,0 +1,119 @@
+package rootmulti
+
+import (
+	"fmt"
+
+	"github.com/cometbft/cometbft/crypto/merkle"
+	dbm "github.com/cosmos/cosmos-db"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/flat"
+	"cosmossdk.io/store/iavl"
+	"cosmossdk.io/store/metrics"
+	"cosmossdk.io/store/types"
+)
+
+// CommitmentBackend builds the persistent stores of a store type, see SetCommitmentBackend.
+type CommitmentBackend struct {
+	// LoadStore loads the store of a key at the given commit from its database.
+	LoadStore func(db dbm.DB, key types.StoreKey, id types.CommitID, opts CommitmentOptions) (types.CommitmentStore, error)
+
+	// ProofOps are the decoders of the operations of the proofs returned by the queries of
+	// the stores, by operation type.
+	ProofOps map[string]merkle.OpDecoder
+}
+
+// CommitmentOptions are the options of the root multistore given to a CommitmentBackend.
+type CommitmentOptions struct {
+	Logger  log.Logger
+	Metrics metrics.StoreMetrics
+	// InitialVersion is the version of the first commit of a store added by an upgrade.
+	InitialVersion uint64
+	LazyLoading    bool
+
+	IAVLCacheSize       int
+	IAVLDisableFastNode bool
+}
+
+// DefaultCommitmentBackends returns the commitment backends of a new Store: the IAVL tree
+// for StoreTypeIAVL and the flat reference backend for StoreTypeFlat.
+func DefaultCommitmentBackends() map[types.StoreType]CommitmentBackend {
+	return map[types.StoreType]CommitmentBackend{
+		types.StoreTypeIAVL: IAVLCommitmentBackend(),
+		types.StoreTypeFlat: FlatCommitmentBackend(),
+	}
+}
+
+// IAVLCommitmentBackend returns the commitment backend of the IAVL stores.
+func IAVLCommitmentBackend() CommitmentBackend {
+	return CommitmentBackend{
+		LoadStore: func(db dbm.DB, key types.StoreKey, id types.CommitID, opts CommitmentOptions) (types.CommitmentStore, error) {
+			var (
+				store types.CommitKVStore
+				err   error
+			)
+			if opts.InitialVersion == 0 {
+				store, err = iavl.LoadStore(db, opts.Logger, key, id, opts.LazyLoading, opts.IAVLCacheSize, opts.IAVLDisableFastNode, opts.Metrics)
+			} else {
+				store, err = iavl.LoadStoreWithInitialVersion(db, opts.Logger, key, id, opts.LazyLoading, opts.InitialVersion, opts.IAVLCacheSize, opts.IAVLDisableFastNode, opts.Metrics)
+			}
+			if err != nil {
+				return nil, err
+			}
+
+			return store.(*iavl.Store), nil
+		},
+		ProofOps: map[string]merkle.OpDecoder{
+			types.ProofOpIAVLCommitment: types.CommitmentOpDecoder,
+		},
+	}
+}
+
+// FlatCommitmentBackend returns the commitment backend of the flat stores.
+func FlatCommitmentBackend() CommitmentBackend {
+	return CommitmentBackend{
+		LoadStore: func(db dbm.DB, _ types.StoreKey, id types.CommitID, opts CommitmentOptions) (types.CommitmentStore, error) {
+			return flat.LoadStore(db, id, int64(opts.InitialVersion))
+		},
+		ProofOps: map[string]merkle.OpDecoder{
+			types.ProofOpSimpleMerkleCommitment: types.CommitmentOpDecoder,
+		},
+	}
+}
+
+// SetCommitmentBackend sets the backend building the stores of the given type, replacing
+// the default one if any. It must be called before the stores are loaded. The stores of the
+// transient, memory, object and DB types are not commitment stores.
+func (rs *Store) SetCommitmentBackend(typ types.StoreType, backend CommitmentBackend) {
+	switch typ {
+	case types.StoreTypeMulti, types.StoreTypeDB, types.StoreTypeTransient, types.StoreTypeMemory, types.StoreTypeObject:
+		panic(fmt.Sprintf("%v cannot have a commitment backend", typ))
+	}
+
+	rs.commitmentBackends[typ] = backend
+}
+
+// isCommitmentStoreType returns whether the stores of the given type are built by a
+// commitment backend.
+func (rs *Store) isCommitmentStoreType(typ types.StoreType) bool {
+	_, ok := rs.commitmentBackends[typ]
+	return ok
+}
+
+// commitmentStore returns the commitment store mounted for the given key, unwrapped from
+// the inter-block cache.
+func (rs *Store) commitmentStore(key types.StoreKey) (types.CommitmentStore, bool) {
+	store, ok := rs.GetCommitStore(key).(types.CommitmentStore)
+	return store, ok
+}
+
+func (rs *Store) commitmentOptions(params storeParams) CommitmentOptions {
+	return CommitmentOptions{
+		Logger:              rs.logger,
+		Metrics:             rs.metrics,
+		InitialVersion:      params.initialVersion,
+		LazyLoading:         rs.lazyLoading,
+		IAVLCacheSize:       rs.iavlCacheSize,
+		IAVLDisableFastNode: rs.iavlDisableFastNode,
+	}
+}
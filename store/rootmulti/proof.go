@@ -3,7 +3,7 @@ package rootmulti
 import (
 	"github.com/cometbft/cometbft/crypto/merkle"
 
-	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/store/types"
 )
 
 // RequireProof returns whether proof is required for the subpath.
@@ -17,11 +17,31 @@ func RequireProof(subpath string) bool {
 
 //-----------------------------------------------------------------------------
 
-// XXX: This should be managed by the rootMultiStore which may want to register
-// more proof ops?
+// DefaultProofRuntime returns the proof runtime verifying the query proofs of the stores
+// built by DefaultCommitmentBackends.
 func DefaultProofRuntime() (prt *merkle.ProofRuntime) {
-	prt = merkle.NewProofRuntime()
-	prt.RegisterOpDecoder(storetypes.ProofOpIAVLCommitment, storetypes.CommitmentOpDecoder)
-	prt.RegisterOpDecoder(storetypes.ProofOpSimpleMerkleCommitment, storetypes.CommitmentOpDecoder)
-	return
+	return proofRuntime(DefaultCommitmentBackends())
+}
+
+// ProofRuntime returns the proof runtime verifying the query proofs of the stores, with the
+// proof operations of the commitment backends set on the store.
+func (rs *Store) ProofRuntime() *merkle.ProofRuntime {
+	return proofRuntime(rs.commitmentBackends)
+}
+
+func proofRuntime(backends map[types.StoreType]CommitmentBackend) *merkle.ProofRuntime {
+	prt := merkle.NewProofRuntime()
+	// the multistore proves the hash of a store with a simple merkle proof
+	decoders := map[string]merkle.OpDecoder{
+		types.ProofOpSimpleMerkleCommitment: types.CommitmentOpDecoder,
+	}
+	for _, backend := range backends {
+		for typ, decoder := range backend.ProofOps {
+			decoders[typ] = decoder
+		}
+	}
+	for typ, decoder := range decoders {
+		prt.RegisterOpDecoder(typ, decoder)
+	}
+	return prt
 }
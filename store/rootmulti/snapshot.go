@@ -0,0 +1,304 @@
+package rootmulti
+
+import (
+	"io"
+	"sync"
+
+	errorsmod "cosmossdk.io/errors"
+	protoio "github.com/cosmos/gogoproto/io"
+
+	snapshottypes "cosmossdk.io/store/snapshots/types"
+	"cosmossdk.io/store/types"
+)
+
+const (
+	// snapshotSegmentSize is the maximum number of IAVL nodes of a store written in a row in
+	// snapshottypes.FormatParallel. Do not change without a new snapshot format (must be uniform
+	// across nodes).
+	snapshotSegmentSize = 10000
+
+	// snapshotSegmentBuffer is the number of segments each store exporter or importer may
+	// buffer ahead of the snapshot stream.
+	snapshotSegmentBuffer = 4
+)
+
+// exportSegment is a segment of IAVL nodes exported from a store.
+type exportSegment struct {
+	items []*snapshottypes.SnapshotIAVLItem
+	err   error
+}
+
+// snapshotParallel writes a snapshot in snapshottypes.FormatParallel. All stores are exported
+// concurrently, and their nodes are written in segments of at most snapshotSegmentSize nodes,
+// taking one segment of each store in turn (in store name order) until all stores are exhausted.
+// Every segment, including the single empty segment of an empty store, is preceded by a
+// SnapshotStoreItem. The interleaving only depends on the store contents, so the output is
+// deterministic.
+func (rs *Store) snapshotParallel(height uint64, stores []namedStore, protoWriter protoio.Writer) error {
+	done := make(chan struct{})
+	var wg sync.WaitGroup
+	defer func() {
+		close(done)
+		wg.Wait()
+	}()
+
+	segments := make([]chan exportSegment, len(stores))
+	for i, store := range stores {
+		segments[i] = make(chan exportSegment, snapshotSegmentBuffer)
+		wg.Add(1)
+		go func(store namedStore, ch chan<- exportSegment) {
+			defer wg.Done()
+			defer close(ch)
+			rs.exportStore(height, store, ch, done)
+		}(store, segments[i])
+	}
+
+	active := make([]int, len(stores))
+	for i := range stores {
+		active[i] = i
+	}
+	for len(active) > 0 {
+		remaining := active[:0]
+		for _, i := range active {
+			segment, ok := <-segments[i]
+			if !ok {
+				continue
+			}
+			if segment.err != nil {
+				rs.logger.Error("snapshot failed; exporter error", "store", stores[i].name, "err", segment.err)
+				return segment.err
+			}
+			remaining = append(remaining, i)
+
+			err := protoWriter.WriteMsg(&snapshottypes.SnapshotItem{
+				Item: &snapshottypes.SnapshotItem_Store{
+					Store: &snapshottypes.SnapshotStoreItem{
+						Name: stores[i].name,
+					},
+				},
+			})
+			if err != nil {
+				rs.logger.Error("snapshot failed; item store write failed", "store", stores[i].name, "err", err)
+				return err
+			}
+			for _, item := range segment.items {
+				err = protoWriter.WriteMsg(&snapshottypes.SnapshotItem{
+					Item: &snapshottypes.SnapshotItem_IAVL{IAVL: item},
+				})
+				if err != nil {
+					return err
+				}
+			}
+		}
+		active = remaining
+	}
+
+	return nil
+}
+
+// exportStore exports the nodes of a store into segments sent to ch, until the export is done,
+// fails, or done is closed.
+func (rs *Store) exportStore(height uint64, store namedStore, ch chan<- exportSegment, done <-chan struct{}) {
+	send := func(segment exportSegment) bool {
+		select {
+		case ch <- segment:
+			return true
+		case <-done:
+			return false
+		}
+	}
+
+	rs.logger.Debug("starting snapshot", "store", store.name, "height", height)
+	exporter, err := store.SnapshotExporter(int64(height))
+	if err != nil {
+		send(exportSegment{err: err})
+		return
+	}
+	defer exporter.Close()
+
+	var (
+		items     []*snapshottypes.SnapshotIAVLItem
+		nodeCount int
+		sent      bool
+	)
+	for {
+		node, err := exporter.Next()
+		if err == types.ErrExportDone {
+			break
+		} else if err != nil {
+			send(exportSegment{err: err})
+			return
+		}
+		items = append(items, &snapshottypes.SnapshotIAVLItem{
+			Key:     node.Key,
+			Value:   node.Value,
+			Height:  int32(node.Height),
+			Version: node.Version,
+		})
+		nodeCount++
+		if len(items) == snapshotSegmentSize {
+			if !send(exportSegment{items: items}) {
+				return
+			}
+			items, sent = nil, true
+		}
+	}
+	if len(items) > 0 || !sent {
+		if !send(exportSegment{items: items}) {
+			return
+		}
+	}
+	rs.logger.Debug("snapshot Done", "store", store.name, "nodeCount", nodeCount)
+}
+
+// storeImporter imports the segments of a store received from a snapshot in
+// snapshottypes.FormatParallel on its own goroutine.
+type storeImporter struct {
+	name     string
+	importer types.CommitmentImporter
+	segments chan []*types.CommitmentNode
+	done     chan struct{}
+	aborted  bool
+	err      error
+}
+
+func newStoreImporter(name string, importer types.CommitmentImporter) *storeImporter {
+	si := &storeImporter{
+		name:     name,
+		importer: importer,
+		segments: make(chan []*types.CommitmentNode, snapshotSegmentBuffer),
+		done:     make(chan struct{}),
+	}
+	go si.run()
+	return si
+}
+
+// run adds the received nodes to the importer and commits it once all segments were received,
+// unless the restore was aborted. After a failure, the remaining segments are drained so that
+// the snapshot reader never blocks.
+func (si *storeImporter) run() {
+	defer close(si.done)
+	for segment := range si.segments {
+		if si.err != nil {
+			continue
+		}
+		for _, node := range segment {
+			if err := si.importer.Add(node); err != nil {
+				si.err = errorsmod.Wrap(err, "IAVL node import failed")
+				break
+			}
+		}
+	}
+	if si.err == nil && !si.aborted {
+		if err := si.importer.Commit(); err != nil {
+			si.err = errorsmod.Wrap(err, "IAVL commit failed")
+		}
+	}
+}
+
+// failed returns whether the import has failed, without waiting for it to complete.
+func (si *storeImporter) failed() bool {
+	select {
+	case <-si.done:
+		return si.err != nil
+	default:
+		return false
+	}
+}
+
+// restoreParallel imports a snapshot in snapshottypes.FormatParallel, importing each store on its
+// own goroutine as the segments are read from the stream.
+func (rs *Store) restoreParallel(height uint64, protoReader protoio.Reader) (snapshottypes.SnapshotItem, error) {
+	var (
+		importers []*storeImporter
+		byName    = map[string]*storeImporter{}
+		current   *storeImporter
+		segment   []*types.CommitmentNode
+	)
+	// finish flushes the pending segment, and waits for all imports to complete. If abort is
+	// set, the imports are not committed.
+	finish := func(abort bool) error {
+		if current != nil && len(segment) > 0 && !abort {
+			current.segments <- segment
+		}
+		for _, si := range importers {
+			si.aborted = abort
+			close(si.segments)
+		}
+		var err error
+		for _, si := range importers {
+			<-si.done
+			si.importer.Close()
+			if si.err != nil && err == nil {
+				err = errorsmod.Wrapf(si.err, "store %q", si.name)
+			}
+		}
+		return err
+	}
+	fail := func(err error) (snapshottypes.SnapshotItem, error) {
+		_ = finish(true)
+		return snapshottypes.SnapshotItem{}, err
+	}
+
+	var snapshotItem snapshottypes.SnapshotItem
+loop:
+	for {
+		snapshotItem = snapshottypes.SnapshotItem{}
+		err := protoReader.ReadMsg(&snapshotItem)
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return fail(errorsmod.Wrap(err, "invalid protobuf message"))
+		}
+
+		switch item := snapshotItem.Item.(type) {
+		case *snapshottypes.SnapshotItem_Store:
+			if current != nil && len(segment) > 0 {
+				current.segments <- segment
+			}
+			segment = nil
+
+			current = byName[item.Store.Name]
+			if current == nil {
+				store, ok := rs.GetStoreByName(item.Store.Name).(types.CommitmentStore)
+				if !ok || store == nil {
+					return fail(errorsmod.Wrapf(types.ErrLogic, "cannot import into non-commitment store %q", item.Store.Name))
+				}
+				importer, err := store.SnapshotImporter(int64(height))
+				if err != nil {
+					return fail(errorsmod.Wrap(err, "import failed"))
+				}
+				rs.logger.Debug("restoring snapshot", "store", item.Store.Name)
+				current = newStoreImporter(item.Store.Name, importer)
+				importers = append(importers, current)
+				byName[item.Store.Name] = current
+			}
+			if current.failed() {
+				return fail(errorsmod.Wrapf(current.err, "store %q", current.name))
+			}
+
+		case *snapshottypes.SnapshotItem_IAVL:
+			if current == nil {
+				rs.logger.Error("failed to restore; received IAVL node item before store item")
+				return fail(errorsmod.Wrap(types.ErrLogic, "received IAVL node item before store item"))
+			}
+			node, err := commitmentNodeFromItem(item.IAVL)
+			if err != nil {
+				return fail(err)
+			}
+			segment = append(segment, node)
+			if len(segment) == snapshotSegmentSize {
+				current.segments <- segment
+				segment = nil
+			}
+
+		default:
+			break loop
+		}
+	}
+
+	if err := finish(false); err != nil {
+		return snapshottypes.SnapshotItem{}, err
+	}
+	return snapshotItem, nil
+}
@@ -123,7 +123,7 @@ func TestMultistoreSnapshot_Checksum(t *testing.T) {
 		format      uint32
 		chunkHashes []string
 	}{
-		{1, []string{
+		{snapshottypes.FormatSequential, []string{
 			"503e5b51b657055b77e88169fadae543619368744ad15f1de0736c0a20482f24",
 			"e1a0daaa738eeb43e778aefd2805e3dd720798288a410b06da4b8459c4d8f72e",
 			"aa048b4ee0f484965d7b3b06822cf0772cdcaad02f3b1b9055e69f2cb365ef3c",
@@ -131,6 +131,14 @@ func TestMultistoreSnapshot_Checksum(t *testing.T) {
 			"a4a864e6c02c9fca5837ec80dc84f650b25276ed7e4820cf7516ced9f9901b86",
 			"980925390cc50f14998ecb1e87de719ca9dd7e72f5fefbe445397bf670f36c31",
 		}},
+		{snapshottypes.FormatParallel, []string{
+			"707c55450c5812009c4b671eccc4ea1af2fae1dda47037a663b6769881c63b52",
+			"84ebb1d4c94e14a8fca911417f89a1a366a28ea7ba5c2351d11243bd8ad55904",
+			"2d121c012cb39bb0c2c432749753d3f2f77849529ef6f1503abf9d20842e3917",
+			"9bfe3f7d7a2aa677adfe0fd9d80673b5bea31c527849a72885e9561f573847f3",
+			"9b7303caaa021529a30be80a15c449110ea6194090d5e307f02fc4c60ae7da18",
+			"9d7f5a4fe89dab55994b8bb44f6daf3dd1ed8f9585561faa775ad4b1e2f4fab4",
+		}},
 	}
 	for _, tc := range testcases {
 		tc := tc
@@ -140,7 +148,7 @@ func TestMultistoreSnapshot_Checksum(t *testing.T) {
 				streamWriter := snapshots.NewStreamWriter(ch)
 				defer streamWriter.Close()
 				require.NotNil(t, streamWriter)
-				err := store.Snapshot(version, streamWriter)
+				err := store.SnapshotWithFormat(version, tc.format, streamWriter)
 				require.NoError(t, err)
 			}()
 			hashes := []string{}
@@ -180,10 +188,64 @@ func TestMultistoreSnapshot_Errors(t *testing.T) {
 }
 
 func TestMultistoreSnapshotRestore(t *testing.T) {
-	source := newMultiStoreWithMixedMountsAndBasicData(dbm.NewMemDB())
-	target := newMultiStoreWithMixedMounts(dbm.NewMemDB())
+	for _, format := range snapshottypes.SupportedFormats {
+		format := format
+		t.Run(fmt.Sprintf("Format %v", format), func(t *testing.T) {
+			source := newMultiStoreWithMixedMountsAndBasicData(dbm.NewMemDB())
+			target := newMultiStoreWithMixedMounts(dbm.NewMemDB())
+			version := uint64(source.LastCommitID().Version)
+			require.EqualValues(t, 3, version)
+			testSnapshotRestore(t, source, target, version, format)
+		})
+	}
+}
+
+func TestMultistoreSnapshotRestore_Segments(t *testing.T) {
+	// Each store holds more nodes than a segment, so the stores are interleaved in the stream.
+	source := newMultiStoreWithGeneratedData(dbm.NewMemDB(), 3, 12000)
+	target := rootmulti.NewStore(dbm.NewMemDB(), log.NewNopLogger(), metrics.NewNoOpMetrics())
+	for _, key := range source.StoreKeysByName() {
+		target.MountStoreWithDB(key, types.StoreTypeIAVL, nil)
+	}
+	require.NoError(t, target.LoadLatestVersion())
 	version := uint64(source.LastCommitID().Version)
-	require.EqualValues(t, 3, version)
+	testSnapshotRestore(t, source, target, version, snapshottypes.FormatParallel)
+}
+
+func TestMultistoreSnapshotRestore_Flat(t *testing.T) {
+	newStore := func() *rootmulti.Store {
+		store := rootmulti.NewStore(dbm.NewMemDB(), log.NewNopLogger(), metrics.NewNoOpMetrics())
+		store.MountStoreWithDB(types.NewKVStoreKey("iavl"), types.StoreTypeIAVL, nil)
+		store.MountStoreWithDB(types.NewKVStoreKey("flat"), types.StoreTypeFlat, nil)
+		if err := store.LoadLatestVersion(); err != nil {
+			panic(err)
+		}
+		return store
+	}
+
+	for _, format := range snapshottypes.SupportedFormats {
+		format := format
+		t.Run(fmt.Sprintf("Format %v", format), func(t *testing.T) {
+			source := newStore()
+			for i := 0; i < 3; i++ {
+				for _, key := range source.StoreKeysByName() {
+					source.GetKVStore(key).Set([]byte(fmt.Sprintf("key%v", i)), []byte(fmt.Sprintf("value%v", i)))
+				}
+				source.Commit()
+			}
+			testSnapshotRestore(t, source, newStore(), uint64(source.LastCommitID().Version), format)
+		})
+	}
+}
+
+func TestMultistoreRestore_UnknownFormat(t *testing.T) {
+	target := newMultiStoreWithMixedMounts(dbm.NewMemDB())
+	_, err := target.Restore(3, snapshottypes.CurrentFormat+1, nil)
+	require.ErrorIs(t, err, snapshottypes.ErrUnknownFormat)
+}
+
+func testSnapshotRestore(t *testing.T, source, target *rootmulti.Store, version uint64, format uint32) {
+	t.Helper()
 	dummyExtensionItem := snapshottypes.SnapshotItem{
 		Item: &snapshottypes.SnapshotItem_Extension{
 			Extension: &snapshottypes.SnapshotExtensionMeta{
@@ -198,7 +260,7 @@ func TestMultistoreSnapshotRestore(t *testing.T) {
 		streamWriter := snapshots.NewStreamWriter(chunks)
 		require.NotNil(t, streamWriter)
 		defer streamWriter.Close()
-		err := source.Snapshot(version, streamWriter)
+		err := source.SnapshotWithFormat(version, format, streamWriter)
 		require.NoError(t, err)
 		// write an extension metadata
 		err = streamWriter.WriteMsg(&dummyExtensionItem)
@@ -207,7 +269,7 @@ func TestMultistoreSnapshotRestore(t *testing.T) {
 
 	streamReader, err := snapshots.NewStreamReader(chunks)
 	require.NoError(t, err)
-	nextItem, err := target.Restore(version, snapshottypes.CurrentFormat, streamReader)
+	nextItem, err := target.Restore(version, format, streamReader)
 	require.NoError(t, err)
 	require.Equal(t, *dummyExtensionItem.GetExtension(), *nextItem.GetExtension())
 
@@ -18,6 +18,7 @@ import (
 	iavltree "github.com/cosmos/iavl"
 
 	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/archive"
 	"cosmossdk.io/store/cachemulti"
 	"cosmossdk.io/store/dbadapter"
 	"cosmossdk.io/store/iavl"
@@ -63,7 +64,7 @@ type Store struct {
 	iavlCacheSize       int
 	iavlDisableFastNode bool
 	storesParams        map[types.StoreKey]storeParams
-	stores              map[types.StoreKey]types.CommitKVStore
+	stores              map[types.StoreKey]types.CommitStore
 	keysByName          map[string]types.StoreKey
 	lazyLoading         bool
 	initialVersion      int64
@@ -75,6 +76,8 @@ type Store struct {
 	listeners           map[types.StoreKey]*types.MemoryListener
 	metrics             metrics.StoreMetrics
 	commitHeader        cmtproto.Header
+	archive             *archive.Store
+	commitmentBackends  map[types.StoreType]CommitmentBackend
 }
 
 var (
@@ -93,12 +96,13 @@ func NewStore(db dbm.DB, logger log.Logger, metricGatherer metrics.StoreMetrics)
 		iavlCacheSize:       iavl.DefaultIAVLCacheSize,
 		iavlDisableFastNode: iavlDisablefastNodeDefault,
 		storesParams:        make(map[types.StoreKey]storeParams),
-		stores:              make(map[types.StoreKey]types.CommitKVStore),
+		stores:              make(map[types.StoreKey]types.CommitStore),
 		keysByName:          make(map[string]types.StoreKey),
 		listeners:           make(map[types.StoreKey]*types.MemoryListener),
 		removalMap:          make(map[types.StoreKey]bool),
 		pruningManager:      pruning.NewManager(db, logger),
 		metrics:             metricGatherer,
+		commitmentBackends:  DefaultCommitmentBackends(),
 	}
 }
 
@@ -138,6 +142,12 @@ func (rs *Store) SetLazyLoading(lazyLoading bool) {
 	rs.lazyLoading = lazyLoading
 }
 
+// SetArchive sets the archive store CacheMultiStoreWithVersion falls back to, for the IAVL
+// stores whose version has been pruned.
+func (rs *Store) SetArchive(archiveStore *archive.Store) {
+	rs.archive = archiveStore
+}
+
 // GetStoreType implements Store.
 func (rs *Store) GetStoreType() types.StoreType {
 	return types.StoreTypeMulti
@@ -161,15 +171,9 @@ func (rs *Store) MountStoreWithDB(key types.StoreKey, typ types.StoreType, db db
 // GetCommitStore returns a mounted CommitStore for a given StoreKey. If the
 // store is wrapped in an inter-block cache, it will be unwrapped before returning.
 func (rs *Store) GetCommitStore(key types.StoreKey) types.CommitStore {
-	return rs.GetCommitKVStore(key)
-}
-
-// GetCommitKVStore returns a mounted CommitKVStore for a given StoreKey. If the
-// store is wrapped in an inter-block cache, it will be unwrapped before returning.
-func (rs *Store) GetCommitKVStore(key types.StoreKey) types.CommitKVStore {
 	// If the Store has an inter-block cache, first attempt to lookup and unwrap
 	// the underlying CommitKVStore by StoreKey. If it does not exist, fallback to
-	// the main mapping of CommitKVStores.
+	// the main mapping of CommitStores.
 	if rs.interBlockCache != nil {
 		if store := rs.interBlockCache.Unwrap(key); store != nil {
 			return store
@@ -179,6 +183,14 @@ func (rs *Store) GetCommitKVStore(key types.StoreKey) types.CommitKVStore {
 	return rs.stores[key]
 }
 
+// GetCommitKVStore returns a mounted CommitKVStore for a given StoreKey. If the
+// store is wrapped in an inter-block cache, it will be unwrapped before returning.
+// It returns nil if the store is not a CommitKVStore, as the object stores.
+func (rs *Store) GetCommitKVStore(key types.StoreKey) types.CommitKVStore {
+	store, _ := rs.GetCommitStore(key).(types.CommitKVStore)
+	return store
+}
+
 // StoreKeysByName returns mapping storeNames -> StoreKeys
 func (rs *Store) StoreKeysByName() map[string]types.StoreKey {
 	return rs.keysByName
@@ -227,7 +239,7 @@ func (rs *Store) loadVersion(ver int64, upgrades *types.StoreUpgrades) error {
 	}
 
 	// load each Store (note this doesn't panic on unmounted keys now)
-	newStores := make(map[types.StoreKey]types.CommitKVStore)
+	newStores := make(map[types.StoreKey]types.CommitStore)
 
 	storesKeys := make([]types.StoreKey, 0, len(rs.storesParams))
 
@@ -252,7 +264,7 @@ func (rs *Store) loadVersion(ver int64, upgrades *types.StoreUpgrades) error {
 		// If it has been added, set the initial version
 		if upgrades.IsAdded(key.Name()) || upgrades.RenamedFrom(key.Name()) != "" {
 			storeParams.initialVersion = uint64(ver) + 1
-		} else if commitID.Version != ver && storeParams.typ == types.StoreTypeIAVL {
+		} else if commitID.Version != ver && rs.isCommitmentStoreType(storeParams.typ) {
 			return fmt.Errorf("version of store %s mismatch root store's version; expected %d got %d; new stores should be added using StoreUpgrades", key.Name(), ver, commitID.Version)
 		}
 
@@ -293,6 +305,12 @@ func (rs *Store) loadVersion(ver int64, upgrades *types.StoreUpgrades) error {
 		}
 	}
 
+	if upgrades.HasPrefixUpgrades() {
+		if err := rs.applyPrefixUpgrades(newStores, upgrades); err != nil {
+			return err
+		}
+	}
+
 	rs.lastCommitInfo = cInfo
 	rs.stores = newStores
 
@@ -506,7 +524,7 @@ func (rs *Store) WorkingHash() []byte {
 	for _, key := range storeKeys {
 		store := rs.stores[key]
 
-		if store.GetStoreType() != types.StoreTypeIAVL {
+		if !rs.isCommitmentStoreType(store.GetStoreType()) {
 			continue
 		}
 
@@ -543,11 +561,11 @@ func (rs *Store) CacheWrapWithTrace(_ io.Writer, _ types.TraceContext) types.Cac
 func (rs *Store) CacheMultiStore() types.CacheMultiStore {
 	stores := make(map[types.StoreKey]types.CacheWrapper)
 	for k, v := range rs.stores {
-		store := types.KVStore(v)
+		var store types.CacheWrapper = v
 		// Wire the listenkv.Store to allow listeners to observe the writes from the cache store,
 		// set same listeners on cache store will observe duplicated writes.
-		if rs.ListeningEnabled(k) {
-			store = listenkv.NewStore(store, k, rs.listeners[k])
+		if kvStore, ok := v.(types.KVStore); ok && rs.ListeningEnabled(k) {
+			store = listenkv.NewStore(kvStore, k, rs.listeners[k])
 		}
 		stores[k] = store
 	}
@@ -563,17 +581,19 @@ func (rs *Store) CacheMultiStoreWithVersion(version int64) (types.CacheMultiStor
 	var commitInfo *types.CommitInfo
 	storeInfos := map[string]bool{}
 	for key, store := range rs.stores {
-		var cacheStore types.KVStore
-		switch store.GetStoreType() {
-		case types.StoreTypeIAVL:
-			// If the store is wrapped with an inter-block cache, we must first unwrap
-			// it to get the underlying IAVL store.
-			store = rs.GetCommitKVStore(key)
-
-			// Attempt to lazy-load an already saved IAVL store version. If the
+		var cacheStore types.CacheWrapper
+		// If the store is wrapped with an inter-block cache, it is unwrapped to get
+		// the underlying commitment store.
+		if commitmentStore, ok := rs.commitmentStore(key); ok {
+			// Attempt to lazy-load an already saved store version. If the
 			// version does not exist or is pruned, an error should be returned.
-			var err error
-			cacheStore, err = store.(*iavl.Store).GetImmutable(version)
+			kvStore, err := commitmentStore.GetImmutableKVStore(version)
+			// if the version is not available in the store, but is archived, the
+			// store is read from the archive instead, without proofs
+			if err != nil && rs.archive != nil && rs.archive.HasVersion(version) {
+				kvStore, err = rs.archive.KVStore(key.Name(), version), nil
+			}
+			cacheStore = kvStore
 			// if we got error from loading a module store
 			// we fetch commit info of this version
 			// we use commit info to check if the store existed at this version or not
@@ -596,16 +616,18 @@ func (rs *Store) CacheMultiStoreWithVersion(version int64) (types.CacheMultiStor
 				if storeInfos[key.Name()] {
 					return nil, err
 				}
-			}
 
-		default:
+				// Otherwise the store did not exist yet and is read as empty.
+				cacheStore = dbadapter.Store{DB: dbm.NewMemDB()}
+			}
+		} else {
 			cacheStore = store
 		}
 
 		// Wire the listenkv.Store to allow listeners to observe the writes from the cache store,
 		// set same listeners on cache store will observe duplicated writes.
-		if rs.ListeningEnabled(key) {
-			cacheStore = listenkv.NewStore(cacheStore, key, rs.listeners[key])
+		if kvStore, ok := cacheStore.(types.KVStore); ok && rs.ListeningEnabled(key) {
+			cacheStore = listenkv.NewStore(kvStore, key, rs.listeners[key])
 		}
 
 		cachedStores[key] = cacheStore
@@ -621,7 +643,7 @@ func (rs *Store) CacheMultiStoreWithVersion(version int64) (types.CacheMultiStor
 // TODO: This isn't used directly upstream. Consider returning the Store as-is
 // instead of unwrapping.
 func (rs *Store) GetStore(key types.StoreKey) types.Store {
-	store := rs.GetCommitKVStore(key)
+	store := rs.GetCommitStore(key)
 	if store == nil {
 		panic(fmt.Sprintf("store does not exist for key: %s", key.Name()))
 	}
@@ -640,7 +662,10 @@ func (rs *Store) GetKVStore(key types.StoreKey) types.KVStore {
 	if s == nil {
 		panic(fmt.Sprintf("store does not exist for key: %s", key.Name()))
 	}
-	store := types.KVStore(s)
+	store, ok := s.(types.KVStore)
+	if !ok {
+		panic(fmt.Sprintf("store with key %s is not a KVStore", key.Name()))
+	}
 
 	if rs.TracingEnabled() {
 		store = tracekv.NewStore(store, rs.traceWriter, rs.getTracingContext())
@@ -652,6 +677,21 @@ func (rs *Store) GetKVStore(key types.StoreKey) types.KVStore {
 	return store
 }
 
+// GetObjKVStore returns a mounted ObjKVStore for a given StoreKey. Object stores
+// are neither traced nor listened to.
+func (rs *Store) GetObjKVStore(key types.StoreKey) types.ObjKVStore {
+	s := rs.stores[key]
+	if s == nil {
+		panic(fmt.Sprintf("store does not exist for key: %s", key.Name()))
+	}
+	store, ok := s.(types.ObjKVStore)
+	if !ok {
+		panic(fmt.Sprintf("store with key %s is not an ObjKVStore", key.Name()))
+	}
+
+	return store
+}
+
 func (rs *Store) handlePruning(version int64) error {
 	rs.pruningManager.HandleHeight(version - 1) // we should never prune the current version.
 	if !rs.pruningManager.ShouldPruneAtHeight(version) {
@@ -686,18 +726,17 @@ func (rs *Store) PruneStores(clearPruningManager bool, pruningHeights []int64) (
 
 	rs.logger.Debug("pruning store", "heights", pruningHeights)
 
-	for key, store := range rs.stores {
+	for key := range rs.stores {
 		rs.logger.Debug("pruning store", "key", key) // Also log store.name (a private variable)?
 
 		// If the store is wrapped with an inter-block cache, we must first unwrap
-		// it to get the underlying IAVL store.
-		if store.GetStoreType() != types.StoreTypeIAVL {
+		// it to get the underlying commitment store.
+		commitmentStore, ok := rs.commitmentStore(key)
+		if !ok {
 			continue
 		}
 
-		store = rs.GetCommitKVStore(key)
-
-		err := store.(*iavl.Store).DeleteVersions(pruningHeights...)
+		err := commitmentStore.DeleteVersions(pruningHeights...)
 		if err == nil {
 			continue
 		}
@@ -719,7 +758,7 @@ func (rs *Store) GetStoreByName(name string) types.Store {
 		return nil
 	}
 
-	return rs.GetCommitKVStore(key)
+	return rs.GetCommitStore(key)
 }
 
 // Query calls substore.Query with the same `req` where `req.Path` is
@@ -780,14 +819,13 @@ func (rs *Store) Query(req abci.RequestQuery) abci.ResponseQuery {
 func (rs *Store) SetInitialVersion(version int64) error {
 	rs.initialVersion = version
 
-	// Loop through all the stores, if it's an IAVL store, then set initial
+	// Loop through all the stores, if it's a commitment store, then set initial
 	// version on it.
-	for key, store := range rs.stores {
-		if store.GetStoreType() == types.StoreTypeIAVL {
-			// If the store is wrapped with an inter-block cache, we must first unwrap
-			// it to get the underlying IAVL store.
-			store = rs.GetCommitKVStore(key)
-			store.(types.StoreWithInitialVersion).SetInitialVersion(version)
+	for key := range rs.stores {
+		// If the store is wrapped with an inter-block cache, we must first unwrap
+		// it to get the underlying commitment store.
+		if commitmentStore, ok := rs.commitmentStore(key); ok {
+			commitmentStore.SetInitialVersion(version)
 		}
 	}
 
@@ -814,11 +852,18 @@ func parsePath(path string) (storeName, subpath string, err error) {
 
 //---------------------- Snapshotting ------------------
 
-// Snapshot implements snapshottypes.Snapshotter. The snapshot output for a given format must be
+// Snapshot implements snapshottypes.Snapshotter, writing the snapshot in
+// snapshottypes.CurrentFormat. The snapshot output for a given format must be
 // identical across nodes such that chunks from different sources fit together. If the output for a
 // given format changes (at the byte level), the snapshot format must be bumped - see
 // TestMultistoreSnapshot_Checksum test.
 func (rs *Store) Snapshot(height uint64, protoWriter protoio.Writer) error {
+	return rs.SnapshotWithFormat(height, snapshottypes.CurrentFormat, protoWriter)
+}
+
+// SnapshotWithFormat writes a snapshot of the given height in the given format, which allows
+// serving snapshots to nodes that only support an older format.
+func (rs *Store) SnapshotWithFormat(height uint64, format uint32, protoWriter protoio.Writer) error {
 	if height == 0 {
 		return errorsmod.Wrap(types.ErrLogic, "cannot snapshot height 0")
 	}
@@ -826,36 +871,58 @@ func (rs *Store) Snapshot(height uint64, protoWriter protoio.Writer) error {
 		return errorsmod.Wrapf(types.ErrLogic, "cannot snapshot future height %v", height)
 	}
 
-	// Collect stores to snapshot (only IAVL stores are supported)
-	type namedStore struct {
-		*iavl.Store
-		name string
+	stores, err := rs.snapshotStores()
+	if err != nil {
+		return err
+	}
+
+	switch format {
+	case snapshottypes.FormatSequential:
+		return rs.snapshotSequential(height, stores, protoWriter)
+	case snapshottypes.FormatParallel:
+		return rs.snapshotParallel(height, stores, protoWriter)
+	default:
+		return errorsmod.Wrapf(snapshottypes.ErrUnknownFormat, "format %v", format)
 	}
+}
+
+// namedStore is a commitment store to snapshot along with its name.
+type namedStore struct {
+	types.CommitmentStore
+	name string
+}
+
+// snapshotStores collects the stores to snapshot (only commitment stores are supported), sorted by name.
+func (rs *Store) snapshotStores() ([]namedStore, error) {
 	stores := []namedStore{}
 	keys := keysFromStoreKeyMap(rs.stores)
 	for _, key := range keys {
-		switch store := rs.GetCommitKVStore(key).(type) {
-		case *iavl.Store:
-			stores = append(stores, namedStore{name: key.Name(), Store: store})
-		case *transient.Store, *mem.Store:
+		switch store := rs.GetCommitStore(key).(type) {
+		case types.CommitmentStore:
+			stores = append(stores, namedStore{name: key.Name(), CommitmentStore: store})
+		case *transient.Store, *transient.ObjStore, *mem.Store:
 			// Non-persisted stores shouldn't be snapshotted
 			continue
 		default:
-			return errorsmod.Wrapf(types.ErrLogic,
+			return nil, errorsmod.Wrapf(types.ErrLogic,
 				"don't know how to snapshot store %q of type %T", key.Name(), store)
 		}
 	}
 	sort.Slice(stores, func(i, j int) bool {
 		return strings.Compare(stores[i].name, stores[j].name) == -1
 	})
+	return stores, nil
+}
 
-	// Export each IAVL store. Stores are serialized as a stream of SnapshotItem Protobuf
+// snapshotSequential writes a snapshot in snapshottypes.FormatSequential.
+func (rs *Store) snapshotSequential(height uint64, stores []namedStore, protoWriter protoio.Writer) error {
+	// Export each commitment store. Stores are serialized as a stream of SnapshotItem Protobuf
 	// messages. The first item contains a SnapshotStore with store metadata (i.e. name),
-	// and the following messages contain a SnapshotNode (i.e. an ExportNode). Store changes
+	// and the following messages contain a SnapshotNode (i.e. a CommitmentNode). Store changes
 	// are demarcated by new SnapshotStore items.
 	for _, store := range stores {
 		rs.logger.Debug("starting snapshot", "store", store.name, "height", height)
-		exporter, err := store.Export(int64(height))
+		exporter, err := store.SnapshotExporter(int64(height))
 		if err != nil {
 			rs.logger.Error("snapshot failed; exporter error", "store", store.name, "err", err)
 			return err
@@ -879,7 +946,7 @@ func (rs *Store) Snapshot(height uint64, protoWriter protoio.Writer) error {
 			nodeCount := 0
 			for {
 				node, err := exporter.Next()
-				if err == iavltree.ErrorExportDone {
+				if err == types.ErrExportDone {
 					rs.logger.Debug("snapshot Done", "store", store.name, "nodeCount", nodeCount)
 					break
 				} else if err != nil {
@@ -917,10 +984,32 @@ func (rs *Store) Snapshot(height uint64, protoWriter protoio.Writer) error {
 func (rs *Store) Restore(
 	height uint64, format uint32, protoReader protoio.Reader,
 ) (snapshottypes.SnapshotItem, error) {
+	var (
+		snapshotItem snapshottypes.SnapshotItem
+		err          error
+	)
+	switch format {
+	case snapshottypes.FormatSequential:
+		snapshotItem, err = rs.restoreSequential(height, protoReader)
+	case snapshottypes.FormatParallel:
+		snapshotItem, err = rs.restoreParallel(height, protoReader)
+	default:
+		return snapshottypes.SnapshotItem{}, errorsmod.Wrapf(snapshottypes.ErrUnknownFormat, "format %v", format)
+	}
+	if err != nil {
+		return snapshottypes.SnapshotItem{}, err
+	}
+
+	rs.flushMetadata(rs.db, int64(height), rs.buildCommitInfo(int64(height)))
+	return snapshotItem, rs.LoadLatestVersion()
+}
+
+// restoreSequential imports a snapshot in snapshottypes.FormatSequential.
+func (rs *Store) restoreSequential(height uint64, protoReader protoio.Reader) (snapshottypes.SnapshotItem, error) {
 	// Import nodes into stores. The first item is expected to be a SnapshotItem containing
 	// a SnapshotStoreItem, telling us which store to import into. The following items will contain
-	// SnapshotNodeItem (i.e. ExportNode) until we reach the next SnapshotStoreItem or EOF.
-	var importer *iavltree.Importer
+	// SnapshotNodeItem (i.e. CommitmentNode) until we reach the next SnapshotStoreItem or EOF.
+	var importer types.CommitmentImporter
 	var snapshotItem snapshottypes.SnapshotItem
 loop:
 	for {
@@ -941,11 +1030,11 @@ loop:
 				}
 				importer.Close()
 			}
-			store, ok := rs.GetStoreByName(item.Store.Name).(*iavl.Store)
+			store, ok := rs.GetStoreByName(item.Store.Name).(types.CommitmentStore)
 			if !ok || store == nil {
-				return snapshottypes.SnapshotItem{}, errorsmod.Wrapf(types.ErrLogic, "cannot import into non-IAVL store %q", item.Store.Name)
+				return snapshottypes.SnapshotItem{}, errorsmod.Wrapf(types.ErrLogic, "cannot import into non-commitment store %q", item.Store.Name)
 			}
-			importer, err = store.Import(int64(height))
+			importer, err = store.SnapshotImporter(int64(height))
 			if err != nil {
 				return snapshottypes.SnapshotItem{}, errorsmod.Wrap(err, "import failed")
 			}
@@ -958,25 +1047,11 @@ loop:
 				rs.logger.Error("failed to restore; received IAVL node item before store item")
 				return snapshottypes.SnapshotItem{}, errorsmod.Wrap(types.ErrLogic, "received IAVL node item before store item")
 			}
-			if item.IAVL.Height > math.MaxInt8 {
-				return snapshottypes.SnapshotItem{}, errorsmod.Wrapf(types.ErrLogic, "node height %v cannot exceed %v",
-					item.IAVL.Height, math.MaxInt8)
-			}
-			node := &iavltree.ExportNode{
-				Key:     item.IAVL.Key,
-				Value:   item.IAVL.Value,
-				Height:  int8(item.IAVL.Height),
-				Version: item.IAVL.Version,
-			}
-			// Protobuf does not differentiate between []byte{} as nil, but fortunately IAVL does
-			// not allow nil keys nor nil values for leaf nodes, so we can always set them to empty.
-			if node.Key == nil {
-				node.Key = []byte{}
-			}
-			if node.Height == 0 && node.Value == nil {
-				node.Value = []byte{}
+			node, err := commitmentNodeFromItem(item.IAVL)
+			if err != nil {
+				return snapshottypes.SnapshotItem{}, err
 			}
-			err := importer.Add(node)
+			err = importer.Add(node)
 			if err != nil {
 				return snapshottypes.SnapshotItem{}, errorsmod.Wrap(err, "IAVL node import failed")
 			}
@@ -994,11 +1069,33 @@ loop:
 		importer.Close()
 	}
 
-	rs.flushMetadata(rs.db, int64(height), rs.buildCommitInfo(int64(height)))
-	return snapshotItem, rs.LoadLatestVersion()
+	return snapshotItem, nil
+}
+
+// commitmentNodeFromItem converts a snapshot IAVL item into a node to import.
+func commitmentNodeFromItem(item *snapshottypes.SnapshotIAVLItem) (*types.CommitmentNode, error) {
+	if item.Height > math.MaxInt8 {
+		return nil, errorsmod.Wrapf(types.ErrLogic, "node height %v cannot exceed %v",
+			item.Height, math.MaxInt8)
+	}
+	node := &types.CommitmentNode{
+		Key:     item.Key,
+		Value:   item.Value,
+		Height:  int8(item.Height),
+		Version: item.Version,
+	}
+	// Protobuf does not differentiate between []byte{} as nil, but fortunately IAVL does
+	// not allow nil keys nor nil values for leaf nodes, so we can always set them to empty.
+	if node.Key == nil {
+		node.Key = []byte{}
+	}
+	if node.Height == 0 && node.Value == nil {
+		node.Value = []byte{}
+	}
+	return node, nil
 }
 
-func (rs *Store) loadCommitStoreFromParams(key types.StoreKey, id types.CommitID, params storeParams) (types.CommitKVStore, error) {
+func (rs *Store) loadCommitStoreFromParams(key types.StoreKey, id types.CommitID, params storeParams) (types.CommitStore, error) {
 	var db dbm.DB
 
 	if params.db != nil {
@@ -1012,29 +1109,6 @@ func (rs *Store) loadCommitStoreFromParams(key types.StoreKey, id types.CommitID
 	case types.StoreTypeMulti:
 		panic("recursive MultiStores not yet supported")
 
-	case types.StoreTypeIAVL:
-		var store types.CommitKVStore
-		var err error
-
-		if params.initialVersion == 0 {
-			store, err = iavl.LoadStore(db, rs.logger, key, id, rs.lazyLoading, rs.iavlCacheSize, rs.iavlDisableFastNode, rs.metrics)
-		} else {
-			store, err = iavl.LoadStoreWithInitialVersion(db, rs.logger, key, id, rs.lazyLoading, params.initialVersion, rs.iavlCacheSize, rs.iavlDisableFastNode, rs.metrics)
-		}
-
-		if err != nil {
-			return nil, err
-		}
-
-		if rs.interBlockCache != nil {
-			// Wrap and get a CommitKVStore with inter-block caching. Note, this should
-			// only wrap the primary CommitKVStore, not any store that is already
-			// branched as that will create unexpected behavior.
-			store = rs.interBlockCache.GetStoreCache(key, store)
-		}
-
-		return store, err
-
 	case types.StoreTypeDB:
 		return commitDBStoreAdapter{Store: dbadapter.Store{DB: db}}, nil
 
@@ -1053,8 +1127,33 @@ func (rs *Store) loadCommitStoreFromParams(key types.StoreKey, id types.CommitID
 
 		return mem.NewStore(), nil
 
+	case types.StoreTypeObject:
+		if _, ok := key.(*types.ObjectStoreKey); !ok {
+			return nil, fmt.Errorf("unexpected key type for an ObjectStoreKey; got: %s", key.String())
+		}
+
+		return transient.NewObjStore(), nil
+
 	default:
-		panic(fmt.Sprintf("unrecognized store type %v", params.typ))
+		backend, ok := rs.commitmentBackends[params.typ]
+		if !ok {
+			panic(fmt.Sprintf("unrecognized store type %v", params.typ))
+		}
+
+		var store types.CommitKVStore
+		store, err := backend.LoadStore(db, key, id, rs.commitmentOptions(params))
+		if err != nil {
+			return nil, err
+		}
+
+		if rs.interBlockCache != nil {
+			// Wrap and get a CommitKVStore with inter-block caching. Note, this should
+			// only wrap the primary CommitKVStore, not any store that is already
+			// branched as that will create unexpected behavior.
+			store = rs.interBlockCache.GetStoreCache(key, store)
+		}
+
+		return store, nil
 	}
 }
 
@@ -1063,7 +1162,7 @@ func (rs *Store) buildCommitInfo(version int64) *types.CommitInfo {
 	storeInfos := []types.StoreInfo{}
 	for _, key := range keys {
 		store := rs.stores[key]
-		if store.GetStoreType() == types.StoreTypeTransient {
+		if storeType := store.GetStoreType(); storeType == types.StoreTypeTransient || storeType == types.StoreTypeObject {
 			continue
 		}
 		storeInfos = append(storeInfos, types.StoreInfo{
@@ -1083,20 +1182,22 @@ func (rs *Store) RollbackToVersion(target int64) error {
 		return fmt.Errorf("invalid rollback height target: %d", target)
 	}
 
-	for key, store := range rs.stores {
-		if store.GetStoreType() == types.StoreTypeIAVL {
-			// If the store is wrapped with an inter-block cache, we must first unwrap
-			// it to get the underlying IAVL store.
-			store = rs.GetCommitKVStore(key)
-			var err error
-			if rs.lazyLoading {
-				_, err = store.(*iavl.Store).LazyLoadVersionForOverwriting(target)
-			} else {
-				_, err = store.(*iavl.Store).LoadVersionForOverwriting(target)
-			}
-			if err != nil {
-				return err
-			}
+	for key := range rs.stores {
+		// If the store is wrapped with an inter-block cache, we must first unwrap
+		// it to get the underlying commitment store.
+		commitmentStore, ok := rs.commitmentStore(key)
+		if !ok {
+			continue
+		}
+
+		var err error
+		if iavlStore, isIAVL := commitmentStore.(*iavl.Store); isIAVL && rs.lazyLoading {
+			_, err = iavlStore.LazyLoadVersionForOverwriting(target)
+		} else {
+			_, err = commitmentStore.LoadVersionForOverwriting(target)
+		}
+		if err != nil {
+			return err
 		}
 	}
 
@@ -1184,7 +1285,7 @@ func GetLatestVersion(db dbm.DB) int64 {
 }
 
 // Commits each store and returns a new commitInfo.
-func commitStores(version int64, storeMap map[types.StoreKey]types.CommitKVStore, removalMap map[types.StoreKey]bool) *types.CommitInfo {
+func commitStores(version int64, storeMap map[types.StoreKey]types.CommitStore, removalMap map[types.StoreKey]bool) *types.CommitInfo {
 	storeInfos := make([]types.StoreInfo, 0, len(storeMap))
 	storeKeys := keysFromStoreKeyMap(storeMap)
 
@@ -1204,7 +1305,7 @@ func commitStores(version int64, storeMap map[types.StoreKey]types.CommitKVStore
 		}
 
 		storeType := store.GetStoreType()
-		if storeType == types.StoreTypeTransient || storeType == types.StoreTypeMemory {
+		if storeType == types.StoreTypeTransient || storeType == types.StoreTypeMemory || storeType == types.StoreTypeObject {
 			continue
 		}
 
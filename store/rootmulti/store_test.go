@@ -11,6 +11,7 @@ import (
 	dbm "github.com/cosmos/cosmos-db"
 	"github.com/stretchr/testify/require"
 
+	"cosmossdk.io/store/archive"
 	"cosmossdk.io/store/cachemulti"
 	"cosmossdk.io/store/iavl"
 	sdkmaps "cosmossdk.io/store/internal/maps"
@@ -114,6 +115,40 @@ func TestCacheMultiStoreWithVersion(t *testing.T) {
 	})
 }
 
+func TestCacheMultiStoreWithVersion_Archive(t *testing.T) {
+	ms := newMultiStoreWithMounts(dbm.NewMemDB(), pruningtypes.NewCustomPruningOptions(1, 1))
+	require.NoError(t, ms.LoadLatestVersion())
+	archiveStore, err := archive.NewStore(dbm.NewMemDB())
+	require.NoError(t, err)
+	ms.AddListeners([]types.StoreKey{testStoreKey1})
+
+	k := []byte("wind")
+	store1 := ms.GetKVStore(testStoreKey1)
+	for i := 1; i <= 5; i++ {
+		store1.Set(k, []byte{byte(i)})
+		cID := ms.Commit()
+		require.NoError(t, archiveStore.Commit(cID.Version, ms.PopStateCache()))
+	}
+
+	// the pruned versions cannot be loaded without the archive
+	_, err = ms.CacheMultiStoreWithVersion(2)
+	require.Error(t, err)
+
+	ms.SetArchive(archiveStore)
+	cms, err := ms.CacheMultiStoreWithVersion(2)
+	require.NoError(t, err)
+	require.Equal(t, []byte{2}, cms.GetKVStore(testStoreKey1).Get(k))
+
+	// the versions still kept by the store are not read from the archive
+	cms, err = ms.CacheMultiStoreWithVersion(5)
+	require.NoError(t, err)
+	require.Equal(t, []byte{5}, cms.GetKVStore(testStoreKey1).Get(k))
+
+	// versions which are neither kept nor archived fail
+	_, err = ms.CacheMultiStoreWithVersion(6)
+	require.Error(t, err)
+}
+
 func TestHashStableWithEmptyCommit(t *testing.T) {
 	var db dbm.DB = dbm.NewMemDB()
 	ms := newMultiStoreWithMounts(db, pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
@@ -322,6 +357,90 @@ func TestMultistoreLoadWithUpgrade(t *testing.T) {
 	checkContains(t, ci.StoreInfos, []string{"store1", "restore2", "store4"})
 }
 
+func TestMultistoreLoadWithPrefixUpgrades(t *testing.T) {
+	var db dbm.DB = dbm.NewMemDB()
+	store := newMultiStoreWithMounts(db, pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
+	require.NoError(t, store.LoadLatestVersion())
+
+	s1 := store.GetStoreByName("store1").(types.KVStore)
+	s1.Set([]byte("balances/a"), []byte("1"))
+	s1.Set([]byte("balances/b"), []byte("2"))
+	s1.Set([]byte("old/x"), []byte("x"))
+	s1.Set([]byte("old/y"), []byte("y"))
+	s1.Set([]byte("params"), []byte("p"))
+	s2 := store.GetStoreByName("store2").(types.KVStore)
+	s2.Set([]byte("bank/c"), []byte("3"))
+	store.Commit()
+
+	newUpgrades := func(transformErr error) *types.StoreUpgrades {
+		return &types.StoreUpgrades{
+			PrefixMoved: []types.PrefixMove{{
+				OldStore: "store1", OldPrefix: []byte("balances/"),
+				NewStore: "store2", NewPrefix: []byte("bank/"),
+			}},
+			PrefixCopied: []types.PrefixCopy{{
+				OldStore: "store1", OldPrefix: []byte("old/"),
+				NewStore: "store3", NewPrefix: []byte("new/"),
+				Transform: func(key, value []byte) ([]byte, []byte, error) {
+					if transformErr != nil {
+						return nil, nil, transformErr
+					}
+					if bytes.Equal(key, []byte("y")) {
+						return nil, nil, nil
+					}
+					return append(key, '2'), append(value, value...), nil
+				},
+			}},
+			PrefixDeleted: []types.PrefixDelete{{Store: "store1", Prefix: []byte("old/")}},
+		}
+	}
+
+	// the upgrades fail as a whole
+	failing := newMultiStoreWithMounts(db, pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
+	err := failing.LoadLatestVersionAndUpgrade(newUpgrades(fmt.Errorf("bad value")))
+	require.ErrorContains(t, err, "failed to copy prefix")
+	require.ErrorContains(t, err, "bad value")
+
+	unknown := newMultiStoreWithMounts(db, pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
+	err = unknown.LoadLatestVersionAndUpgrade(&types.StoreUpgrades{
+		PrefixDeleted: []types.PrefixDelete{{Store: "store4", Prefix: []byte("old/")}},
+	})
+	require.ErrorContains(t, err, "store store4 is not a mounted persistent store")
+
+	restore := newMultiStoreWithMounts(db, pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
+	require.NoError(t, restore.LoadLatestVersionAndUpgrade(newUpgrades(nil)))
+
+	checkUpgraded := func(store *Store) {
+		s1 := store.GetStoreByName("store1").(types.KVStore)
+		s2 := store.GetStoreByName("store2").(types.KVStore)
+		s3 := store.GetStoreByName("store3").(types.KVStore)
+
+		require.Equal(t, [][2]string{{"params", "p"}}, storePairs(s1))
+		require.Equal(t, [][2]string{{"bank/a", "1"}, {"bank/b", "2"}, {"bank/c", "3"}}, storePairs(s2))
+		require.Equal(t, [][2]string{{"new/x2", "xx"}}, storePairs(s3))
+	}
+	checkUpgraded(restore)
+
+	migratedID := restore.Commit()
+	require.Equal(t, int64(2), migratedID.Version)
+
+	reload := newMultiStoreWithMounts(db, pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
+	require.NoError(t, reload.LoadLatestVersion())
+	require.Equal(t, migratedID, reload.LastCommitID())
+	checkUpgraded(reload)
+}
+
+// storePairs returns all the pairs of a store.
+func storePairs(store types.KVStore) [][2]string {
+	var pairs [][2]string
+	it := store.Iterator(nil, nil)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		pairs = append(pairs, [2]string{string(it.Key()), string(it.Value())})
+	}
+	return pairs
+}
+
 func TestParsePath(t *testing.T) {
 	_, _, err := parsePath("foo")
 	require.Error(t, err)
@@ -865,7 +984,7 @@ func getExpectedCommitID(store *Store, ver int64) types.CommitID {
 	}
 }
 
-func hashStores(stores map[types.StoreKey]types.CommitKVStore) []byte {
+func hashStores(stores map[types.StoreKey]types.CommitStore) []byte {
 	m := make(map[string][]byte, len(stores))
 	for key, store := range stores {
 		name := key.Name()
@@ -931,14 +1050,14 @@ func (stub *commitKVStoreStub) Commit() types.CommitID {
 	return commitID
 }
 
-func prepareStoreMap() map[types.StoreKey]types.CommitKVStore {
+func prepareStoreMap() map[types.StoreKey]types.CommitStore {
 	var db dbm.DB = dbm.NewMemDB()
 	store := NewStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics())
 	store.MountStoreWithDB(types.NewKVStoreKey("iavl1"), types.StoreTypeIAVL, nil)
 	store.MountStoreWithDB(types.NewKVStoreKey("iavl2"), types.StoreTypeIAVL, nil)
 	store.MountStoreWithDB(types.NewTransientStoreKey("trans1"), types.StoreTypeTransient, nil)
 	store.LoadLatestVersion()
-	return map[types.StoreKey]types.CommitKVStore{
+	return map[types.StoreKey]types.CommitStore{
 		testStoreKey1: &commitKVStoreStub{
 			CommitKVStore: store.GetStoreByName("iavl1").(types.CommitKVStore),
 		},
@@ -992,3 +1111,94 @@ func TestCommitStores(t *testing.T) {
 		})
 	}
 }
+
+func TestObjectStore(t *testing.T) {
+	db := dbm.NewMemDB()
+	store := NewStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics())
+	key := types.NewKVStoreKey("store1")
+	objKey := types.NewObjectStoreKey("obj1")
+	store.MountStoreWithDB(key, types.StoreTypeIAVL, nil)
+	store.MountStoreWithDB(objKey, types.StoreTypeObject, nil)
+	require.NoError(t, store.LoadLatestVersion())
+
+	require.Panics(t, func() { store.GetKVStore(objKey) })
+	require.Panics(t, func() { store.GetObjKVStore(key) })
+
+	cacheMulti := store.CacheMultiStore()
+	branch := cacheMulti.CacheMultiStore()
+	branch.GetObjKVStore(objKey).Set([]byte("a"), 1)
+	require.Nil(t, cacheMulti.GetObjKVStore(objKey).Get([]byte("a")))
+	branch.Write()
+	require.Equal(t, 1, cacheMulti.GetObjKVStore(objKey).Get([]byte("a")))
+	require.Nil(t, store.GetObjKVStore(objKey).Get([]byte("a")))
+	cacheMulti.Write()
+	require.Equal(t, 1, store.GetObjKVStore(objKey).Get([]byte("a")))
+
+	// object stores are not part of the commit info and are cleared on commit
+	commitID := store.Commit()
+	require.Equal(t, commitID.Hash, store.lastCommitInfo.Hash())
+	require.Len(t, store.lastCommitInfo.StoreInfos, 1)
+	require.Nil(t, store.GetObjKVStore(objKey).Get([]byte("a")))
+}
+
+func TestFlatCommitmentBackend(t *testing.T) {
+	db := dbm.NewMemDB()
+	store := NewStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics())
+	store.SetPruning(pruningtypes.NewCustomPruningOptions(1, 1))
+	iavlKey, flatKey := types.NewKVStoreKey("iavl"), types.NewKVStoreKey("flat")
+	store.MountStoreWithDB(iavlKey, types.StoreTypeIAVL, nil)
+	store.MountStoreWithDB(flatKey, types.StoreTypeFlat, nil)
+	require.NoError(t, store.LoadLatestVersion())
+
+	flatStore := store.GetKVStore(flatKey)
+	store.GetKVStore(iavlKey).Set([]byte("a"), []byte("1"))
+	flatStore.Set([]byte("a"), []byte("1"))
+	flatStore.Set([]byte("b"), []byte("1"))
+	require.Equal(t, store.WorkingHash(), store.Commit().Hash)
+
+	flatStore.Set([]byte("a"), []byte("2"))
+	cid := store.Commit()
+
+	// proofs of the flat store go through the proof operations of its backend
+	res := store.Query(abci.RequestQuery{Path: "/flat/key", Data: []byte("a"), Height: 2, Prove: true})
+	require.Equal(t, []byte("2"), res.Value)
+	require.NoError(t, store.ProofRuntime().VerifyValue(res.ProofOps, cid.Hash, "/flat/a", []byte("2")))
+
+	cms, err := store.CacheMultiStoreWithVersion(1)
+	require.NoError(t, err)
+	require.Equal(t, []byte("1"), cms.GetKVStore(flatKey).Get([]byte("a")))
+
+	// the flat store is pruned along with the IAVL store
+	store.Commit()
+	_, err = store.CacheMultiStoreWithVersion(1)
+	require.Error(t, err)
+
+	// the flat store is rolled back along with the IAVL store
+	require.NoError(t, store.RollbackToVersion(2))
+	require.Equal(t, cid, store.LastCommitID())
+	require.Equal(t, []byte("2"), store.GetKVStore(flatKey).Get([]byte("a")))
+
+	// the flat store is loaded again from the database
+	restarted := NewStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics())
+	restarted.MountStoreWithDB(iavlKey, types.StoreTypeIAVL, nil)
+	restarted.MountStoreWithDB(flatKey, types.StoreTypeFlat, nil)
+	require.NoError(t, restarted.LoadLatestVersion())
+	require.Equal(t, cid, restarted.LastCommitID())
+}
+
+func TestSetCommitmentBackend(t *testing.T) {
+	store := NewStore(dbm.NewMemDB(), log.NewNopLogger(), metrics.NewNoOpMetrics())
+	require.Panics(t, func() { store.SetCommitmentBackend(types.StoreTypeTransient, FlatCommitmentBackend()) })
+
+	// a store type is built by the backend registered for it
+	store.SetCommitmentBackend(types.StoreTypeSMT, FlatCommitmentBackend())
+	key := types.NewKVStoreKey("store")
+	store.MountStoreWithDB(key, types.StoreTypeSMT, nil)
+	require.NoError(t, store.LoadLatestVersion())
+	require.Equal(t, types.StoreTypeFlat, store.GetCommitStore(key).GetStoreType())
+
+	store.GetKVStore(key).Set([]byte("a"), []byte("1"))
+	cid := store.Commit()
+	require.Equal(t, int64(1), cid.Version)
+	require.Len(t, store.lastCommitInfo.StoreInfos, 1)
+}
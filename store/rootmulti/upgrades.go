@@ -0,0 +1,153 @@
+package rootmulti
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/cachekv"
+	"cosmossdk.io/store/prefix"
+	"cosmossdk.io/store/types"
+)
+
+// prefixUpgrader applies the prefix upgrades of the stores. The changes are
+// made on branches of the stores, which are written once all the upgrades
+// succeeded, so that either all or none of them are applied.
+type prefixUpgrader struct {
+	stores   map[string]types.KVStore
+	branches map[string]types.CacheKVStore
+}
+
+// applyPrefixUpgrades moves, copies and deletes the key prefixes of the
+// persistent stores loaded in loadVersion.
+func (rs *Store) applyPrefixUpgrades(stores map[types.StoreKey]types.CommitStore, upgrades *types.StoreUpgrades) error {
+	u := &prefixUpgrader{
+		stores:   make(map[string]types.KVStore),
+		branches: make(map[string]types.CacheKVStore),
+	}
+	for key, params := range rs.storesParams {
+		if rs.removalMap[key] || (!rs.isCommitmentStoreType(params.typ) && params.typ != types.StoreTypeDB) {
+			continue
+		}
+		u.stores[key.Name()] = stores[key].(types.KVStore)
+	}
+
+	for _, move := range upgrades.PrefixMoved {
+		if err := u.move(move); err != nil {
+			return errorsmod.Wrapf(err, "failed to move prefix %X of store %s -> prefix %X of store %s", move.OldPrefix, move.OldStore, move.NewPrefix, move.NewStore)
+		}
+	}
+	for _, cp := range upgrades.PrefixCopied {
+		if err := u.copy(cp); err != nil {
+			return errorsmod.Wrapf(err, "failed to copy prefix %X of store %s -> prefix %X of store %s", cp.OldPrefix, cp.OldStore, cp.NewPrefix, cp.NewStore)
+		}
+	}
+	for _, del := range upgrades.PrefixDeleted {
+		if err := u.delete(del); err != nil {
+			return errorsmod.Wrapf(err, "failed to delete prefix %X of store %s", del.Prefix, del.Store)
+		}
+	}
+
+	u.write()
+	return nil
+}
+
+// prefixStore returns the branch of a store under a key prefix.
+func (u *prefixUpgrader) prefixStore(name string, keyPrefix []byte) (types.KVStore, error) {
+	branch, ok := u.branches[name]
+	if !ok {
+		store, ok := u.stores[name]
+		if !ok {
+			return nil, fmt.Errorf("store %s is not a mounted persistent store", name)
+		}
+		branch = cachekv.NewStore(store)
+		u.branches[name] = branch
+	}
+	return prefix.NewStore(branch, keyPrefix), nil
+}
+
+func (u *prefixUpgrader) move(move types.PrefixMove) error {
+	oldStore, err := u.prefixStore(move.OldStore, move.OldPrefix)
+	if err != nil {
+		return err
+	}
+	newStore, err := u.prefixStore(move.NewStore, move.NewPrefix)
+	if err != nil {
+		return err
+	}
+
+	keys, values := loadPairs(oldStore)
+	for _, key := range keys {
+		oldStore.Delete(key)
+	}
+	for i, key := range keys {
+		newStore.Set(key, values[i])
+	}
+	return nil
+}
+
+func (u *prefixUpgrader) copy(cp types.PrefixCopy) error {
+	oldStore, err := u.prefixStore(cp.OldStore, cp.OldPrefix)
+	if err != nil {
+		return err
+	}
+	newStore, err := u.prefixStore(cp.NewStore, cp.NewPrefix)
+	if err != nil {
+		return err
+	}
+
+	keys, values := loadPairs(oldStore)
+	for i, key := range keys {
+		value := values[i]
+		if cp.Transform != nil {
+			key, value, err = cp.Transform(key, value)
+			if err != nil {
+				return errorsmod.Wrapf(err, "failed to transform key %X", keys[i])
+			}
+			if value == nil {
+				continue
+			}
+			if key == nil {
+				return errors.New("transformed key cannot be nil")
+			}
+		}
+		newStore.Set(key, value)
+	}
+	return nil
+}
+
+func (u *prefixUpgrader) delete(del types.PrefixDelete) error {
+	store, err := u.prefixStore(del.Store, del.Prefix)
+	if err != nil {
+		return err
+	}
+
+	return deleteKVStore(store)
+}
+
+// write writes the branches to the stores, in a deterministic order.
+func (u *prefixUpgrader) write() {
+	names := make([]string, 0, len(u.branches))
+	for name := range u.branches {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		u.branches[name].Write()
+	}
+}
+
+// loadPairs returns all the pairs of a store, as we cannot write while
+// iterating.
+func loadPairs(kv types.KVStore) (keys, values [][]byte) {
+	itr := kv.Iterator(nil, nil)
+	defer itr.Close()
+
+	for ; itr.Valid(); itr.Next() {
+		keys = append(keys, itr.Key())
+		values = append(values, itr.Value())
+	}
+	return keys, values
+}
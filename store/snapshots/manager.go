@@ -274,7 +274,7 @@ func (m *Manager) Restore(snapshot types.Snapshot) error {
 	defer m.mtx.Unlock()
 
 	// check multistore supported format preemptive
-	if snapshot.Format != types.CurrentFormat {
+	if !types.IsSupportedFormat(snapshot.Format) {
 		return errorsmod.Wrapf(types.ErrUnknownFormat, "snapshot format %v", snapshot.Format)
 	}
 	if snapshot.Height == 0 {
@@ -310,6 +310,34 @@ func (m *Manager) Restore(snapshot types.Snapshot) error {
 	return nil
 }
 
+// RestoreLocalSnapshot restores the state from a snapshot of the local snapshot store, without
+// going through ABCI state sync. It is used to bootstrap a node from a snapshot offline.
+func (m *Manager) RestoreLocalSnapshot(height uint64, format uint32) error {
+	if m == nil {
+		return errorsmod.Wrap(storetypes.ErrLogic, "no snapshot store configured")
+	}
+	snapshot, chChunks, err := m.store.Load(height, format)
+	if err != nil {
+		return err
+	}
+	if snapshot == nil {
+		return errorsmod.Wrapf(storetypes.ErrInvalidRequest, "snapshot at height %v format %v does not exist", height, format)
+	}
+	defer DrainChunks(chChunks)
+	if err := ValidRestoreHeight(snapshot.Format, snapshot.Height); err != nil {
+		return err
+	}
+
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	if err := m.beginLocked(opRestore); err != nil {
+		return err
+	}
+	defer m.endLocked()
+
+	return m.restoreSnapshot(*snapshot, chChunks)
+}
+
 // restoreSnapshot do the heavy work of snapshot restoration after preliminary checks on request have passed.
 func (m *Manager) restoreSnapshot(snapshot types.Snapshot, chChunks <-chan io.ReadCloser) error {
 	var nextItem types.SnapshotItem
@@ -236,3 +236,42 @@ func TestManager_Restore(t *testing.T) {
 	})
 	require.NoError(t, err)
 }
+
+func TestManager_RestoreLocalSnapshot(t *testing.T) {
+	store := setupStore(t)
+	items := [][]byte{
+		{1, 2, 3},
+		{4, 5, 6},
+		{7, 8, 9},
+	}
+	source := &mockSnapshotter{
+		items:         items,
+		prunedHeights: make(map[int64]struct{}),
+	}
+	manager := snapshots.NewManager(store, opts, source, nil, log.NewNopLogger())
+	err := manager.RegisterExtensions(newExtSnapshotter(10))
+	require.NoError(t, err)
+	snapshot, err := manager.Create(5)
+	require.NoError(t, err)
+
+	target := &mockSnapshotter{
+		prunedHeights: make(map[int64]struct{}),
+	}
+	extSnapshotter := newExtSnapshotter(0)
+	manager = snapshots.NewManager(store, opts, target, nil, log.NewNopLogger())
+	err = manager.RegisterExtensions(extSnapshotter)
+	require.NoError(t, err)
+
+	// restoring a missing snapshot should error
+	err = manager.RestoreLocalSnapshot(6, snapshot.Format)
+	require.Error(t, err)
+
+	err = manager.RestoreLocalSnapshot(snapshot.Height, snapshot.Format)
+	require.NoError(t, err)
+	assert.Equal(t, items, target.items)
+	assert.Equal(t, 10, len(extSnapshotter.state))
+
+	// the restore operation has ended, so other operations are allowed again
+	_, err = manager.Prune(1)
+	require.NoError(t, err)
+}
@@ -1,6 +1,30 @@
 package types
 
+const (
+	// FormatSequential is the snapshot format in which the IAVL stores are exported one after
+	// another, each store being introduced by a single SnapshotStoreItem.
+	FormatSequential uint32 = 3
+
+	// FormatParallel is the snapshot format in which the IAVL stores are exported and imported
+	// concurrently. The nodes of the stores are interleaved in fixed size segments, each segment
+	// being introduced by a SnapshotStoreItem naming the store it belongs to.
+	FormatParallel uint32 = 4
+)
+
 // CurrentFormat is the currently used format for snapshots. Snapshots using the same format
 // must be identical across all nodes for a given height, so this must be bumped when the binary
 // snapshot output changes.
-const CurrentFormat uint32 = 3
+const CurrentFormat = FormatParallel
+
+// SupportedFormats lists the snapshot formats that can be restored.
+var SupportedFormats = []uint32{FormatSequential, FormatParallel}
+
+// IsSupportedFormat returns whether snapshots of the given format can be restored.
+func IsSupportedFormat(format uint32) bool {
+	for _, f := range SupportedFormats {
+		if f == format {
+			return true
+		}
+	}
+	return false
+}
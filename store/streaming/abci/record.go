@@ -0,0 +1,26 @@
+package abci
+
+// Record is a single ABCI message, along with its response, streamed by the
+// in-process ABCIListener implementations. Exactly one of its fields is set.
+type Record struct {
+	BeginBlock *ListenBeginBlockRequest
+	EndBlock   *ListenEndBlockRequest
+	DeliverTx  *ListenDeliverTxRequest
+	Commit     *ListenCommitRequest
+}
+
+// BlockHeight returns the height of the block the record belongs to.
+func (r Record) BlockHeight() int64 {
+	switch {
+	case r.BeginBlock != nil:
+		return r.BeginBlock.GetReq().GetHeader().Height
+	case r.EndBlock != nil:
+		return r.EndBlock.GetReq().GetHeight()
+	case r.DeliverTx != nil:
+		return r.DeliverTx.BlockHeight
+	case r.Commit != nil:
+		return r.Commit.BlockHeight
+	default:
+		return 0
+	}
+}
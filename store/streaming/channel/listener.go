@@ -0,0 +1,137 @@
+// Package channel implements an ABCIListener streaming the ABCI messages and
+// state changes of every block to an in-process Go channel.
+package channel
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	streamingabci "cosmossdk.io/store/streaming/abci"
+	storetypes "cosmossdk.io/store/types"
+)
+
+var (
+	// ErrBufferFull is returned by a non-blocking Listener when the consumer
+	// does not keep up and the channel buffer is full.
+	ErrBufferFull = errors.New("streaming channel buffer is full")
+
+	// ErrClosed is returned when streaming to a closed Listener.
+	ErrClosed = errors.New("streaming channel listener is closed")
+)
+
+var _ storetypes.ABCIListener = (*Listener)(nil)
+
+// Options defines the configuration of a channel Listener.
+type Options struct {
+	// BufferSize is the capacity of the records channel.
+	BufferSize int
+	// Blocking makes the Listener wait for the consumer when the channel buffer
+	// is full, stalling block processing, instead of failing with ErrBufferFull.
+	Blocking bool
+	// StopNodeOnErr makes the Listener panic, halting the node, instead of
+	// returning an error when a record cannot be delivered.
+	StopNodeOnErr bool
+}
+
+// Listener is an ABCIListener sending every ABCI message it listens to as a
+// streamingabci.Record on a Go channel, to be consumed in-process.
+//
+// The records channel is never closed, consumers should stop reading from it
+// once Done is closed.
+type Listener struct {
+	opts    Options
+	records chan streamingabci.Record
+	done    chan struct{}
+
+	closeOnce sync.Once
+	// height is the height of the block being processed, set on BeginBlock as
+	// neither DeliverTx nor Commit messages contain it.
+	height int64
+}
+
+// NewListener returns a new channel Listener.
+func NewListener(opts Options) *Listener {
+	return &Listener{
+		opts:    opts,
+		records: make(chan streamingabci.Record, opts.BufferSize),
+		done:    make(chan struct{}),
+	}
+}
+
+// Records returns the channel the records are sent on.
+func (l *Listener) Records() <-chan streamingabci.Record {
+	return l.records
+}
+
+// Done returns a channel that is closed when the Listener is closed.
+func (l *Listener) Done() <-chan struct{} {
+	return l.done
+}
+
+// Close stops the Listener, any record streamed afterwards fails with ErrClosed.
+func (l *Listener) Close() error {
+	l.closeOnce.Do(func() { close(l.done) })
+	return nil
+}
+
+// ListenBeginBlock implements storetypes.ABCIListener.
+func (l *Listener) ListenBeginBlock(_ context.Context, req abci.RequestBeginBlock, res abci.ResponseBeginBlock) error {
+	l.height = req.Header.Height
+	return l.send(streamingabci.Record{
+		BeginBlock: &streamingabci.ListenBeginBlockRequest{Req: &req, Res: &res},
+	})
+}
+
+// ListenEndBlock implements storetypes.ABCIListener.
+func (l *Listener) ListenEndBlock(_ context.Context, req abci.RequestEndBlock, res abci.ResponseEndBlock) error {
+	return l.send(streamingabci.Record{
+		EndBlock: &streamingabci.ListenEndBlockRequest{Req: &req, Res: &res},
+	})
+}
+
+// ListenDeliverTx implements storetypes.ABCIListener.
+func (l *Listener) ListenDeliverTx(_ context.Context, req abci.RequestDeliverTx, res abci.ResponseDeliverTx) error {
+	return l.send(streamingabci.Record{
+		DeliverTx: &streamingabci.ListenDeliverTxRequest{BlockHeight: l.height, Req: &req, Res: &res},
+	})
+}
+
+// ListenCommit implements storetypes.ABCIListener.
+func (l *Listener) ListenCommit(_ context.Context, res abci.ResponseCommit, changeSet []*storetypes.StoreKVPair) error {
+	return l.send(streamingabci.Record{
+		Commit: &streamingabci.ListenCommitRequest{BlockHeight: l.height, Res: &res, ChangeSet: changeSet},
+	})
+}
+
+func (l *Listener) send(record streamingabci.Record) error {
+	var err error
+
+	select {
+	case <-l.done:
+		err = ErrClosed
+	default:
+		if l.opts.Blocking {
+			select {
+			case l.records <- record:
+			case <-l.done:
+				err = ErrClosed
+			}
+		} else {
+			select {
+			case l.records <- record:
+			default:
+				err = ErrBufferFull
+			}
+		}
+	}
+
+	if err != nil && l.opts.StopNodeOnErr {
+		panic(fmt.Errorf("channel streaming listener failed at height %d: %w", record.BlockHeight(), err))
+	}
+
+	return err
+}
@@ -0,0 +1,74 @@
+package channel_test
+
+import (
+	"context"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/store/streaming/channel"
+	storetypes "cosmossdk.io/store/types"
+)
+
+func TestListener(t *testing.T) {
+	ctx := context.Background()
+	l := channel.NewListener(channel.Options{BufferSize: 4})
+
+	require.NoError(t, l.ListenBeginBlock(ctx, abci.RequestBeginBlock{Header: tmproto.Header{Height: 7}}, abci.ResponseBeginBlock{}))
+	require.NoError(t, l.ListenDeliverTx(ctx, abci.RequestDeliverTx{Tx: []byte("tx")}, abci.ResponseDeliverTx{}))
+	require.NoError(t, l.ListenEndBlock(ctx, abci.RequestEndBlock{Height: 7}, abci.ResponseEndBlock{}))
+	changeSet := []*storetypes.StoreKVPair{{StoreKey: "bank", Key: []byte("key"), Value: []byte("value")}}
+	require.NoError(t, l.ListenCommit(ctx, abci.ResponseCommit{}, changeSet))
+
+	records := l.Records()
+	record := <-records
+	require.NotNil(t, record.BeginBlock)
+	require.Equal(t, int64(7), record.BlockHeight())
+
+	record = <-records
+	require.NotNil(t, record.DeliverTx)
+	require.Equal(t, []byte("tx"), record.DeliverTx.Req.Tx)
+	require.Equal(t, int64(7), record.BlockHeight())
+
+	record = <-records
+	require.NotNil(t, record.EndBlock)
+
+	record = <-records
+	require.NotNil(t, record.Commit)
+	require.Equal(t, changeSet, record.Commit.ChangeSet)
+	require.Equal(t, int64(7), record.BlockHeight())
+
+	require.NoError(t, l.Close())
+	<-l.Done()
+	require.ErrorIs(t, l.ListenCommit(ctx, abci.ResponseCommit{}, nil), channel.ErrClosed)
+}
+
+func TestListenerBufferFull(t *testing.T) {
+	ctx := context.Background()
+
+	l := channel.NewListener(channel.Options{BufferSize: 1})
+	require.NoError(t, l.ListenEndBlock(ctx, abci.RequestEndBlock{}, abci.ResponseEndBlock{}))
+	require.ErrorIs(t, l.ListenEndBlock(ctx, abci.RequestEndBlock{}, abci.ResponseEndBlock{}), channel.ErrBufferFull)
+
+	l = channel.NewListener(channel.Options{BufferSize: 1, StopNodeOnErr: true})
+	require.NoError(t, l.ListenEndBlock(ctx, abci.RequestEndBlock{}, abci.ResponseEndBlock{}))
+	require.Panics(t, func() {
+		_ = l.ListenEndBlock(ctx, abci.RequestEndBlock{}, abci.ResponseEndBlock{})
+	})
+}
+
+func TestListenerBlocking(t *testing.T) {
+	ctx := context.Background()
+	l := channel.NewListener(channel.Options{Blocking: true})
+
+	go func() {
+		<-l.Records()
+		_ = l.Close()
+	}()
+
+	// the first record waits for the consumer, the second one for the close
+	require.NoError(t, l.ListenEndBlock(ctx, abci.RequestEndBlock{}, abci.ResponseEndBlock{}))
+	require.ErrorIs(t, l.ListenEndBlock(ctx, abci.RequestEndBlock{}, abci.ResponseEndBlock{}), channel.ErrClosed)
+}
@@ -0,0 +1,116 @@
+package file
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+
+	streamingabci "cosmossdk.io/store/streaming/abci"
+)
+
+// A file is a sequence of records, each of them encoded as a one byte record
+// kind, followed by the uvarint length prefixed protobuf encoding of the
+// corresponding streamingabci Listen*Request message.
+const (
+	kindBeginBlock byte = iota + 1
+	kindEndBlock
+	kindDeliverTx
+	kindCommit
+)
+
+// maxRecordSize bounds the size of a single record to protect readers from
+// allocating arbitrary amounts of memory on corrupted files.
+const maxRecordSize = 1 << 30
+
+type marshaler interface {
+	Size() int
+	MarshalToSizedBuffer([]byte) (int, error)
+}
+
+// encodeRecord appends the encoding of the record to buf.
+func encodeRecord(buf []byte, record streamingabci.Record) ([]byte, error) {
+	var (
+		kind byte
+		msg  marshaler
+	)
+	switch {
+	case record.BeginBlock != nil:
+		kind, msg = kindBeginBlock, record.BeginBlock
+	case record.EndBlock != nil:
+		kind, msg = kindEndBlock, record.EndBlock
+	case record.DeliverTx != nil:
+		kind, msg = kindDeliverTx, record.DeliverTx
+	case record.Commit != nil:
+		kind, msg = kindCommit, record.Commit
+	default:
+		return nil, errors.New("empty streaming record")
+	}
+
+	size := msg.Size()
+	buf = append(buf, kind)
+	buf = binary.AppendUvarint(buf, uint64(size))
+
+	start := len(buf)
+	buf = append(buf, make([]byte, size)...)
+	if _, err := msg.MarshalToSizedBuffer(buf[start:]); err != nil {
+		return nil, err
+	}
+
+	return buf, nil
+}
+
+// decodeRecord reads the next record from r. It returns io.EOF if there are no
+// more records, and io.ErrUnexpectedEOF if the last record is truncated.
+func decodeRecord(r *bufio.Reader) (streamingabci.Record, error) {
+	kind, err := r.ReadByte()
+	if err != nil {
+		return streamingabci.Record{}, err
+	}
+
+	size, err := binary.ReadUvarint(r)
+	if err != nil {
+		return streamingabci.Record{}, noEOF(err)
+	}
+	if size > maxRecordSize {
+		return streamingabci.Record{}, fmt.Errorf("streaming record too large: %d bytes", size)
+	}
+
+	bz := make([]byte, size)
+	if _, err := io.ReadFull(r, bz); err != nil {
+		return streamingabci.Record{}, noEOF(err)
+	}
+
+	var record streamingabci.Record
+	switch kind {
+	case kindBeginBlock:
+		record.BeginBlock = &streamingabci.ListenBeginBlockRequest{}
+		err = record.BeginBlock.Unmarshal(bz)
+	case kindEndBlock:
+		record.EndBlock = &streamingabci.ListenEndBlockRequest{}
+		err = record.EndBlock.Unmarshal(bz)
+	case kindDeliverTx:
+		record.DeliverTx = &streamingabci.ListenDeliverTxRequest{}
+		err = record.DeliverTx.Unmarshal(bz)
+	case kindCommit:
+		record.Commit = &streamingabci.ListenCommitRequest{}
+		err = record.Commit.Unmarshal(bz)
+	default:
+		return streamingabci.Record{}, fmt.Errorf("unknown streaming record kind %d", kind)
+	}
+	if err != nil {
+		return streamingabci.Record{}, err
+	}
+
+	return record, nil
+}
+
+// noEOF converts io.EOF into io.ErrUnexpectedEOF, for reads in the middle of
+// a record.
+func noEOF(err error) error {
+	if errors.Is(err, io.EOF) {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
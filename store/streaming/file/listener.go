@@ -0,0 +1,213 @@
+// Package file implements an ABCIListener writing the ABCI messages and state
+// changes of every block to rotating files in a directory, and a reader to
+// replay them.
+package file
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	streamingabci "cosmossdk.io/store/streaming/abci"
+	storetypes "cosmossdk.io/store/types"
+)
+
+// ErrClosed is returned when streaming to a closed Listener.
+var ErrClosed = errors.New("file streaming listener is closed")
+
+var _ storetypes.ABCIListener = (*Listener)(nil)
+
+// Options defines the configuration of a file Listener.
+type Options struct {
+	// Dir is the directory the files are written to, it is created if needed.
+	Dir string
+	// Prefix is prepended to the name of the files.
+	Prefix string
+	// MaxFileSize is the size in bytes after which a new file is started. Files
+	// are only rotated between blocks, so that a block is never split across
+	// files. Zero disables the rotation.
+	MaxFileSize int64
+	// Fsync makes the Listener sync the file to disk on every commit.
+	Fsync bool
+	// StopNodeOnErr makes the Listener panic, halting the node, instead of
+	// returning an error when a record cannot be written.
+	StopNodeOnErr bool
+}
+
+// Listener is an ABCIListener writing every ABCI message it listens to as a
+// record to a file. A new file is started at the first block after the current
+// file reached Options.MaxFileSize, named after the height of its first block,
+// see FileName. Files are flushed on every commit, so after a crash the last
+// file may end with an incomplete block.
+type Listener struct {
+	opts Options
+
+	mtx    sync.Mutex
+	file   *os.File
+	writer *bufio.Writer
+	size   int64
+	buf    []byte
+	closed bool
+	// height is the height of the block being processed, set on BeginBlock as
+	// neither DeliverTx nor Commit messages contain it.
+	height int64
+}
+
+// NewListener returns a new file Listener.
+func NewListener(opts Options) (*Listener, error) {
+	if opts.Dir == "" {
+		return nil, errors.New("file streaming listener directory is not set")
+	}
+
+	if err := os.MkdirAll(opts.Dir, 0o755); err != nil {
+		return nil, err
+	}
+
+	return &Listener{opts: opts}, nil
+}
+
+// FileName returns the name of the file whose first block is at the given height.
+func FileName(prefix string, height int64) string {
+	return fmt.Sprintf("%sblock-%020d.abci", prefix, height)
+}
+
+// ListenBeginBlock implements storetypes.ABCIListener.
+func (l *Listener) ListenBeginBlock(_ context.Context, req abci.RequestBeginBlock, res abci.ResponseBeginBlock) error {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+
+	l.height = req.Header.Height
+	return l.handleErr(l.write(streamingabci.Record{
+		BeginBlock: &streamingabci.ListenBeginBlockRequest{Req: &req, Res: &res},
+	}))
+}
+
+// ListenEndBlock implements storetypes.ABCIListener.
+func (l *Listener) ListenEndBlock(_ context.Context, req abci.RequestEndBlock, res abci.ResponseEndBlock) error {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+
+	return l.handleErr(l.write(streamingabci.Record{
+		EndBlock: &streamingabci.ListenEndBlockRequest{Req: &req, Res: &res},
+	}))
+}
+
+// ListenDeliverTx implements storetypes.ABCIListener.
+func (l *Listener) ListenDeliverTx(_ context.Context, req abci.RequestDeliverTx, res abci.ResponseDeliverTx) error {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+
+	return l.handleErr(l.write(streamingabci.Record{
+		DeliverTx: &streamingabci.ListenDeliverTxRequest{BlockHeight: l.height, Req: &req, Res: &res},
+	}))
+}
+
+// ListenCommit implements storetypes.ABCIListener. It flushes the current file,
+// and closes it if it reached the maximum file size.
+func (l *Listener) ListenCommit(_ context.Context, res abci.ResponseCommit, changeSet []*storetypes.StoreKVPair) error {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+
+	err := l.write(streamingabci.Record{
+		Commit: &streamingabci.ListenCommitRequest{BlockHeight: l.height, Res: &res, ChangeSet: changeSet},
+	})
+	if err == nil {
+		err = l.flush()
+	}
+	if err == nil && l.opts.MaxFileSize > 0 && l.size >= l.opts.MaxFileSize {
+		err = l.closeFile()
+	}
+
+	return l.handleErr(err)
+}
+
+// Close flushes and closes the current file, any record streamed afterwards
+// fails with ErrClosed.
+func (l *Listener) Close() error {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+
+	if l.closed {
+		return nil
+	}
+	l.closed = true
+
+	if l.file == nil {
+		return nil
+	}
+
+	if err := l.flush(); err != nil {
+		l.file.Close()
+		l.file = nil
+		return err
+	}
+
+	return l.closeFile()
+}
+
+func (l *Listener) write(record streamingabci.Record) error {
+	if l.closed {
+		return ErrClosed
+	}
+
+	if l.file == nil {
+		if err := l.openFile(); err != nil {
+			return err
+		}
+	}
+
+	var err error
+	l.buf, err = encodeRecord(l.buf[:0], record)
+	if err != nil {
+		return err
+	}
+
+	n, err := l.writer.Write(l.buf)
+	l.size += int64(n)
+	return err
+}
+
+func (l *Listener) openFile() error {
+	path := filepath.Join(l.opts.Dir, FileName(l.opts.Prefix, l.height))
+	f, err := os.OpenFile(filepath.Clean(path), os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+
+	l.file = f
+	l.writer = bufio.NewWriter(f)
+	l.size = 0
+	return nil
+}
+
+func (l *Listener) flush() error {
+	if err := l.writer.Flush(); err != nil {
+		return err
+	}
+
+	if l.opts.Fsync {
+		return l.file.Sync()
+	}
+
+	return nil
+}
+
+func (l *Listener) closeFile() error {
+	err := l.file.Close()
+	l.file, l.writer = nil, nil
+	return err
+}
+
+func (l *Listener) handleErr(err error) error {
+	if err != nil && l.opts.StopNodeOnErr {
+		panic(fmt.Errorf("file streaming listener failed at height %d: %w", l.height, err))
+	}
+
+	return err
+}
@@ -0,0 +1,138 @@
+package file_test
+
+import (
+	"context"
+	"io"
+	"os"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	streamingabci "cosmossdk.io/store/streaming/abci"
+	"cosmossdk.io/store/streaming/file"
+	storetypes "cosmossdk.io/store/types"
+)
+
+func streamBlock(t *testing.T, l *file.Listener, height int64) {
+	t.Helper()
+
+	ctx := context.Background()
+	require.NoError(t, l.ListenBeginBlock(ctx, abci.RequestBeginBlock{Header: tmproto.Header{Height: height}}, abci.ResponseBeginBlock{}))
+	require.NoError(t, l.ListenDeliverTx(ctx, abci.RequestDeliverTx{Tx: []byte("tx")}, abci.ResponseDeliverTx{GasUsed: height}))
+	require.NoError(t, l.ListenEndBlock(ctx, abci.RequestEndBlock{Height: height}, abci.ResponseEndBlock{}))
+	require.NoError(t, l.ListenCommit(ctx, abci.ResponseCommit{Data: []byte("hash")}, []*storetypes.StoreKVPair{
+		{StoreKey: "bank", Key: []byte("key"), Value: []byte("value")},
+	}))
+}
+
+func TestListenerReplay(t *testing.T) {
+	dir := t.TempDir()
+
+	l, err := file.NewListener(file.Options{Dir: dir, Prefix: "node-", MaxFileSize: 1})
+	require.NoError(t, err)
+
+	for height := int64(1); height <= 3; height++ {
+		streamBlock(t, l, height)
+	}
+	require.NoError(t, l.Close())
+
+	// every block exceeds the maximum file size, so each of them has its own file
+	files, err := file.Files(dir, "node-")
+	require.NoError(t, err)
+	require.Len(t, files, 3)
+	require.Contains(t, files[2], file.FileName("node-", 3))
+
+	var records []streamingabci.Record
+	require.NoError(t, file.Replay(dir, "node-", func(record streamingabci.Record) error {
+		records = append(records, record)
+		return nil
+	}))
+	require.Len(t, records, 12)
+
+	for i, record := range records {
+		height := int64(i/4 + 1)
+		require.Equal(t, height, record.BlockHeight())
+
+		switch i % 4 {
+		case 0:
+			require.NotNil(t, record.BeginBlock)
+		case 1:
+			require.NotNil(t, record.DeliverTx)
+			require.Equal(t, []byte("tx"), record.DeliverTx.Req.Tx)
+			require.Equal(t, height, record.DeliverTx.Res.GasUsed)
+		case 2:
+			require.NotNil(t, record.EndBlock)
+		case 3:
+			require.NotNil(t, record.Commit)
+			require.Equal(t, []byte("hash"), record.Commit.Res.Data)
+			require.Len(t, record.Commit.ChangeSet, 1)
+			require.Equal(t, "bank", record.Commit.ChangeSet[0].StoreKey)
+		}
+	}
+
+	require.ErrorIs(t, l.ListenBeginBlock(context.Background(), abci.RequestBeginBlock{}, abci.ResponseBeginBlock{}), file.ErrClosed)
+}
+
+func TestListenerNoRotation(t *testing.T) {
+	dir := t.TempDir()
+
+	l, err := file.NewListener(file.Options{Dir: dir, Fsync: true})
+	require.NoError(t, err)
+
+	for height := int64(1); height <= 3; height++ {
+		streamBlock(t, l, height)
+	}
+
+	// the records are flushed on commit, so they can be read before closing
+	files, err := file.Files(dir, "")
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+
+	r, err := file.OpenReader(files[0])
+	require.NoError(t, err)
+
+	count := 0
+	for {
+		_, err := r.Next()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		count++
+	}
+	require.Equal(t, 12, count)
+	require.NoError(t, r.Close())
+	require.NoError(t, l.Close())
+}
+
+func TestReaderTruncatedRecord(t *testing.T) {
+	dir := t.TempDir()
+
+	l, err := file.NewListener(file.Options{Dir: dir})
+	require.NoError(t, err)
+	streamBlock(t, l, 1)
+	require.NoError(t, l.Close())
+
+	files, err := file.Files(dir, "")
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+
+	info, err := os.Stat(files[0])
+	require.NoError(t, err)
+	require.NoError(t, os.Truncate(files[0], info.Size()-1))
+
+	err = file.Replay(dir, "", func(streamingabci.Record) error { return nil })
+	require.ErrorIs(t, err, io.ErrUnexpectedEOF)
+}
+
+func TestListenerStopNodeOnErr(t *testing.T) {
+	l, err := file.NewListener(file.Options{Dir: t.TempDir(), StopNodeOnErr: true})
+	require.NoError(t, err)
+	require.NoError(t, l.Close())
+
+	require.Panics(t, func() {
+		_ = l.ListenBeginBlock(context.Background(), abci.RequestBeginBlock{}, abci.ResponseBeginBlock{})
+	})
+}
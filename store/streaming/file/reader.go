@@ -0,0 +1,92 @@
+package file
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+
+	streamingabci "cosmossdk.io/store/streaming/abci"
+)
+
+// Reader reads the records of a file written by a Listener.
+type Reader struct {
+	file   *os.File
+	reader *bufio.Reader
+}
+
+// OpenReader opens the file at the given path for reading.
+func OpenReader(path string) (*Reader, error) {
+	f, err := os.Open(filepath.Clean(path))
+	if err != nil {
+		return nil, err
+	}
+
+	return &Reader{file: f, reader: bufio.NewReader(f)}, nil
+}
+
+// Next returns the next record of the file. It returns io.EOF once all the
+// records have been read, and io.ErrUnexpectedEOF if the file ends with a
+// truncated record.
+func (r *Reader) Next() (streamingabci.Record, error) {
+	return decodeRecord(r.reader)
+}
+
+// Close closes the underlying file.
+func (r *Reader) Close() error {
+	return r.file.Close()
+}
+
+// Files returns the paths of the files with the given prefix written to dir
+// by a Listener, in the order they were written.
+func Files(dir, prefix string) ([]string, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, prefix+"block-*.abci"))
+	if err != nil {
+		return nil, err
+	}
+
+	// file names are zero padded, so the lexicographic order is the block order
+	sort.Strings(paths)
+	return paths, nil
+}
+
+// Replay calls fn with every record of the files with the given prefix written
+// to dir by a Listener, in the order they were written, until fn returns an
+// error.
+func Replay(dir, prefix string, fn func(streamingabci.Record) error) error {
+	paths, err := Files(dir, prefix)
+	if err != nil {
+		return err
+	}
+
+	for _, path := range paths {
+		if err := replayFile(path, fn); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func replayFile(path string, fn func(streamingabci.Record) error) error {
+	r, err := OpenReader(path)
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	for {
+		record, err := r.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		} else if err != nil {
+			return err
+		}
+
+		if err := fn(record); err != nil {
+			return err
+		}
+	}
+}
@@ -1,9 +1,13 @@
 package transient
 
 import (
+	"io"
+
 	dbm "github.com/cosmos/cosmos-db"
 
+	"cosmossdk.io/store/cachekv"
 	"cosmossdk.io/store/dbadapter"
+	"cosmossdk.io/store/internal/btree"
 	pruningtypes "cosmossdk.io/store/pruning/types"
 	"cosmossdk.io/store/types"
 )
@@ -51,3 +55,99 @@ func (ts *Store) WorkingHash() []byte {
 func (ts *Store) GetStoreType() types.StoreType {
 	return types.StoreTypeTransient
 }
+
+var (
+	_ types.Committer  = (*ObjStore)(nil)
+	_ types.ObjKVStore = (*ObjStore)(nil)
+)
+
+// ObjStore is a transient store of object values, which are kept in memory
+// without being serialized. It is emptied on Commit.
+type ObjStore struct {
+	tree btree.BTree[any]
+}
+
+// NewObjStore constructs a new ObjStore.
+func NewObjStore() *ObjStore {
+	return &ObjStore{tree: btree.NewBTree[any]()}
+}
+
+// Get implements types.ObjKVStore.
+func (ts *ObjStore) Get(key []byte) any {
+	types.AssertValidKey(key)
+	return ts.tree.Get(key)
+}
+
+// Has implements types.ObjKVStore.
+func (ts *ObjStore) Has(key []byte) bool {
+	return ts.Get(key) != nil
+}
+
+// Set implements types.ObjKVStore.
+func (ts *ObjStore) Set(key []byte, value any) {
+	types.AssertValidKey(key)
+	types.AssertValidObjValue(value)
+	ts.tree.Set(key, value)
+}
+
+// Delete implements types.ObjKVStore.
+func (ts *ObjStore) Delete(key []byte) {
+	types.AssertValidKey(key)
+	ts.tree.Delete(key)
+}
+
+// Iterator implements types.ObjKVStore.
+func (ts *ObjStore) Iterator(start, end []byte) types.ObjIterator {
+	it, err := ts.tree.Copy().Iterator(start, end)
+	if err != nil {
+		panic(err)
+	}
+	return it
+}
+
+// ReverseIterator implements types.ObjKVStore.
+func (ts *ObjStore) ReverseIterator(start, end []byte) types.ObjIterator {
+	it, err := ts.tree.Copy().ReverseIterator(start, end)
+	if err != nil {
+		panic(err)
+	}
+	return it
+}
+
+// GetStoreType implements Store.
+func (ts *ObjStore) GetStoreType() types.StoreType {
+	return types.StoreTypeObject
+}
+
+// CacheWrap implements CacheWrapper.
+func (ts *ObjStore) CacheWrap() types.CacheWrap {
+	return cachekv.NewObjStore(ts)
+}
+
+// CacheWrapWithTrace implements CacheWrapper, the object stores are not traced.
+func (ts *ObjStore) CacheWrapWithTrace(_ io.Writer, _ types.TraceContext) types.CacheWrap {
+	return ts.CacheWrap()
+}
+
+// Commit implements CommitStore, it empties the store.
+func (ts *ObjStore) Commit() (id types.CommitID) {
+	ts.tree = btree.NewBTree[any]()
+	return
+}
+
+func (ts *ObjStore) SetPruning(_ pruningtypes.PruningOptions) {}
+
+// GetPruning is a no-op as pruning options cannot be directly set on this store.
+// They must be set on the root commit multi-store.
+func (ts *ObjStore) GetPruning() pruningtypes.PruningOptions {
+	return pruningtypes.NewPruningOptions(pruningtypes.PruningUndefined)
+}
+
+// Implements CommitStore
+func (ts *ObjStore) LastCommitID() types.CommitID {
+	return types.CommitID{}
+}
+
+func (ts *ObjStore) WorkingHash() []byte {
+	return []byte{}
+}
@@ -8,6 +8,7 @@ import (
 
 	pruningtypes "cosmossdk.io/store/pruning/types"
 	"cosmossdk.io/store/transient"
+	"cosmossdk.io/store/types"
 )
 
 var k, v = []byte("hello"), []byte("world")
@@ -32,3 +33,32 @@ func TestTransientStore(t *testing.T) {
 	require.Equal(t, emptyCommitID.Version, int64(0))
 	require.True(t, bytes.Equal(emptyCommitID.Hash, nil))
 }
+
+func TestObjStore(t *testing.T) {
+	store := transient.NewObjStore()
+	obj := &struct{ count int }{1}
+
+	require.Nil(t, store.Get(k))
+	store.Set(k, obj)
+	require.Same(t, obj, store.Get(k))
+
+	// writes of a branch are kept until it is written to the store
+	cache := store.CacheWrap().(types.CacheObjKVStore)
+	cache.Set(v, 2)
+	require.Equal(t, 2, cache.Get(v))
+	require.Nil(t, store.Get(v))
+	cache.Write()
+	require.Equal(t, 2, store.Get(v))
+
+	it := store.Iterator(nil, nil)
+	var keys [][]byte
+	for ; it.Valid(); it.Next() {
+		keys = append(keys, it.Key())
+	}
+	require.NoError(t, it.Close())
+	require.Equal(t, [][]byte{k, v}, keys)
+
+	store.Commit()
+	require.Nil(t, store.Get(k))
+	require.Equal(t, types.StoreTypeObject, store.GetStoreType())
+}
@@ -0,0 +1,63 @@
+package types
+
+import "errors"
+
+// ErrExportDone is returned by a CommitmentExporter when all the nodes were exported.
+var ErrExportDone = errors.New("commitment export is complete")
+
+// CommitmentStore is a versioned CommitKVStore whose hash commits to its contents, such as
+// an IAVL tree. The root multistore builds its persistent stores through the commitment
+// backend registered for their store type, and queries, prunes, rolls back and snapshots
+// them through this interface.
+type CommitmentStore interface {
+	CommitKVStore
+	Queryable
+	StoreWithInitialVersion
+
+	// VersionExists returns whether the given version is stored.
+	VersionExists(version int64) bool
+
+	// GetImmutableKVStore returns a read-only store of the given version. An error is
+	// returned if the version does not exist or has been pruned.
+	GetImmutableKVStore(version int64) (KVStore, error)
+
+	// DeleteVersions deletes the given versions. The latest version cannot be deleted.
+	DeleteVersions(versions ...int64) error
+
+	// LoadVersionForOverwriting loads the given version, or the latest version below it,
+	// deleting all the versions above it. It returns the loaded version.
+	LoadVersionForOverwriting(targetVersion int64) (int64, error)
+
+	// SnapshotExporter returns an exporter of the nodes of the given version.
+	SnapshotExporter(version int64) (CommitmentExporter, error)
+
+	// SnapshotImporter returns an importer of the nodes of the given version into the
+	// store, which must be empty.
+	SnapshotImporter(version int64) (CommitmentImporter, error)
+}
+
+// CommitmentNode is a node of a commitment structure in a state sync snapshot. Leaf nodes
+// have a height of 0 and carry a key and a value; the nodes of a backend without inner
+// nodes are all leaves.
+type CommitmentNode struct {
+	Key     []byte
+	Value   []byte
+	Version int64
+	Height  int8
+}
+
+// CommitmentExporter exports the nodes of a version of a CommitmentStore.
+type CommitmentExporter interface {
+	// Next returns the next node, or ErrExportDone once all the nodes were exported.
+	Next() (*CommitmentNode, error)
+	Close()
+}
+
+// CommitmentImporter imports the nodes exported from a CommitmentStore, in the order they
+// were exported.
+type CommitmentImporter interface {
+	Add(node *CommitmentNode) error
+	// Commit persists the imported version.
+	Commit() error
+	Close()
+}
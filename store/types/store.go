@@ -46,11 +46,16 @@ type Queryable interface {
 //----------------------------------------
 // MultiStore
 
-// StoreUpgrades defines a series of transformations to apply the multistore db upon load
+// StoreUpgrades defines a series of transformations to apply the multistore db upon load.
+// The prefix upgrades are applied after the stores are added, renamed and
+// deleted: the prefixes are moved, then copied, then deleted, in order.
 type StoreUpgrades struct {
-	Added   []string      `json:"added"`
-	Renamed []StoreRename `json:"renamed"`
-	Deleted []string      `json:"deleted"`
+	Added         []string       `json:"added"`
+	Renamed       []StoreRename  `json:"renamed"`
+	Deleted       []string       `json:"deleted"`
+	PrefixMoved   []PrefixMove   `json:"prefix_moved"`
+	PrefixCopied  []PrefixCopy   `json:"prefix_copied"`
+	PrefixDeleted []PrefixDelete `json:"prefix_deleted"`
 }
 
 // StoreRename defines a name change of a sub-store.
@@ -61,6 +66,46 @@ type StoreRename struct {
 	NewKey string `json:"new_key"`
 }
 
+// PrefixMove defines a move of the data under a key prefix of a sub-store.
+// All data previously under OldPrefix in the OldStore store will be copied
+// under NewPrefix in the NewStore store, then deleted from OldStore.
+type PrefixMove struct {
+	OldStore  string `json:"old_store"`
+	OldPrefix []byte `json:"old_prefix"`
+	NewStore  string `json:"new_store"`
+	NewPrefix []byte `json:"new_prefix"`
+}
+
+// PrefixCopy defines a copy of the data under a key prefix of a sub-store.
+// All data under OldPrefix in the OldStore store will be copied under
+// NewPrefix in the NewStore store, rewritten by Transform if it is set.
+type PrefixCopy struct {
+	OldStore  string `json:"old_store"`
+	OldPrefix []byte `json:"old_prefix"`
+	NewStore  string `json:"new_store"`
+	NewPrefix []byte `json:"new_prefix"`
+
+	// Transform rewrites every pair, its key without OldPrefix, into the key
+	// to set under NewPrefix and its value. The pair is not copied if the
+	// returned value is nil, and the upgrade fails if an error is returned.
+	Transform func(key, value []byte) (newKey, newValue []byte, err error) `json:"-"`
+}
+
+// PrefixDelete defines a deletion of the data under a key prefix of a
+// sub-store.
+type PrefixDelete struct {
+	Store  string `json:"store"`
+	Prefix []byte `json:"prefix"`
+}
+
+// HasPrefixUpgrades returns true if prefixes should be moved, copied or deleted
+func (s *StoreUpgrades) HasPrefixUpgrades() bool {
+	if s == nil {
+		return false
+	}
+	return len(s.PrefixMoved) > 0 || len(s.PrefixCopied) > 0 || len(s.PrefixDeleted) > 0
+}
+
 // IsAdded returns true if the given key should be added
 func (s *StoreUpgrades) IsAdded(key string) bool {
 	if s == nil {
@@ -117,6 +162,7 @@ type MultiStore interface {
 	// If the store does not exist, panics.
 	GetStore(StoreKey) Store
 	GetKVStore(StoreKey) KVStore
+	GetObjKVStore(StoreKey) ObjKVStore
 
 	// TracingEnabled returns if tracing is enabled for the MultiStore.
 	TracingEnabled() bool
@@ -214,24 +260,36 @@ type CommitMultiStore interface {
 // KVStore
 
 // BasicKVStore is a simple interface to get/set data
-type BasicKVStore interface {
+type BasicKVStore = GBasicKVStore[[]byte]
+
+// GBasicKVStore is a simple interface to get/set data, generic over the type
+// of the values.
+type GBasicKVStore[V any] interface {
 	// Get returns nil if key doesn't exist. Panics on nil key.
-	Get(key []byte) []byte
+	Get(key []byte) V
 
 	// Has checks if a key exists. Panics on nil key.
 	Has(key []byte) bool
 
 	// Set sets the key. Panics on nil key or value.
-	Set(key, value []byte)
+	Set(key []byte, value V)
 
 	// Delete deletes the key. Panics on nil key.
 	Delete(key []byte)
 }
 
 // KVStore additionally provides iteration and deletion
-type KVStore interface {
+type KVStore = GKVStore[[]byte]
+
+// ObjKVStore is a KVStore of object values, which are kept without being
+// serialized.
+type ObjKVStore = GKVStore[any]
+
+// GKVStore additionally provides iteration and deletion, generic over the type
+// of the values.
+type GKVStore[V any] interface {
 	Store
-	BasicKVStore
+	GBasicKVStore[V]
 
 	// Iterator over a domain of keys in ascending order. End is exclusive.
 	// Start must be less than end, or the Iterator is invalid.
@@ -239,24 +297,61 @@ type KVStore interface {
 	// To iterate over entire domain, use store.Iterator(nil, nil)
 	// CONTRACT: No writes may happen within a domain while an iterator exists over it.
 	// Exceptionally allowed for cachekv.Store, safe to write in the modules.
-	Iterator(start, end []byte) Iterator
+	Iterator(start, end []byte) GIterator[V]
 
 	// Iterator over a domain of keys in descending order. End is exclusive.
 	// Start must be less than end, or the Iterator is invalid.
 	// Iterator must be closed by caller.
 	// CONTRACT: No writes may happen within a domain while an iterator exists over it.
 	// Exceptionally allowed for cachekv.Store, safe to write in the modules.
-	ReverseIterator(start, end []byte) Iterator
+	ReverseIterator(start, end []byte) GIterator[V]
 }
 
-// Iterator is an alias db's Iterator for convenience.
-type Iterator = dbm.Iterator
+// Iterator is the iterator of a KVStore, it has the same methods as db's
+// Iterator, so they can be used interchangeably.
+type Iterator = GIterator[[]byte]
+
+// ObjIterator is the iterator of an ObjKVStore.
+type ObjIterator = GIterator[any]
+
+// GIterator represents an iterator over a domain of keys, generic over the
+// type of the values.
+type GIterator[V any] interface {
+	// Domain returns the start (inclusive) and end (exclusive) limits of the iterator.
+	Domain() (start, end []byte)
+
+	// Valid returns whether the current iterator is valid. Once invalid, the Iterator remains
+	// invalid forever.
+	Valid() bool
+
+	// Next moves the iterator to the next key in the database, as defined by order of iteration.
+	// If Valid returns false, this method will panic.
+	Next()
+
+	// Key returns the key at the current position. Panics if the iterator is invalid.
+	Key() (key []byte)
+
+	// Value returns the value at the current position. Panics if the iterator is invalid.
+	Value() (value V)
+
+	// Error returns the last error encountered by the iterator, if any.
+	Error() error
+
+	// Close closes the iterator, releasing any allocated resources.
+	Close() error
+}
 
 // CacheKVStore branches a KVStore and provides read cache functionality.
 // After calling .Write() on the CacheKVStore, all previously created
 // CacheKVStores on the object expire.
-type CacheKVStore interface {
-	KVStore
+type CacheKVStore = GCacheKVStore[[]byte]
+
+// CacheObjKVStore branches an ObjKVStore and provides read cache functionality.
+type CacheObjKVStore = GCacheKVStore[any]
+
+// GCacheKVStore branches a GKVStore and provides read cache functionality.
+type GCacheKVStore[V any] interface {
+	GKVStore[V]
 
 	// Writes operations to underlying KVStore
 	Write()
@@ -316,6 +411,8 @@ const (
 	StoreTypeMemory
 	StoreTypeSMT
 	StoreTypePersistent
+	StoreTypeObject
+	StoreTypeFlat
 )
 
 func (st StoreType) String() string {
@@ -340,6 +437,12 @@ func (st StoreType) String() string {
 
 	case StoreTypePersistent:
 		return "StoreTypePersistent"
+
+	case StoreTypeObject:
+		return "StoreTypeObject"
+
+	case StoreTypeFlat:
+		return "StoreTypeFlat"
 	}
 
 	return "unknown store type"
@@ -438,6 +541,28 @@ func (key *MemoryStoreKey) String() string {
 	return fmt.Sprintf("MemoryStoreKey{%p, %s}", key, key.name)
 }
 
+// ObjectStoreKey is used for indexing transient stores of object values in a
+// MultiStore.
+type ObjectStoreKey struct {
+	name string
+}
+
+// NewObjectStoreKey constructs a new ObjectStoreKey.
+// Must return a pointer according to the ocap principle.
+func NewObjectStoreKey(name string) *ObjectStoreKey {
+	return &ObjectStoreKey{name: name}
+}
+
+// Name returns the name of the ObjectStoreKey.
+func (key *ObjectStoreKey) Name() string {
+	return key.name
+}
+
+// String returns a stringified representation of the ObjectStoreKey.
+func (key *ObjectStoreKey) String() string {
+	return fmt.Sprintf("ObjectStoreKey{%p, %s}", key, key.name)
+}
+
 //----------------------------------------
 
 // TraceContext contains TraceKVStore context data. It will be written with
@@ -516,3 +641,17 @@ func NewMemoryStoreKeys(names ...string) map[string]*MemoryStoreKey {
 
 	return keys
 }
+
+// NewObjectStoreKeys constructs a new map matching store key names to their
+// respective ObjectStoreKey references.
+// The function will panic if there is a potential conflict in names (see `assertNoPrefix`
+// function for more details).
+func NewObjectStoreKeys(names ...string) map[string]*ObjectStoreKey {
+	assertNoCommonPrefix(names)
+	keys := make(map[string]*ObjectStoreKey)
+	for _, n := range names {
+		keys[n] = NewObjectStoreKey(n)
+	}
+
+	return keys
+}
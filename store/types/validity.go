@@ -26,3 +26,11 @@ func AssertValidValue(value []byte) {
 		panic("value is too large")
 	}
 }
+
+// AssertValidObjValue checks if the value of an object store is valid (value
+// is not nil).
+func AssertValidObjValue(value any) {
+	if value == nil {
+		panic("value is nil")
+	}
+}
@@ -3,11 +3,13 @@ package nft
 import (
 	"fmt"
 
+	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/suite"
 
 	"cosmossdk.io/x/nft"
-	"cosmossdk.io/x/nft/client/cli"
+	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
 	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
 	"github.com/cosmos/cosmos-sdk/testutil/network"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -93,7 +95,6 @@ func (s *E2ETestSuite) TearDownSuite() {
 }
 
 func (s *E2ETestSuite) TestCLITxSend() {
-	cmd := cli.NewCmdSend()
 	val := s.network.Validators[0]
 	args := []string{
 		fmt.Sprintf("--%s=%s", flags.FlagFrom, OwnerName),
@@ -103,16 +104,17 @@ func (s *E2ETestSuite) TestCLITxSend() {
 	}
 	testCases := []struct {
 		name         string
-		args         []string
+		msg          *nft.MsgSend
 		expectedCode uint32
 		expectErr    bool
 	}{
 		{
 			"valid transaction",
-			[]string{
-				testClassID,
-				testID,
-				val.Address.String(),
+			&nft.MsgSend{
+				ClassId:  testClassID,
+				Id:       testID,
+				Sender:   s.owner.String(),
+				Receiver: val.Address.String(),
 			},
 			0,
 			false,
@@ -123,9 +125,8 @@ func (s *E2ETestSuite) TestCLITxSend() {
 		tc := tc
 		s.Run(tc.name, func() {
 			clientCtx := val.ClientCtx
-			args = append(args, tc.args...)
 
-			out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cmd, args)
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, newBroadcastMsgCmd(tc.msg), args)
 			if tc.expectErr {
 				s.Require().Error(err)
 			} else {
@@ -138,6 +139,25 @@ func (s *E2ETestSuite) TestCLITxSend() {
 	}
 }
 
+// newBroadcastMsgCmd returns a command signing and broadcasting the message,
+// the nft transaction commands being generated by autocli.
+func newBroadcastMsgCmd(msg sdk.Msg) *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "broadcast-msg",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 func (s *E2ETestSuite) initAccount() {
 	val := s.network.Validators[0]
 	ctx := val.ClientCtx
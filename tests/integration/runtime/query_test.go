@@ -1,6 +1,7 @@
 package runtime
 
 import (
+	"strings"
 	"testing"
 
 	reflectionv1 "cosmossdk.io/api/cosmos/reflection/v1"
@@ -15,12 +16,16 @@ import (
 	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
 	"cosmossdk.io/depinject"
 	"cosmossdk.io/log"
+	"cosmossdk.io/math"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/testutil/configurator"
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
 	_ "github.com/cosmos/cosmos-sdk/x/auth"
 	_ "github.com/cosmos/cosmos-sdk/x/auth/tx/config"
@@ -32,6 +37,7 @@ import (
 
 type fixture struct {
 	ctx               sdk.Context
+	accountKeeper     authkeeper.AccountKeeper
 	appQueryClient    appv1alpha1.QueryClient
 	autocliInfoClient autocliv1.QueryClient
 	reflectionClient  reflectionv1.ReflectionServiceClient
@@ -55,6 +61,7 @@ func initFixture(t assert.TestingT) *fixture {
 			depinject.Supply(log.NewNopLogger()),
 		),
 		&interfaceRegistry,
+		&f.accountKeeper,
 	)
 	assert.NilError(t, err)
 
@@ -124,6 +131,103 @@ func TestReflectionService(t *testing.T) {
 	})
 }
 
+func TestReflectionServiceCollections(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	res, err := f.reflectionClient.CollectionsSchemas(f.ctx, &reflectionv1.CollectionsSchemasRequest{})
+	assert.NilError(t, err)
+
+	// make sure we have the x/bank collections schema
+	var bankSchema *reflectionv1.ModuleCollectionsSchema
+	for _, schema := range res.Schemas {
+		if schema.Module == banktypes.ModuleName {
+			bankSchema = schema
+		}
+	}
+	assert.Assert(t, bankSchema != nil)
+
+	collections := map[string]*reflectionv1.CollectionDescriptor{}
+	for _, coll := range bankSchema.Collections {
+		collections[coll.Name] = coll
+	}
+	supply := collections["supply"]
+	assert.Assert(t, supply != nil)
+	assert.DeepEqual(t, banktypes.SupplyKey.Bytes(), supply.Prefix)
+	assert.Equal(t, "string", supply.KeyType)
+	assert.Equal(t, "math.Int", supply.ValueType)
+
+	// decode a supply store pair
+	value, err := sdk.IntValue.Encode(math.NewInt(100))
+	assert.NilError(t, err)
+	decoded, err := f.reflectionClient.DecodeStorePair(f.ctx, &reflectionv1.DecodeStorePairRequest{
+		Module: banktypes.ModuleName,
+		Key:    append(banktypes.SupplyKey.Bytes(), "stake"...),
+		Value:  value,
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, "supply", decoded.Collection)
+	assert.Equal(t, `"stake"`, decoded.KeyJson)
+	assert.Equal(t, `"100"`, decoded.ValueJson)
+
+	// decode a deleted supply store pair
+	decoded, err = f.reflectionClient.DecodeStorePair(f.ctx, &reflectionv1.DecodeStorePairRequest{
+		Module: banktypes.ModuleName,
+		Key:    append(banktypes.SupplyKey.Bytes(), "stake"...),
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, `"stake"`, decoded.KeyJson)
+	assert.Equal(t, "", decoded.ValueJson)
+
+	// modules without collections schema are not supported
+	_, err = f.reflectionClient.DecodeStorePair(f.ctx, &reflectionv1.DecodeStorePairRequest{
+		Module: "params",
+		Key:    []byte{0x01},
+	})
+	assert.ErrorContains(t, err, "module params has no collections schema")
+}
+
+func TestReflectionServiceDecodeAuthPair(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	res, err := f.reflectionClient.CollectionsSchemas(f.ctx, &reflectionv1.CollectionsSchemasRequest{})
+	assert.NilError(t, err)
+
+	modules := map[string]bool{}
+	for _, schema := range res.Schemas {
+		modules[schema.Module] = true
+	}
+	assert.Assert(t, modules[authtypes.ModuleName])
+	assert.Assert(t, modules["consensus"])
+
+	// decode an account store pair
+	addr := sdk.AccAddress([]byte("addr1_______________"))
+	acc := f.accountKeeper.NewAccountWithAddress(f.ctx, addr)
+	value, err := f.accountKeeper.MarshalAccount(acc)
+	assert.NilError(t, err)
+	decoded, err := f.reflectionClient.DecodeStorePair(f.ctx, &reflectionv1.DecodeStorePairRequest{
+		Module: authtypes.ModuleName,
+		Key:    authtypes.AddressStoreKey(addr),
+		Value:  value,
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, "accounts", decoded.Collection)
+	assert.Equal(t, `"`+addr.String()+`"`, decoded.KeyJson)
+	assert.Assert(t, strings.Contains(decoded.ValueJson, `"@type":"/cosmos.auth.v1beta1.BaseAccount"`), decoded.ValueJson)
+	assert.Assert(t, strings.Contains(decoded.ValueJson, addr.String()), decoded.ValueJson)
+
+	// decode an account number store pair
+	decoded, err = f.reflectionClient.DecodeStorePair(f.ctx, &reflectionv1.DecodeStorePairRequest{
+		Module: authtypes.ModuleName,
+		Key:    authtypes.AccountNumberStoreKey(acc.GetAccountNumber()),
+		Value:  addr,
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, "accounts_by_number", decoded.Collection)
+	assert.Equal(t, `"`+addr.String()+`"`, decoded.ValueJson)
+}
+
 func TestQueryAutoCLIAppOptions(t *testing.T) {
 	t.Parallel()
 	f := initFixture(t)
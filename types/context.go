@@ -46,6 +46,7 @@ type Context struct {
 	priority             int64 // The tx priority, only relevant in CheckTx
 	kvGasConfig          storetypes.GasConfig
 	transientKVGasConfig storetypes.GasConfig
+	storeKVGasConfigs    map[string]storetypes.GasConfig
 	streamingManager     storetypes.StreamingManager
 	cometInfo            comet.BlockInfo
 	headerInfo           header.Info
@@ -93,6 +94,12 @@ func (c Context) ConsensusParams() cmtproto.ConsensusParams {
 	return c.consParams
 }
 
+// StoreKVGasConfigs returns the gas configurations of the KVStores, by store
+// key name, overriding the KVStore gas configuration.
+func (c Context) StoreKVGasConfigs() map[string]storetypes.GasConfig {
+	return c.storeKVGasConfigs
+}
+
 func (c Context) Deadline() (deadline time.Time, ok bool) {
 	return c.baseCtx.Deadline()
 }
@@ -219,6 +226,14 @@ func (c Context) WithKVGasConfig(gasConfig storetypes.GasConfig) Context {
 	return c
 }
 
+// WithStoreKVGasConfigs returns a Context with updated gas configurations for
+// the KVStores of the given store key names, overriding the KVStore gas
+// configuration. The map must not be modified afterwards.
+func (c Context) WithStoreKVGasConfigs(gasConfigs map[string]storetypes.GasConfig) Context {
+	c.storeKVGasConfigs = gasConfigs
+	return c
+}
+
 // WithTransientKVGasConfig returns a Context with an updated gas configuration for
 // the transient KVStore
 func (c Context) WithTransientKVGasConfig(gasConfig storetypes.GasConfig) Context {
@@ -306,9 +321,16 @@ func (c Context) Value(key interface{}) interface{} {
 // Store / Caching
 // ----------------------------------------------------------------------------
 
-// KVStore fetches a KVStore from the MultiStore.
+// KVStore fetches a KVStore from the MultiStore. Its accesses consume gas
+// according to the gas configuration of the store, if any, or to the KVStore
+// gas configuration.
 func (c Context) KVStore(key storetypes.StoreKey) storetypes.KVStore {
-	return gaskv.NewStore(c.ms.GetKVStore(key), c.gasMeter, c.kvGasConfig)
+	gasConfig, ok := c.storeKVGasConfigs[key.Name()]
+	if !ok {
+		gasConfig = c.kvGasConfig
+	}
+
+	return gaskv.NewStore(c.ms.GetKVStore(key), c.gasMeter, gasConfig)
 }
 
 // TransientStore fetches a TransientStore from the MultiStore.
@@ -316,6 +338,12 @@ func (c Context) TransientStore(key storetypes.StoreKey) storetypes.KVStore {
 	return gaskv.NewStore(c.ms.GetKVStore(key), c.gasMeter, c.transientKVGasConfig)
 }
 
+// ObjectStore fetches an object store from the MultiStore. The accesses to
+// object stores do not consume gas.
+func (c Context) ObjectStore(key storetypes.StoreKey) storetypes.ObjKVStore {
+	return c.ms.GetObjKVStore(key)
+}
+
 // CacheContext returns a new Context with the multi-store cached and a new
 // EventManager. The cached context is written to the context when writeCache
 // is called. Note, events are automatically emitted on the parent context's
@@ -59,6 +59,25 @@ func (s *contextTestSuite) TestCacheContext() {
 	s.Require().Len(ctx.EventManager().Events(), 2)
 }
 
+func (s *contextTestSuite) TestStoreKVGasConfigs() {
+	key := storetypes.NewKVStoreKey(s.T().Name() + "_TestStoreKVGasConfigs")
+	cheapConfig := storetypes.GasConfig{ReadCostFlat: 1, WriteCostFlat: 2}
+
+	ctx := testutil.DefaultContext(key, storetypes.NewTransientStoreKey("transient_"+s.T().Name()))
+	ctx = ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+	ctx.KVStore(key).Set([]byte("key"), []byte{})
+	defaultConfig := storetypes.KVGasConfig()
+	s.Require().Equal(defaultConfig.WriteCostFlat+3*defaultConfig.WriteCostPerByte, ctx.GasMeter().GasConsumed())
+
+	ctx = ctx.WithStoreKVGasConfigs(map[string]storetypes.GasConfig{key.Name(): cheapConfig})
+	s.Require().Equal(cheapConfig, ctx.StoreKVGasConfigs()[key.Name()])
+
+	ctx = ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+	ctx.KVStore(key).Set([]byte("key"), []byte{})
+	ctx.KVStore(key).Get([]byte("key"))
+	s.Require().Equal(uint64(3), ctx.GasMeter().GasConsumed())
+}
+
 func (s *contextTestSuite) TestLogContext() {
 	key := storetypes.NewKVStoreKey(s.T().Name())
 	ctx := testutil.DefaultContext(key, storetypes.NewTransientStoreKey("transient_"+s.T().Name()))
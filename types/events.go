@@ -260,6 +260,7 @@ const (
 	AttributeKeySignature       = "signature"
 	AttributeKeyFee             = "fee"
 	AttributeKeyFeePayer        = "fee_payer"
+	AttributeKeyFeeRefund       = "fee_refund"
 
 	EventTypeMessage = "message"
 
@@ -5,6 +5,7 @@ import (
 	"errors"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 )
 
 type Mempool interface {
@@ -25,6 +26,26 @@ type Mempool interface {
 	Remove(sdk.Tx) error
 }
 
+// Introspector defines an optional extension of the Mempool interface which
+// allows the transactions in the mempool to be inspected without modifying the
+// mempool, e.g. to serve queries.
+type Introspector interface {
+	Mempool
+
+	// Walk calls fn for each transaction in the mempool, with the transaction's
+	// sender and nonce, until fn returns true. The order of the transactions is
+	// determined by the mempool implementation.
+	Walk(fn func(sender string, nonce uint64, tx sdk.Tx) (stop bool))
+
+	// WalkSender calls fn for each transaction of the given sender in the
+	// mempool, in nonce order, until fn returns true.
+	WalkSender(sender string, fn func(sender string, nonce uint64, tx sdk.Tx) (stop bool))
+
+	// SenderCounts returns the number of transactions in the mempool for each
+	// sender with at least one transaction.
+	SenderCounts() map[string]int
+}
+
 // Iterator defines an app-side mempool iterator interface that is as minimal as
 // possible. The order of iteration is determined by the app-side mempool
 // implementation.
@@ -41,3 +62,24 @@ var (
 	ErrTxNotFound           = errors.New("tx not found in mempool")
 	ErrMempoolTxMaxCapacity = errors.New("pool reached max tx capacity")
 )
+
+// txNonce returns the nonce used to order the given tx of the sender whose
+// signature is sig, along with the id distinguishing the unordered txs sharing
+// a nonce. Unordered txs are not bound to the sender's sequence, so their timeout
+// timestamp, in unix nanoseconds, or their timeout height if the timestamp is
+// not set, is used instead. As several unordered txs of a sender may share a
+// timeout, which may also be the sequence of an ordered tx, they are identified
+// by their unordered ID, as in the x/auth replay protection. The id of ordered
+// txs is empty.
+func txNonce(tx sdk.Tx, sig signing.SignatureV2) (uint64, string) {
+	unorderedTx, ok := tx.(sdk.TxWithUnordered)
+	if !ok || !unorderedTx.GetUnordered() {
+		return sig.Sequence, ""
+	}
+
+	if timeoutTimestamp := unorderedTx.GetTimeoutTimeStamp(); !timeoutTimestamp.IsZero() {
+		return uint64(timeoutTimestamp.UnixNano()), string(unorderedTx.GetUnorderedID())
+	}
+
+	return unorderedTx.GetTimeoutHeight(), string(unorderedTx.GetUnorderedID())
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"math"
+	"sync"
 
 	"github.com/huandu/skiplist"
 
@@ -12,8 +13,9 @@ import (
 )
 
 var (
-	_ Mempool  = (*PriorityNonceMempool[int64])(nil)
-	_ Iterator = (*PriorityNonceIterator[int64])(nil)
+	_ Mempool      = (*PriorityNonceMempool[int64])(nil)
+	_ Introspector = (*PriorityNonceMempool[int64])(nil)
+	_ Iterator     = (*PriorityNonceIterator[int64])(nil)
 )
 
 type (
@@ -29,18 +31,29 @@ type (
 		// TxReplacement is a callback to be called when duplicated transaction nonce
 		// detected during mempool insert. An application can define a transaction
 		// replacement rule based on tx priority or certain transaction fields.
+		// If nil, a duplicated transaction always replaces the existing one. See
+		// NewPriorityTxReplacement for a replace-by-fee rule.
 		TxReplacement func(op, np C, oTx, nTx sdk.Tx) bool
 
 		// MaxTx sets the maximum number of transactions allowed in the mempool with
 		// the semantics:
 		// - if MaxTx == 0, there is no cap on the number of transactions in the mempool
 		// - if MaxTx > 0, the mempool will cap the number of transactions it stores,
-		//   and will prioritize transactions by their priority and sender-nonce
-		//   (sequence number) when evicting transactions.
+		//   and will apply the EvictionPolicy when a new transaction is inserted
+		//   while the mempool is full.
 		// - if MaxTx < 0, `Insert` is a no-op.
 		MaxTx int
+
+		// EvictionPolicy defines how a full mempool (see MaxTx) handles the
+		// insertion of a new transaction. The default, EvictionPolicyNone, rejects
+		// the transaction with ErrMempoolTxMaxCapacity.
+		EvictionPolicy EvictionPolicy
 	}
 
+	// EvictionPolicy defines which transaction, if any, is removed from a full
+	// PriorityNonceMempool to make room for a new transaction.
+	EvictionPolicy int
+
 	// PriorityNonceMempool is a mempool implementation that stores txs
 	// in a partially ordered set by 2 dimensions: priority, and sender-nonce
 	// (sequence number). Internally it uses one priority ordered skip list and one
@@ -49,6 +62,10 @@ type (
 	// priority to other sender txs and must be partially ordered by both sender-nonce
 	// and priority.
 	PriorityNonceMempool[C comparable] struct {
+		// mtx guards the indices against the concurrent introspection of the
+		// mempool, e.g. from gRPC queries.
+		mtx sync.RWMutex
+
 		priorityIndex  *skiplist.SkipList
 		priorityCounts map[C]int
 		senderIndices  map[string]*skiplist.SkipList
@@ -86,6 +103,9 @@ type (
 	txMeta[C comparable] struct {
 		// nonce is the sender's sequence number
 		nonce uint64
+		// id distinguishes the unordered transactions sharing a nonce, it is empty
+		// for ordered transactions
+		id string
 		// priority is the transaction's priority
 		priority C
 		// sender is the transaction's sender
@@ -98,6 +118,19 @@ type (
 	}
 )
 
+const (
+	// EvictionPolicyNone never evicts a transaction, inserting into a full
+	// mempool fails with ErrMempoolTxMaxCapacity.
+	EvictionPolicyNone EvictionPolicy = iota
+
+	// EvictionPolicyLowestPriority evicts the lowest priority transaction if it
+	// has a strictly lower priority than the transaction being inserted. Only a
+	// sender's highest nonce transaction may be evicted so that no nonce gap is
+	// created. If no transaction can be evicted, the insert fails with
+	// ErrMempoolTxMaxCapacity.
+	EvictionPolicyLowestPriority
+)
+
 // NewDefaultTxPriority returns a TxPriority comparator using ctx.Priority as
 // the defining transaction priority.
 func NewDefaultTxPriority() TxPriority[int64] {
@@ -112,6 +145,15 @@ func NewDefaultTxPriority() TxPriority[int64] {
 	}
 }
 
+// NewPriorityTxReplacement returns a TxReplacement rule that implements
+// replace-by-fee: a transaction replaces an existing transaction with the same
+// sender and nonce only if it has a strictly higher priority.
+func NewPriorityTxReplacement[C comparable](txPriority TxPriority[C]) func(op, np C, oTx, nTx sdk.Tx) bool {
+	return func(op, np C, _, _ sdk.Tx) bool {
+		return txPriority.Compare(np, op) > 0
+	}
+}
+
 func DefaultPriorityNonceMempoolConfig() PriorityNonceMempoolConfig[int64] {
 	return PriorityNonceMempoolConfig[int64]{
 		TxPriority: NewDefaultTxPriority(),
@@ -119,7 +161,7 @@ func DefaultPriorityNonceMempoolConfig() PriorityNonceMempoolConfig[int64] {
 }
 
 // skiplistComparable is a comparator for txKeys that first compares priority,
-// then weight, then sender, then nonce, uniquely identifying a transaction.
+// then weight, then sender, then nonce and id, uniquely identifying a transaction.
 //
 // Note, skiplistComparable is used as the comparator in the priority index.
 func skiplistComparable[C comparable](txPriority TxPriority[C]) skiplist.Comparable {
@@ -148,10 +190,25 @@ func skiplistComparable[C comparable](txPriority TxPriority[C]) skiplist.Compara
 			return res
 		}
 
-		return skiplist.Uint64.Compare(keyA.nonce, keyB.nonce)
+		return compareNonce(keyA, keyB)
 	})
 }
 
+// compareNonce compares the nonce, then the id of two txKeys.
+func compareNonce[C comparable](keyA, keyB txMeta[C]) int {
+	res := skiplist.Uint64.Compare(keyA.nonce, keyB.nonce)
+	if res != 0 {
+		return res
+	}
+
+	return skiplist.String.Compare(keyA.id, keyB.id)
+}
+
+// scoreKey returns the key of the tx in the scores map.
+func (m txMeta[C]) scoreKey() txMeta[C] {
+	return txMeta[C]{nonce: m.nonce, sender: m.sender, id: m.id}
+}
+
 // NewPriorityMempool returns the SDK's default mempool implementation which
 // returns txs in a partial order by 2 dimensions; priority, and sender-nonce.
 func NewPriorityMempool[C comparable](cfg PriorityNonceMempoolConfig[C]) *PriorityNonceMempool[C] {
@@ -175,12 +232,19 @@ func DefaultPriorityMempool() *PriorityNonceMempool[int64] {
 // i.e. the next valid transaction for the sender. If no such transaction exists,
 // nil will be returned.
 func (mp *PriorityNonceMempool[C]) NextSenderTx(sender string) sdk.Tx {
+	mp.mtx.RLock()
+	defer mp.mtx.RUnlock()
+
 	senderIndex, ok := mp.senderIndices[sender]
 	if !ok {
 		return nil
 	}
 
 	cursor := senderIndex.Front()
+	if cursor == nil {
+		return nil
+	}
+
 	return cursor.Value.(sdk.Tx)
 }
 
@@ -192,11 +256,15 @@ func (mp *PriorityNonceMempool[C]) NextSenderTx(sender string) sdk.Tx {
 // O(log n) no-op.
 //
 // Inserting a duplicate tx with a different priority overwrites the existing tx,
-// changing the total order of the mempool.
+// changing the total order of the mempool, if allowed by the TxReplacement rule.
+//
+// When the mempool is full, the configured EvictionPolicy decides whether an
+// existing tx is evicted to make room for the new one.
 func (mp *PriorityNonceMempool[C]) Insert(ctx context.Context, tx sdk.Tx) error {
-	if mp.cfg.MaxTx > 0 && mp.CountTx() >= mp.cfg.MaxTx {
-		return ErrMempoolTxMaxCapacity
-	} else if mp.cfg.MaxTx < 0 {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	if mp.cfg.MaxTx < 0 {
 		return nil
 	}
 
@@ -211,13 +279,23 @@ func (mp *PriorityNonceMempool[C]) Insert(ctx context.Context, tx sdk.Tx) error
 	sig := sigs[0]
 	sender := sdk.AccAddress(sig.PubKey.Address()).String()
 	priority := mp.cfg.TxPriority.GetTxPriority(ctx, tx)
-	nonce := sig.Sequence
-	key := txMeta[C]{nonce: nonce, priority: priority, sender: sender}
+	nonce, id := txNonce(tx, sig)
+	key := txMeta[C]{nonce: nonce, id: id, priority: priority, sender: sender}
+
+	// A replacement does not change the number of txs in the mempool, so the
+	// capacity is only checked for new txs.
+	sk := key.scoreKey()
+	oldScore, txExists := mp.scores[sk]
+	if !txExists && mp.cfg.MaxTx > 0 && mp.priorityIndex.Len() >= mp.cfg.MaxTx {
+		if err := mp.evict(sender, nonce, priority); err != nil {
+			return err
+		}
+	}
 
 	senderIndex, ok := mp.senderIndices[sender]
 	if !ok {
 		senderIndex = skiplist.New(skiplist.LessThanFunc(func(a, b any) int {
-			return skiplist.Uint64.Compare(b.(txMeta[C]).nonce, a.(txMeta[C]).nonce)
+			return compareNonce(b.(txMeta[C]), a.(txMeta[C]))
 		}))
 
 		// initialize sender index if not found
@@ -231,8 +309,7 @@ func (mp *PriorityNonceMempool[C]) Insert(ctx context.Context, tx sdk.Tx) error
 	//
 	// This O(log n) remove operation is rare and only happens when a tx's priority
 	// changes.
-	sk := txMeta[C]{nonce: nonce, sender: sender}
-	if oldScore, txExists := mp.scores[sk]; txExists {
+	if txExists {
 		if mp.cfg.TxReplacement != nil && !mp.cfg.TxReplacement(oldScore.priority, priority, senderIndex.Get(key).Value.(sdk.Tx), tx) {
 			return fmt.Errorf(
 				"tx doesn't fit the replacement rule, oldPriority: %v, newPriority: %v, oldTx: %v, newTx: %v",
@@ -245,17 +322,21 @@ func (mp *PriorityNonceMempool[C]) Insert(ctx context.Context, tx sdk.Tx) error
 
 		mp.priorityIndex.Remove(txMeta[C]{
 			nonce:    nonce,
+			id:       id,
 			sender:   sender,
 			priority: oldScore.priority,
 			weight:   oldScore.weight,
 		})
 		mp.priorityCounts[oldScore.priority]--
+
+		// Since senderIndex is scored by nonce, setting the new key would only
+		// overwrite the value and keep the key holding the old priority, so the
+		// old element must be removed first.
+		senderIndex.Remove(key)
 	}
 
 	mp.priorityCounts[priority]++
 
-	// Since senderIndex is scored by nonce, a changed priority will overwrite the
-	// existing key.
 	key.senderElement = senderIndex.Set(key, tx)
 
 	mp.scores[sk] = txMeta[C]{priority: priority}
@@ -264,6 +345,37 @@ func (mp *PriorityNonceMempool[C]) Insert(ctx context.Context, tx sdk.Tx) error
 	return nil
 }
 
+// evict applies the configured EvictionPolicy to make room for a tx with the
+// given sender, nonce and priority. It returns ErrMempoolTxMaxCapacity if no tx
+// was evicted.
+func (mp *PriorityNonceMempool[C]) evict(sender string, nonce uint64, priority C) error {
+	if mp.cfg.EvictionPolicy != EvictionPolicyLowestPriority {
+		return ErrMempoolTxMaxCapacity
+	}
+
+	// The priority index is ordered from highest to lowest priority, so walk it
+	// backwards until a tx with a priority not lower than the new tx is found.
+	for node := mp.priorityIndex.Back(); node != nil; node = node.Prev() {
+		key := node.Key().(txMeta[C])
+		if mp.cfg.TxPriority.Compare(key.priority, priority) >= 0 {
+			break
+		}
+
+		// Only a sender's last tx can be evicted, otherwise the sender's remaining
+		// txs would have a nonce gap. For the same reason, the new tx's sender
+		// cannot lose a tx preceding the new one.
+		last := mp.senderIndices[key.sender].Back().Key().(txMeta[C])
+		if last.scoreKey() != key.scoreKey() || (key.sender == sender && key.nonce < nonce) {
+			continue
+		}
+
+		mp.remove(key)
+		return nil
+	}
+
+	return ErrMempoolTxMaxCapacity
+}
+
 func (i *PriorityNonceIterator[C]) iteratePriority() Iterator {
 	// beginning of priority iteration
 	if i.priorityNode == nil {
@@ -317,7 +429,7 @@ func (i *PriorityNonceIterator[C]) Next() Iterator {
 	} else if i.mempool.cfg.TxPriority.Compare(key.priority, i.nextPriority) == 0 {
 		// Weight is incorporated into the priority index key only (not sender index)
 		// so we must fetch it here from the scores map.
-		weight := i.mempool.scores[txMeta[C]{nonce: key.nonce, sender: key.sender}].weight
+		weight := i.mempool.scores[key.scoreKey()].weight
 		if i.mempool.cfg.TxPriority.Compare(weight, i.priorityNode.Next().Key().(txMeta[C]).weight) < 0 {
 			return i.iteratePriority()
 		}
@@ -341,6 +453,9 @@ func (i *PriorityNonceIterator[C]) Tx() sdk.Tx {
 // NOTE: It is not safe to use this iterator while removing transactions from
 // the underlying mempool.
 func (mp *PriorityNonceMempool[C]) Select(_ context.Context, _ [][]byte) Iterator {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
 	if mp.priorityIndex.Len() == 0 {
 		return nil
 	}
@@ -378,9 +493,9 @@ func (mp *PriorityNonceMempool[C]) reorderPriorityTies() {
 
 	for _, k := range reordering {
 		mp.priorityIndex.Remove(k.deleteKey)
-		delete(mp.scores, txMeta[C]{nonce: k.deleteKey.nonce, sender: k.deleteKey.sender})
+		delete(mp.scores, k.deleteKey.scoreKey())
 		mp.priorityIndex.Set(k.insertKey, k.tx)
-		mp.scores[txMeta[C]{nonce: k.insertKey.nonce, sender: k.insertKey.sender}] = k.insertKey
+		mp.scores[k.insertKey.scoreKey()] = k.insertKey
 	}
 }
 
@@ -409,12 +524,67 @@ func senderWeight[C comparable](txPriority TxPriority[C], senderCursor *skiplist
 
 // CountTx returns the number of transactions in the mempool.
 func (mp *PriorityNonceMempool[C]) CountTx() int {
+	mp.mtx.RLock()
+	defer mp.mtx.RUnlock()
+
 	return mp.priorityIndex.Len()
 }
 
+// Walk calls fn for each tx in the mempool in priority order, from the highest
+// to the lowest, until fn returns true. Contrary to Select, the sender-nonce
+// order is not enforced and the mempool is not modified. fn must not modify the
+// mempool.
+func (mp *PriorityNonceMempool[C]) Walk(fn func(sender string, nonce uint64, tx sdk.Tx) (stop bool)) {
+	mp.mtx.RLock()
+	defer mp.mtx.RUnlock()
+
+	for node := mp.priorityIndex.Front(); node != nil; node = node.Next() {
+		key := node.Key().(txMeta[C])
+		if fn(key.sender, key.nonce, node.Value.(sdk.Tx)) {
+			return
+		}
+	}
+}
+
+// WalkSender calls fn for each tx of the given sender in nonce order until fn
+// returns true. fn must not modify the mempool.
+func (mp *PriorityNonceMempool[C]) WalkSender(sender string, fn func(sender string, nonce uint64, tx sdk.Tx) (stop bool)) {
+	mp.mtx.RLock()
+	defer mp.mtx.RUnlock()
+
+	senderIndex, ok := mp.senderIndices[sender]
+	if !ok {
+		return
+	}
+
+	for node := senderIndex.Front(); node != nil; node = node.Next() {
+		if fn(sender, node.Key().(txMeta[C]).nonce, node.Value.(sdk.Tx)) {
+			return
+		}
+	}
+}
+
+// SenderCounts returns the number of txs in the mempool for each sender.
+func (mp *PriorityNonceMempool[C]) SenderCounts() map[string]int {
+	mp.mtx.RLock()
+	defer mp.mtx.RUnlock()
+
+	counts := make(map[string]int, len(mp.senderIndices))
+	for sender, senderIndex := range mp.senderIndices {
+		if senderIndex.Len() > 0 {
+			counts[sender] = senderIndex.Len()
+		}
+	}
+
+	return counts
+}
+
 // Remove removes a transaction from the mempool in O(log n) time, returning an
 // error if unsuccessful.
 func (mp *PriorityNonceMempool[C]) Remove(tx sdk.Tx) error {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
 	sigs, err := tx.(signing.SigVerifiableTx).GetSignaturesV2()
 	if err != nil {
 		return err
@@ -425,28 +595,32 @@ func (mp *PriorityNonceMempool[C]) Remove(tx sdk.Tx) error {
 
 	sig := sigs[0]
 	sender := sdk.AccAddress(sig.PubKey.Address()).String()
-	nonce := sig.Sequence
+	nonce, id := txNonce(tx, sig)
 
-	scoreKey := txMeta[C]{nonce: nonce, sender: sender}
+	scoreKey := txMeta[C]{nonce: nonce, id: id, sender: sender}
 	score, ok := mp.scores[scoreKey]
 	if !ok {
 		return ErrTxNotFound
 	}
-	tk := txMeta[C]{nonce: nonce, priority: score.priority, sender: sender, weight: score.weight}
+	tk := txMeta[C]{nonce: nonce, id: id, priority: score.priority, sender: sender, weight: score.weight}
 
-	senderTxs, ok := mp.senderIndices[sender]
-	if !ok {
+	if _, ok := mp.senderIndices[sender]; !ok {
 		return fmt.Errorf("sender %s not found", sender)
 	}
 
-	mp.priorityIndex.Remove(tk)
-	senderTxs.Remove(tk)
-	delete(mp.scores, scoreKey)
-	mp.priorityCounts[score.priority]--
-
+	mp.remove(tk)
 	return nil
 }
 
+// remove deletes the tx identified by key from all indices. The key must exist
+// in the mempool.
+func (mp *PriorityNonceMempool[C]) remove(key txMeta[C]) {
+	mp.priorityIndex.Remove(key)
+	mp.senderIndices[key.sender].Remove(key)
+	delete(mp.scores, key.scoreKey())
+	mp.priorityCounts[key.priority]--
+}
+
 func IsEmpty[C comparable](mempool Mempool) error {
 	mp := mempool.(*PriorityNonceMempool[C])
 	if mp.priorityIndex.Len() != 0 {
@@ -0,0 +1,161 @@
+package mempool_test
+
+import (
+	"fmt"
+
+	"pgregory.net/rapid"
+
+	"cosmossdk.io/log"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	mempool "github.com/cosmos/cosmos-sdk/types/mempool"
+)
+
+// Property Based Testing
+// The priority nonce mempool is checked against a model of its contents, keyed by sender and nonce.
+// Replacement: a tx replaces an existing tx with the same sender and nonce only if the TxReplacement rule allows it.
+// Eviction: when the mempool is full, a new tx evicts the lowest priority tx among the txs which have a strictly lower
+// priority and are the last tx (nonce-wise) of their sender, or it is rejected with ErrMempoolTxMaxCapacity.
+// In all cases the order of the selected txs must satisfy the rules in priority_nonce_spec.md.
+
+func txKey(tx testTx) string {
+	return fmt.Sprintf("%s/%d", tx.address, tx.nonce)
+}
+
+func genPriorityNonceTxs(t *rapid.T) []testTx {
+	genMultipleAddress := rapid.SliceOfNDistinct(AddressGenerator(t), 1, 5, func(acc sdk.AccAddress) string {
+		return acc.String()
+	})
+
+	accounts := genMultipleAddress.Draw(t, "address")
+	genTx := rapid.Custom(func(t *rapid.T) testTx {
+		return testTx{
+			priority: rapid.Int64Range(0, 100).Draw(t, "priority"),
+			nonce:    rapid.Uint64Range(0, 10).Draw(t, "nonce"),
+			address:  rapid.SampledFrom(accounts).Draw(t, "acc"),
+		}
+	})
+
+	return rapid.SliceOfN(genTx, 1, 200).Draw(t, "txs")
+}
+
+// selectAll returns the mempool contents keyed by sender and nonce, after
+// checking that the selection order is valid.
+func selectAll(t require.TestingT, ctx sdk.Context, mp mempool.Mempool) map[string]testTx {
+	selected := fetchAllTxs(mp.Select(ctx, nil))
+	require.Equal(t, mp.CountTx(), len(selected))
+
+	mtxs := make([]sdk.Tx, 0, len(selected))
+	contents := make(map[string]testTx, len(selected))
+	for _, tx := range selected {
+		mtxs = append(mtxs, tx)
+		contents[txKey(tx)] = tx
+	}
+	require.NoError(t, validateOrder(mtxs))
+
+	return contents
+}
+
+func testPriorityNonceReplacementProperties(t *rapid.T) {
+	ctx := sdk.NewContext(nil, cmtproto.Header{}, false, log.NewNopLogger())
+	txPriority := mempool.NewDefaultTxPriority()
+	mp := mempool.NewPriorityMempool(mempool.PriorityNonceMempoolConfig[int64]{
+		TxPriority:    txPriority,
+		TxReplacement: mempool.NewPriorityTxReplacement(txPriority),
+	})
+
+	model := make(map[string]testTx)
+	for _, tx := range genPriorityNonceTxs(t) {
+		err := mp.Insert(ctx.WithPriority(tx.priority), tx)
+
+		old, found := model[txKey(tx)]
+		switch {
+		case !found, tx.priority > old.priority:
+			require.NoError(t, err)
+			model[txKey(tx)] = tx
+		default:
+			require.Error(t, err)
+		}
+
+		require.Equal(t, len(model), mp.CountTx())
+	}
+
+	require.Equal(t, model, selectAll(t, ctx, mp))
+}
+
+func testPriorityNonceEvictionProperties(t *rapid.T) {
+	ctx := sdk.NewContext(nil, cmtproto.Header{}, false, log.NewNopLogger())
+	maxTx := rapid.IntRange(1, 20).Draw(t, "maxTx")
+	mp := mempool.NewPriorityMempool(mempool.PriorityNonceMempoolConfig[int64]{
+		TxPriority:     mempool.NewDefaultTxPriority(),
+		MaxTx:          maxTx,
+		EvictionPolicy: mempool.EvictionPolicyLowestPriority,
+	})
+
+	model := make(map[string]testTx)
+	for _, tx := range genPriorityNonceTxs(t) {
+		// the txs which may be evicted by tx: a lower priority, the last nonce of
+		// their sender, and not preceding tx from the same sender.
+		var candidates []testTx
+		for _, c := range model {
+			if c.priority >= tx.priority {
+				continue
+			}
+			if c.address.Equals(tx.address) && c.nonce < tx.nonce {
+				continue
+			}
+			last := true
+			for _, o := range model {
+				if o.address.Equals(c.address) && o.nonce > c.nonce {
+					last = false
+					break
+				}
+			}
+			if last {
+				candidates = append(candidates, c)
+			}
+		}
+
+		err := mp.Insert(ctx.WithPriority(tx.priority), tx)
+		contents := selectAll(t, ctx, mp)
+		require.LessOrEqual(t, len(contents), maxTx)
+
+		_, found := model[txKey(tx)]
+		switch {
+		case found, len(model) < maxTx:
+			require.NoError(t, err)
+			model[txKey(tx)] = tx
+		case len(candidates) == 0:
+			require.ErrorIs(t, err, mempool.ErrMempoolTxMaxCapacity)
+		default:
+			require.NoError(t, err)
+
+			var evicted []testTx
+			for k, o := range model {
+				if _, ok := contents[k]; !ok {
+					evicted = append(evicted, o)
+				}
+			}
+			require.Len(t, evicted, 1)
+			require.Contains(t, candidates, evicted[0])
+			for _, c := range candidates {
+				require.LessOrEqual(t, evicted[0].priority, c.priority)
+			}
+
+			delete(model, txKey(evicted[0]))
+			model[txKey(tx)] = tx
+		}
+
+		require.Equal(t, model, contents)
+	}
+}
+
+func (s *MempoolTestSuite) TestPriorityNonceReplacementProperties() {
+	rapid.Check(s.T(), testPriorityNonceReplacementProperties)
+}
+
+func (s *MempoolTestSuite) TestPriorityNonceEvictionProperties() {
+	rapid.Check(s.T(), testPriorityNonceEvictionProperties)
+}
@@ -748,3 +748,145 @@ func TestNextSenderTx_TxReplacement(t *testing.T) {
 	iter := mp.Select(ctx, nil)
 	require.Equal(t, txs[3], iter.Tx())
 }
+
+func TestNextSenderTx_PriorityTxReplacement(t *testing.T) {
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 1)
+	ctx := sdk.NewContext(nil, cmtproto.Header{}, false, log.NewNopLogger())
+	sa := accounts[0].Address
+
+	txs := []testTx{
+		{priority: 20, nonce: 1, address: sa},
+		{priority: 15, nonce: 1, address: sa}, // priority is less than the first Tx, failed tx replacement.
+		{priority: 20, nonce: 1, address: sa}, // priority is equal to the first Tx, failed tx replacement.
+		{priority: 21, nonce: 1, address: sa}, // priority is more than the first Tx, the first tx will be replaced.
+	}
+
+	txPriority := mempool.NewDefaultTxPriority()
+	mp := mempool.NewPriorityMempool(
+		mempool.PriorityNonceMempoolConfig[int64]{
+			TxPriority:    txPriority,
+			TxReplacement: mempool.NewPriorityTxReplacement(txPriority),
+			MaxTx:         1,
+		},
+	)
+
+	c := ctx.WithPriority(txs[0].priority)
+	require.NoError(t, mp.Insert(c, txs[0]))
+	require.Equal(t, 1, mp.CountTx())
+
+	c = ctx.WithPriority(txs[1].priority)
+	require.Error(t, mp.Insert(c, txs[1]))
+	require.Equal(t, 1, mp.CountTx())
+
+	c = ctx.WithPriority(txs[2].priority)
+	require.Error(t, mp.Insert(c, txs[2]))
+	require.Equal(t, 1, mp.CountTx())
+
+	// a replacement is allowed even though the mempool is full
+	c = ctx.WithPriority(txs[3].priority)
+	require.NoError(t, mp.Insert(c, txs[3]))
+	require.Equal(t, 1, mp.CountTx())
+
+	iter := mp.Select(ctx, nil)
+	require.Equal(t, txs[3], iter.Tx())
+}
+
+func TestNextSenderTx_TxEviction(t *testing.T) {
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 3)
+	ctx := sdk.NewContext(nil, cmtproto.Header{}, false, log.NewNopLogger())
+	sa := accounts[0].Address
+	sb := accounts[1].Address
+	sc := accounts[2].Address
+
+	mp := mempool.NewPriorityMempool(
+		mempool.PriorityNonceMempoolConfig[int64]{
+			TxPriority:     mempool.NewDefaultTxPriority(),
+			MaxTx:          3,
+			EvictionPolicy: mempool.EvictionPolicyLowestPriority,
+		},
+	)
+
+	txs := []testTx{
+		{priority: 5, nonce: 1, address: sa},
+		{priority: 30, nonce: 2, address: sa},
+		{priority: 10, nonce: 1, address: sb},
+	}
+	for _, tx := range txs {
+		c := ctx.WithPriority(tx.priority)
+		require.NoError(t, mp.Insert(c, tx))
+	}
+
+	// a tx without a higher priority than any evictable tx is rejected
+	tx := testTx{priority: 10, nonce: 1, address: sc}
+	require.ErrorIs(t, mp.Insert(ctx.WithPriority(tx.priority), tx), mempool.ErrMempoolTxMaxCapacity)
+	require.Equal(t, 3, mp.CountTx())
+
+	// sa's tx with priority 5 is the lowest priority tx but is followed by the
+	// sa tx with nonce 2, so sb's tx is evicted instead
+	tx = testTx{priority: 11, nonce: 1, address: sc}
+	require.NoError(t, mp.Insert(ctx.WithPriority(tx.priority), tx))
+	require.Equal(t, 3, mp.CountTx())
+	require.Nil(t, mp.NextSenderTx(sb.String()))
+	require.Equal(t, tx, mp.NextSenderTx(sc.String()))
+
+	// the sender's own previous tx is never evicted
+	tx = testTx{priority: 12, nonce: 2, address: sc}
+	require.ErrorIs(t, mp.Insert(ctx.WithPriority(tx.priority), tx), mempool.ErrMempoolTxMaxCapacity)
+
+	// without an eviction policy the insert fails
+	mp = mempool.NewPriorityMempool(
+		mempool.PriorityNonceMempoolConfig[int64]{
+			TxPriority: mempool.NewDefaultTxPriority(),
+			MaxTx:      3,
+		},
+	)
+	for _, tx := range txs {
+		c := ctx.WithPriority(tx.priority)
+		require.NoError(t, mp.Insert(c, tx))
+	}
+	tx = testTx{priority: 100, nonce: 1, address: sc}
+	require.ErrorIs(t, mp.Insert(ctx.WithPriority(tx.priority), tx), mempool.ErrMempoolTxMaxCapacity)
+}
+
+func TestPriorityNonceMempool_UnorderedTxSharingNonce(t *testing.T) {
+	ctx := sdk.NewContext(nil, cmtproto.Header{}, false, log.NewNopLogger())
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 1)
+	mp := mempool.DefaultPriorityMempool()
+
+	// unordered txs with the same timeout height, and an ordered tx whose sequence
+	// is this timeout height, do not overwrite each other
+	tx1 := unorderedTestTx{testTx: testTx{id: 1, priority: 10, address: accounts[0].Address}, timeoutHeight: 10}
+	tx2 := unorderedTestTx{testTx: testTx{id: 2, priority: 20, address: accounts[0].Address}, timeoutHeight: 10}
+	tx3 := testTx{id: 3, priority: 30, nonce: 10, address: accounts[0].Address}
+	require.NoError(t, mp.Insert(ctx.WithPriority(tx1.priority), tx1))
+	require.NoError(t, mp.Insert(ctx.WithPriority(tx2.priority), tx2))
+	require.NoError(t, mp.Insert(ctx.WithPriority(tx3.priority), tx3))
+	require.Equal(t, 3, mp.CountTx())
+
+	// inserting the same unordered tx again replaces it, even with a malleated
+	// signature
+	require.NoError(t, mp.Insert(ctx.WithPriority(15), tx1))
+	require.Equal(t, 3, mp.CountTx())
+
+	malleated := tx1
+	malleated.signature = []byte("malleated")
+	require.NoError(t, mp.Insert(ctx.WithPriority(16), malleated))
+	require.Equal(t, 3, mp.CountTx())
+
+	var ids []int
+	for iter := mp.Select(ctx, nil); iter != nil; iter = iter.Next() {
+		switch tx := iter.Tx().(type) {
+		case unorderedTestTx:
+			ids = append(ids, tx.id)
+		case testTx:
+			ids = append(ids, tx.id)
+		}
+	}
+	require.ElementsMatch(t, []int{1, 2, 3}, ids)
+
+	require.NoError(t, mp.Remove(tx2))
+	require.Equal(t, mempool.ErrTxNotFound, mp.Remove(tx2))
+	require.NoError(t, mp.Remove(tx3))
+	require.NoError(t, mp.Remove(tx1))
+	require.NoError(t, mempool.IsEmpty[int64](mp))
+}
@@ -6,6 +6,8 @@ import (
 	"encoding/binary"
 	"fmt"
 	"math/rand" // #nosec // math/rand is used for random selection and seeded from crypto/rand
+	"sort"
+	"sync"
 
 	"github.com/huandu/skiplist"
 
@@ -14,8 +16,9 @@ import (
 )
 
 var (
-	_ Mempool  = (*SenderNonceMempool)(nil)
-	_ Iterator = (*senderNonceMempoolIterator)(nil)
+	_ Mempool      = (*SenderNonceMempool)(nil)
+	_ Introspector = (*SenderNonceMempool)(nil)
+	_ Iterator     = (*senderNonceMempoolIterator)(nil)
 )
 
 var DefaultMaxTx = 0
@@ -31,6 +34,10 @@ var DefaultMaxTx = 0
 // Note that PrepareProposal could choose to stop iteration before reaching the
 // end if maxBytes is reached.
 type SenderNonceMempool struct {
+	// mtx guards the mempool against the concurrent introspection of the
+	// mempool, e.g. from gRPC queries.
+	mtx sync.RWMutex
+
 	senders    map[string]*skiplist.SkipList
 	rnd        *rand.Rand
 	maxTx      int
@@ -42,8 +49,30 @@ type SenderNonceOptions func(*SenderNonceMempool)
 type txKey struct {
 	address string
 	nonce   uint64
+	// id distinguishes the unordered txs sharing a nonce, it is empty for
+	// ordered txs
+	id string
+}
+
+// senderTxKey is the key of a tx in the list of txs of its sender.
+type senderTxKey struct {
+	nonce uint64
+	id    string
 }
 
+// senderTxKeyComparable orders the txs of a sender by nonce, then by id.
+var senderTxKeyComparable = skiplist.GreaterThanFunc(func(a, b any) int {
+	keyA := a.(senderTxKey)
+	keyB := b.(senderTxKey)
+
+	res := skiplist.Uint64.Compare(keyA.nonce, keyB.nonce)
+	if res != 0 {
+		return res
+	}
+
+	return skiplist.String.Compare(keyA.id, keyB.id)
+})
+
 // NewSenderNonceMempool creates a new mempool that prioritizes transactions by
 // nonce, the lowest first, picking a random sender on each iteration.
 func NewSenderNonceMempool(opts ...SenderNonceOptions) *SenderNonceMempool {
@@ -104,6 +133,9 @@ func (snm *SenderNonceMempool) setSeed(seed int64) {
 // i.e. the next valid transaction for the sender. If no such transaction exists,
 // nil will be returned.
 func (snm *SenderNonceMempool) NextSenderTx(sender string) sdk.Tx {
+	snm.mtx.RLock()
+	defer snm.mtx.RUnlock()
+
 	senderIndex, ok := snm.senders[sender]
 	if !ok {
 		return nil
@@ -116,7 +148,10 @@ func (snm *SenderNonceMempool) NextSenderTx(sender string) sdk.Tx {
 // Insert adds a tx to the mempool. It returns an error if the tx does not have
 // at least one signer. Note, priority is ignored.
 func (snm *SenderNonceMempool) Insert(_ context.Context, tx sdk.Tx) error {
-	if snm.maxTx > 0 && snm.CountTx() >= snm.maxTx {
+	snm.mtx.Lock()
+	defer snm.mtx.Unlock()
+
+	if snm.maxTx > 0 && len(snm.existingTx) >= snm.maxTx {
 		return ErrMempoolTxMaxCapacity
 	}
 	if snm.maxTx < 0 {
@@ -133,17 +168,17 @@ func (snm *SenderNonceMempool) Insert(_ context.Context, tx sdk.Tx) error {
 
 	sig := sigs[0]
 	sender := sdk.AccAddress(sig.PubKey.Address()).String()
-	nonce := sig.Sequence
+	nonce, id := txNonce(tx, sig)
 
 	senderTxs, found := snm.senders[sender]
 	if !found {
-		senderTxs = skiplist.New(skiplist.Uint64)
+		senderTxs = skiplist.New(senderTxKeyComparable)
 		snm.senders[sender] = senderTxs
 	}
 
-	senderTxs.Set(nonce, tx)
+	senderTxs.Set(senderTxKey{nonce: nonce, id: id}, tx)
 
-	key := txKey{nonce: nonce, address: sender}
+	key := txKey{nonce: nonce, id: id, address: sender}
 	snm.existingTx[key] = true
 
 	return nil
@@ -155,6 +190,9 @@ func (snm *SenderNonceMempool) Insert(_ context.Context, tx sdk.Tx) error {
 // NOTE: It is not safe to use this iterator while removing transactions from
 // the underlying mempool.
 func (snm *SenderNonceMempool) Select(_ context.Context, _ [][]byte) Iterator {
+	snm.mtx.RLock()
+	defer snm.mtx.RUnlock()
+
 	var senders []string
 
 	senderCursors := make(map[string]*skiplist.Element)
@@ -184,12 +222,76 @@ func (snm *SenderNonceMempool) Select(_ context.Context, _ [][]byte) Iterator {
 
 // CountTx returns the total count of txs in the mempool.
 func (snm *SenderNonceMempool) CountTx() int {
+	snm.mtx.RLock()
+	defer snm.mtx.RUnlock()
+
 	return len(snm.existingTx)
 }
 
+// Walk calls fn for each tx in the mempool, ordered by sender and then by nonce,
+// until fn returns true. fn must not modify the mempool.
+func (snm *SenderNonceMempool) Walk(fn func(sender string, nonce uint64, tx sdk.Tx) (stop bool)) {
+	snm.mtx.RLock()
+	defer snm.mtx.RUnlock()
+
+	senders := make([]string, 0, len(snm.senders))
+	for sender := range snm.senders {
+		senders = append(senders, sender)
+	}
+	sort.Strings(senders)
+
+	for _, sender := range senders {
+		if snm.walkSender(sender, fn) {
+			return
+		}
+	}
+}
+
+// WalkSender calls fn for each tx of the given sender in nonce order until fn
+// returns true. fn must not modify the mempool.
+func (snm *SenderNonceMempool) WalkSender(sender string, fn func(sender string, nonce uint64, tx sdk.Tx) (stop bool)) {
+	snm.mtx.RLock()
+	defer snm.mtx.RUnlock()
+
+	snm.walkSender(sender, fn)
+}
+
+// walkSender calls fn for each tx of the given sender in nonce order until fn
+// returns true, in which case it returns true.
+func (snm *SenderNonceMempool) walkSender(sender string, fn func(sender string, nonce uint64, tx sdk.Tx) (stop bool)) bool {
+	senderTxs, found := snm.senders[sender]
+	if !found {
+		return false
+	}
+
+	for node := senderTxs.Front(); node != nil; node = node.Next() {
+		if fn(sender, node.Key().(senderTxKey).nonce, node.Value.(sdk.Tx)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// SenderCounts returns the number of txs in the mempool for each sender.
+func (snm *SenderNonceMempool) SenderCounts() map[string]int {
+	snm.mtx.RLock()
+	defer snm.mtx.RUnlock()
+
+	counts := make(map[string]int, len(snm.senders))
+	for sender, senderTxs := range snm.senders {
+		counts[sender] = senderTxs.Len()
+	}
+
+	return counts
+}
+
 // Remove removes a tx from the mempool. It returns an error if the tx does not
 // have at least one signer or the tx was not found in the pool.
 func (snm *SenderNonceMempool) Remove(tx sdk.Tx) error {
+	snm.mtx.Lock()
+	defer snm.mtx.Unlock()
+
 	sigs, err := tx.(signing.SigVerifiableTx).GetSignaturesV2()
 	if err != nil {
 		return err
@@ -200,14 +302,14 @@ func (snm *SenderNonceMempool) Remove(tx sdk.Tx) error {
 
 	sig := sigs[0]
 	sender := sdk.AccAddress(sig.PubKey.Address()).String()
-	nonce := sig.Sequence
+	nonce, id := txNonce(tx, sig)
 
 	senderTxs, found := snm.senders[sender]
 	if !found {
 		return ErrTxNotFound
 	}
 
-	res := senderTxs.Remove(nonce)
+	res := senderTxs.Remove(senderTxKey{nonce: nonce, id: id})
 	if res == nil {
 		return ErrTxNotFound
 	}
@@ -216,7 +318,7 @@ func (snm *SenderNonceMempool) Remove(tx sdk.Tx) error {
 		delete(snm.senders, sender)
 	}
 
-	key := txKey{nonce: nonce, address: sender}
+	key := txKey{nonce: nonce, id: id, address: sender}
 	delete(snm.existingTx, key)
 
 	return nil
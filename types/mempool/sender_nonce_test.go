@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"math/rand"
 	"testing"
+	"time"
 
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	"github.com/stretchr/testify/require"
@@ -192,3 +193,73 @@ func (s *MempoolTestSuite) TestTxNotFoundOnSender() {
 	err = mp.Remove(tx)
 	require.Equal(t, mempool.ErrTxNotFound, err)
 }
+
+func (s *MempoolTestSuite) TestUnorderedTx() {
+	t := s.T()
+	ctx := sdk.NewContext(nil, cmtproto.Header{}, false, log.NewNopLogger())
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 1)
+	mp := mempool.NewSenderNonceMempool()
+
+	// unordered txs sharing the same sequence are keyed by their timeout
+	// timestamp, or by their timeout height if it is not set
+	tx1 := unorderedTestTx{testTx: testTx{id: 1, nonce: 0, address: accounts[0].Address}, timeoutHeight: 10}
+	tx2 := unorderedTestTx{testTx: testTx{id: 2, nonce: 0, address: accounts[0].Address}, timeoutHeight: 5}
+	tx3 := unorderedTestTx{testTx: testTx{id: 3, nonce: 0, address: accounts[0].Address}, timeoutHeight: 10, timeoutTimestamp: time.Unix(100, 0)}
+
+	require.NoError(t, mp.Insert(ctx, tx1))
+	require.NoError(t, mp.Insert(ctx, tx2))
+	require.NoError(t, mp.Insert(ctx, tx3))
+	require.Equal(t, 3, mp.CountTx())
+
+	require.NoError(t, mp.Remove(tx1))
+	require.Equal(t, 2, mp.CountTx())
+	require.Equal(t, mempool.ErrTxNotFound, mp.Remove(tx1))
+
+	require.NoError(t, mp.Remove(tx3))
+	require.Equal(t, 1, mp.CountTx())
+}
+
+func (s *MempoolTestSuite) TestUnorderedTxSharingNonce() {
+	t := s.T()
+	ctx := sdk.NewContext(nil, cmtproto.Header{}, false, log.NewNopLogger())
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 1)
+	mp := mempool.NewSenderNonceMempool()
+
+	// unordered txs with the same timeout height, and an ordered tx whose sequence
+	// is this timeout height, do not overwrite each other
+	tx1 := unorderedTestTx{testTx: testTx{id: 1, address: accounts[0].Address}, timeoutHeight: 10}
+	tx2 := unorderedTestTx{testTx: testTx{id: 2, address: accounts[0].Address}, timeoutHeight: 10}
+	tx3 := testTx{id: 3, nonce: 10, address: accounts[0].Address}
+	require.NoError(t, mp.Insert(ctx, tx1))
+	require.NoError(t, mp.Insert(ctx, tx2))
+	require.NoError(t, mp.Insert(ctx, tx3))
+	require.Equal(t, 3, mp.CountTx())
+	require.Equal(t, map[string]int{accounts[0].Address.String(): 3}, mp.SenderCounts())
+
+	// inserting the same unordered tx again does not duplicate it, even with a
+	// malleated signature
+	require.NoError(t, mp.Insert(ctx, tx1))
+	require.Equal(t, 3, mp.CountTx())
+
+	malleated := tx1
+	malleated.signature = []byte("malleated")
+	require.NoError(t, mp.Insert(ctx, malleated))
+	require.Equal(t, 3, mp.CountTx())
+
+	var ids []int
+	for iter := mp.Select(ctx, nil); iter != nil; iter = iter.Next() {
+		switch tx := iter.Tx().(type) {
+		case unorderedTestTx:
+			ids = append(ids, tx.id)
+		case testTx:
+			ids = append(ids, tx.id)
+		}
+	}
+	require.ElementsMatch(t, []int{1, 2, 3}, ids)
+
+	require.NoError(t, mp.Remove(tx2))
+	require.Equal(t, mempool.ErrTxNotFound, mp.Remove(tx2))
+	require.NoError(t, mp.Remove(tx3))
+	require.NoError(t, mp.Remove(tx1))
+	require.Equal(t, 0, mp.CountTx())
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	fmt "fmt"
 	strings "strings"
+	"time"
 
 	"github.com/cosmos/gogoproto/proto"
 
@@ -67,6 +68,29 @@ type (
 		GetTimeoutHeight() uint64
 	}
 
+	// TxWithTimeoutTimeStamp extends the Tx interface by allowing a transaction
+	// to set a time based timeout.
+	TxWithTimeoutTimeStamp interface {
+		Tx
+
+		GetTimeoutTimeStamp() time.Time
+	}
+
+	// TxWithUnordered extends the Tx interface by allowing a transaction to set
+	// the unordered field, which implicitly relies on TxWithTimeoutHeight or
+	// TxWithTimeoutTimeStamp.
+	TxWithUnordered interface {
+		TxWithTimeoutHeight
+		TxWithTimeoutTimeStamp
+
+		GetUnordered() bool
+		// GetUnorderedID returns the identifier of the transaction used for the
+		// replay protection of unordered transactions. It must be derived from
+		// the signed content of the transaction only, and not from its
+		// signatures, which can be malleated without being invalidated.
+		GetUnorderedID() []byte
+	}
+
 	// HasValidateBasic defines a type that has a ValidateBasic method.
 	// ValidateBasic is deprecated and now facultative.
 	// Prefer validating messages directly in the msg server.
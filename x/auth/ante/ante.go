@@ -1,6 +1,8 @@
 package ante
 
 import (
+	"time"
+
 	storetypes "cosmossdk.io/store/types"
 	txsigning "cosmossdk.io/x/tx/signing"
 
@@ -21,6 +23,17 @@ type HandlerOptions struct {
 	SignModeHandler        *txsigning.HandlerMap
 	SigGasConsumer         func(meter storetypes.GasMeter, sig signing.SignatureV2, params types.Params) error
 	TxFeeChecker           TxFeeChecker
+	// UnorderedTxKeeper keeps track of the unordered transactions, they are
+	// rejected if it is not set.
+	UnorderedTxKeeper UnorderedTxKeeper
+	// MaxUnorderedTxTimeoutDelta defines the maximum number of blocks between the
+	// block height and the timeout height of an unordered transaction, it
+	// defaults to DefaultMaxUnorderedTxTimeoutDelta.
+	MaxUnorderedTxTimeoutDelta uint64
+	// MaxUnorderedTxTimeoutDuration defines the maximum duration between the
+	// block time and the timeout timestamp of an unordered transaction, it
+	// defaults to DefaultMaxUnorderedTxTimeoutDuration.
+	MaxUnorderedTxTimeoutDuration time.Duration
 }
 
 // NewAnteHandler returns an AnteHandler that checks and increments sequence
@@ -39,11 +52,22 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "sign mode handler is required for ante builder")
 	}
 
+	maxUnorderedTxTimeoutDelta := options.MaxUnorderedTxTimeoutDelta
+	if maxUnorderedTxTimeoutDelta == 0 {
+		maxUnorderedTxTimeoutDelta = DefaultMaxUnorderedTxTimeoutDelta
+	}
+
+	maxUnorderedTxTimeoutDuration := options.MaxUnorderedTxTimeoutDuration
+	if maxUnorderedTxTimeoutDuration == 0 {
+		maxUnorderedTxTimeoutDuration = DefaultMaxUnorderedTxTimeoutDuration
+	}
+
 	anteDecorators := []sdk.AnteDecorator{
 		NewSetUpContextDecorator(), // outermost AnteDecorator. SetUpContext must be called first
 		NewExtensionOptionsDecorator(options.ExtensionOptionChecker),
 		NewValidateBasicDecorator(),
 		NewTxTimeoutHeightDecorator(),
+		NewUnorderedTxDecorator(maxUnorderedTxTimeoutDelta, maxUnorderedTxTimeoutDuration, options.UnorderedTxKeeper),
 		NewValidateMemoDecorator(options.AccountKeeper),
 		NewConsumeGasForTxSizeDecorator(options.AccountKeeper),
 		NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper, options.TxFeeChecker),
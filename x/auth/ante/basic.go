@@ -172,7 +172,7 @@ func isIncompleteSignature(data signing.SignatureData) bool {
 
 type (
 	// TxTimeoutHeightDecorator defines an AnteHandler decorator that checks for a
-	// tx height timeout.
+	// tx height or timestamp timeout.
 	TxTimeoutHeightDecorator struct{}
 
 	// TxWithTimeoutHeight defines the interface a tx must implement in order for
@@ -185,7 +185,7 @@ type (
 )
 
 // TxTimeoutHeightDecorator defines an AnteHandler decorator that checks for a
-// tx height timeout.
+// tx height or timestamp timeout.
 func NewTxTimeoutHeightDecorator() TxTimeoutHeightDecorator {
 	return TxTimeoutHeightDecorator{}
 }
@@ -193,7 +193,10 @@ func NewTxTimeoutHeightDecorator() TxTimeoutHeightDecorator {
 // AnteHandle implements an AnteHandler decorator for the TxHeightTimeoutDecorator
 // type where the current block height is checked against the tx's height timeout.
 // If a height timeout is provided (non-zero) and is less than the current block
-// height, then an error is returned.
+// height, then an error is returned. Likewise, if the tx implements
+// sdk.TxWithTimeoutTimeStamp and its timeout timestamp is set and before the
+// current block time, or signed with SIGN_MODE_LEGACY_AMINO_JSON, then an error
+// is returned.
 func (txh TxTimeoutHeightDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
 	timeoutTx, ok := tx.(TxWithTimeoutHeight)
 	if !ok {
@@ -207,5 +210,31 @@ func (txh TxTimeoutHeightDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simul
 		)
 	}
 
+	timeoutTimestampTx, ok := tx.(sdk.TxWithTimeoutTimeStamp)
+	if !ok || timeoutTimestampTx.GetTimeoutTimeStamp().IsZero() {
+		return next(ctx, tx, simulate)
+	}
+
+	timeoutTimestamp := timeoutTimestampTx.GetTimeoutTimeStamp()
+	if ctx.BlockTime().After(timeoutTimestamp) {
+		return ctx, errorsmod.Wrapf(
+			sdkerrors.ErrTxTimeout, "block time: %s, timeout timestamp: %s", ctx.BlockTime(), timeoutTimestamp,
+		)
+	}
+
+	// the amino JSON sign bytes do not cover the timeout timestamp
+	if sigTx, ok := tx.(authsigning.SigVerifiableTx); ok {
+		sigs, err := sigTx.GetSignaturesV2()
+		if err != nil {
+			return ctx, err
+		}
+
+		for _, sig := range sigs {
+			if hasLegacyAminoSigner(sig.Data) {
+				return ctx, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "timeout timestamps cannot be signed with %s", signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON)
+			}
+		}
+	}
+
 	return next(ctx, tx, simulate)
 }
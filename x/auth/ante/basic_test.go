@@ -3,6 +3,7 @@ package ante_test
 import (
 	"strings"
 	"testing"
+	"time"
 
 	storetypes "cosmossdk.io/store/types"
 	"github.com/stretchr/testify/require"
@@ -190,16 +191,23 @@ func TestTxHeightTimeoutDecorator(t *testing.T) {
 	feeAmount := testdata.NewTestFeeAmount()
 	gasLimit := testdata.NewTestGasLimit()
 
+	blockTime := time.Unix(1_000_000, 0)
+
 	testCases := []struct {
-		name        string
-		timeout     uint64
-		height      int64
-		expectedErr error
+		name             string
+		timeout          uint64
+		height           int64
+		timeoutTimestamp time.Time
+		expectedErr      error
 	}{
-		{"default value", 0, 10, nil},
-		{"no timeout (greater height)", 15, 10, nil},
-		{"no timeout (same height)", 10, 10, nil},
-		{"timeout (smaller height)", 9, 10, sdkerrors.ErrTxTimeoutHeight},
+		{"default value", 0, 10, time.Time{}, nil},
+		{"no timeout (greater height)", 15, 10, time.Time{}, nil},
+		{"no timeout (same height)", 10, 10, time.Time{}, nil},
+		{"timeout (smaller height)", 9, 10, time.Time{}, sdkerrors.ErrTxTimeoutHeight},
+		{"no timeout (later timestamp)", 0, 10, blockTime.Add(time.Second), nil},
+		{"no timeout (same timestamp)", 0, 10, blockTime, nil},
+		{"timeout (earlier timestamp)", 0, 10, blockTime.Add(-time.Second), sdkerrors.ErrTxTimeout},
+		{"timeout (earlier timestamp, greater height)", 15, 10, blockTime.Add(-time.Second), sdkerrors.ErrTxTimeout},
 	}
 
 	for _, tc := range testCases {
@@ -214,14 +222,35 @@ func TestTxHeightTimeoutDecorator(t *testing.T) {
 			suite.txBuilder.SetGasLimit(gasLimit)
 			suite.txBuilder.SetMemo(strings.Repeat("01234567890", 10))
 			suite.txBuilder.SetTimeoutHeight(tc.timeout)
+			suite.txBuilder.SetTimeoutTimestamp(tc.timeoutTimestamp)
 
 			privs, accNums, accSeqs := []cryptotypes.PrivKey{priv1}, []uint64{0}, []uint64{0}
 			tx, err := suite.CreateTestTx(suite.ctx, privs, accNums, accSeqs, suite.ctx.ChainID(), signing.SignMode_SIGN_MODE_DIRECT)
 			require.NoError(t, err)
 
-			ctx := suite.ctx.WithBlockHeight(tc.height)
+			ctx := suite.ctx.WithBlockHeight(tc.height).WithBlockTime(blockTime)
 			_, err = antehandler(ctx, tx, true)
 			require.ErrorIs(t, err, tc.expectedErr)
 		})
 	}
+
+	t.Run("timeout timestamp signed with amino json", func(t *testing.T) {
+		suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+
+		require.NoError(t, suite.txBuilder.SetMsgs(msg))
+		suite.txBuilder.SetFeeAmount(feeAmount)
+		suite.txBuilder.SetGasLimit(gasLimit)
+
+		privs, accNums, accSeqs := []cryptotypes.PrivKey{priv1}, []uint64{0}, []uint64{0}
+		_, err := suite.CreateTestTx(suite.ctx, privs, accNums, accSeqs, suite.ctx.ChainID(), signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON)
+		require.NoError(t, err)
+
+		// the amino JSON sign bytes do not cover the timeout timestamp, it is
+		// set after signing as a malleated tx would do
+		suite.txBuilder.SetTimeoutTimestamp(blockTime.Add(time.Second))
+
+		ctx := suite.ctx.WithBlockHeight(10).WithBlockTime(blockTime)
+		_, err = antehandler(ctx, suite.txBuilder.GetTx(), true)
+		require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	})
 }
@@ -256,6 +256,8 @@ func (svd SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simul
 		return ctx, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "invalid number of signer;  expected: %d, got %d", len(signerAddrs), len(sigs))
 	}
 
+	unordered := IsUnorderedTx(tx)
+
 	for i, sig := range sigs {
 		acc, err := GetSignerAcc(ctx, svd.ak, signerAddrs[i])
 		if err != nil {
@@ -268,8 +270,14 @@ func (svd SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simul
 			return ctx, errorsmod.Wrap(sdkerrors.ErrInvalidPubKey, "pubkey on account is not set")
 		}
 
-		// Check account sequence number.
-		if sig.Sequence != acc.GetSequence() {
+		// Check account sequence number. Unordered transactions are not bound
+		// to the account sequence, they are signed over the sequence they declare
+		// and rely on the UnorderedTxDecorator for replay protection until they
+		// time out, by height or by timestamp.
+		sequence := acc.GetSequence()
+		if unordered {
+			sequence = sig.Sequence
+		} else if sig.Sequence != acc.GetSequence() {
 			return ctx, errorsmod.Wrapf(
 				sdkerrors.ErrWrongSequence,
 				"account sequence mismatch, expected %d, got %d", acc.GetSequence(), sig.Sequence,
@@ -292,7 +300,7 @@ func (svd SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simul
 				Address:       acc.GetAddress().String(),
 				ChainID:       chainID,
 				AccountNumber: accNum,
-				Sequence:      acc.GetSequence(),
+				Sequence:      sequence,
 				PubKey: &anypb.Any{
 					TypeUrl: anyPk.TypeUrl,
 					Value:   anyPk.Value,
@@ -309,7 +317,7 @@ func (svd SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simul
 				if OnlyLegacyAminoSigners(sig.Data) {
 					// If all signers are using SIGN_MODE_LEGACY_AMINO, we rely on VerifySignature to check account sequence number,
 					// and therefore communicate sequence number as a potential cause of error.
-					errMsg = fmt.Sprintf("signature verification failed; please verify account number (%d), sequence (%d) and chain-id (%s)", accNum, acc.GetSequence(), chainID)
+					errMsg = fmt.Sprintf("signature verification failed; please verify account number (%d), sequence (%d) and chain-id (%s)", accNum, sequence, chainID)
 				} else {
 					errMsg = fmt.Sprintf("signature verification failed; please verify account number (%d) and chain-id (%s): (%s)", accNum, chainID, err.Error())
 				}
@@ -347,6 +355,11 @@ func (isd IncrementSequenceDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, sim
 		return ctx, errorsmod.Wrap(sdkerrors.ErrTxDecode, "invalid transaction type")
 	}
 
+	// unordered transactions do not increment the sequence of their signers
+	if IsUnorderedTx(tx) {
+		return next(ctx, tx, simulate)
+	}
+
 	// increment sequence of all signers
 	for _, addr := range sigTx.GetSigners() {
 		acc := isd.ak.GetAccount(ctx, addr)
@@ -434,10 +447,69 @@ func DefaultSigVerificationGasConsumer(
 	}
 }
 
+// NewSigVerificationGasConsumer returns a SignatureVerificationGasConsumer accepting the public keys
+// of the given types only, e.g. "secp256k1", "secp256r1" or "ed25519", as returned by PubKey.Type.
+// Multisig public keys are accepted if all their keys are accepted. The gas is consumed as in
+// DefaultSigVerificationGasConsumer, and ed25519 signatures cost SigVerifyCostED25519.
+func NewSigVerificationGasConsumer(pubKeyTypes ...string) SignatureVerificationGasConsumer {
+	accepted := make(map[string]bool, len(pubKeyTypes))
+	for _, pubKeyType := range pubKeyTypes {
+		accepted[pubKeyType] = true
+	}
+
+	var consumer SignatureVerificationGasConsumer
+	consumer = func(meter storetypes.GasMeter, sig signing.SignatureV2, params types.Params) error {
+		pubkey := sig.PubKey
+		if pubkey == nil {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidPubKey, "missing public key")
+		}
+
+		if multisigPubKey, ok := pubkey.(multisig.PubKey); ok {
+			multisignature, ok := sig.Data.(*signing.MultiSignatureData)
+			if !ok {
+				return fmt.Errorf("expected %T, got, %T", &signing.MultiSignatureData{}, sig.Data)
+			}
+			return consumeMultisignatureVerificationGas(meter, multisignature, multisigPubKey, params, sig.Sequence, consumer)
+		}
+
+		if !accepted[pubkey.Type()] {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidPubKey, "%s public keys are not accepted", pubkey.Type())
+		}
+
+		switch pubkey.(type) {
+		case *ed25519.PubKey:
+			meter.ConsumeGas(params.SigVerifyCostED25519, "ante verify: ed25519")
+			return nil
+
+		case *secp256k1.PubKey:
+			meter.ConsumeGas(params.SigVerifyCostSecp256k1, "ante verify: secp256k1")
+			return nil
+
+		case *secp256r1.PubKey:
+			meter.ConsumeGas(params.SigVerifyCostSecp256r1(), "ante verify: secp256r1")
+			return nil
+
+		default:
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidPubKey, "unrecognized public key type: %T", pubkey)
+		}
+	}
+
+	return consumer
+}
+
 // ConsumeMultisignatureVerificationGas consumes gas from a GasMeter for verifying a multisig pubkey signature
 func ConsumeMultisignatureVerificationGas(
 	meter storetypes.GasMeter, sig *signing.MultiSignatureData, pubkey multisig.PubKey,
 	params types.Params, accSeq uint64,
+) error {
+	return consumeMultisignatureVerificationGas(meter, sig, pubkey, params, accSeq, DefaultSigVerificationGasConsumer)
+}
+
+// consumeMultisignatureVerificationGas consumes the gas of the signatures of a multisig with the
+// given SignatureVerificationGasConsumer.
+func consumeMultisignatureVerificationGas(
+	meter storetypes.GasMeter, sig *signing.MultiSignatureData, pubkey multisig.PubKey,
+	params types.Params, accSeq uint64, sigGasConsumer SignatureVerificationGasConsumer,
 ) error {
 	size := sig.BitArray.Count()
 	sigIndex := 0
@@ -451,7 +523,7 @@ func ConsumeMultisignatureVerificationGas(
 			Data:     sig.Signatures[sigIndex],
 			Sequence: accSeq,
 		}
-		err := DefaultSigVerificationGasConsumer(meter, sigV2, params)
+		err := sigGasConsumer(meter, sigV2, params)
 		if err != nil {
 			return err
 		}
@@ -19,6 +19,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
 	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
@@ -125,6 +126,56 @@ func TestConsumeSignatureVerificationGas(t *testing.T) {
 	}
 }
 
+func TestNewSigVerificationGasConsumer(t *testing.T) {
+	params := types.DefaultParams()
+	msg := []byte{1, 2, 3, 4}
+
+	skR1, _ := secp256r1.GenPrivKey()
+	pkEd25519 := ed25519.GenPrivKey().PubKey()
+	pkSet := []cryptotypes.PubKey{pkEd25519, secp256k1.GenPrivKey().PubKey(), skR1.PubKey()}
+	multisigKey := kmultisig.NewLegacyAminoPubKey(2, pkSet)
+	multisignature := multisig.NewMultisig(len(pkSet))
+	for _, pk := range pkSet {
+		sigV2 := signing.SignatureV2{PubKey: pk, Data: &signing.SingleSignatureData{Signature: msg}}
+		require.NoError(t, multisig.AddSignatureV2(multisignature, sigV2, pkSet))
+	}
+
+	tests := []struct {
+		name        string
+		pubKeyTypes []string
+		sig         signing.SignatureData
+		pubkey      cryptotypes.PubKey
+		gasConsumed uint64
+		expErr      bool
+	}{
+		{"accepted ed25519", []string{"ed25519"}, nil, pkEd25519, params.SigVerifyCostED25519, false},
+		{"rejected ed25519", []string{"secp256k1", "secp256r1"}, nil, pkEd25519, 0, true},
+		{"accepted secp256k1", []string{"secp256k1"}, nil, secp256k1.GenPrivKey().PubKey(), params.SigVerifyCostSecp256k1, false},
+		{"accepted secp256r1", []string{"secp256r1"}, nil, skR1.PubKey(), params.SigVerifyCostSecp256r1(), false},
+		{"rejected secp256r1", []string{"secp256k1"}, nil, skR1.PubKey(), 0, true},
+		{
+			"accepted multisig", []string{"ed25519", "secp256k1", "secp256r1"}, multisignature, multisigKey,
+			params.SigVerifyCostED25519 + params.SigVerifyCostSecp256k1 + params.SigVerifyCostSecp256r1(), false,
+		},
+		{"rejected multisig", []string{"secp256k1", "secp256r1"}, multisignature, multisigKey, 0, true},
+		{"missing key", []string{"secp256k1"}, nil, nil, 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			meter := storetypes.NewInfiniteGasMeter()
+			sigV2 := signing.SignatureV2{PubKey: tt.pubkey, Data: tt.sig}
+			err := ante.NewSigVerificationGasConsumer(tt.pubKeyTypes...)(meter, sigV2, params)
+			if tt.expErr {
+				require.ErrorIs(t, err, sdkerrors.ErrInvalidPubKey)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.gasConsumed, meter.GasConsumed())
+		})
+	}
+}
+
 func TestSigVerification(t *testing.T) {
 	suite := SetupTestSuite(t, true)
 	suite.txBankKeeper.EXPECT().DenomMetadata(gomock.Any(), gomock.Any()).Return(&banktypes.QueryDenomMetadataResponse{}, nil).AnyTimes()
@@ -0,0 +1,156 @@
+package ante
+
+import (
+	"context"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+// DefaultMaxUnorderedTxTimeoutDelta defines the default maximum number of
+// blocks between the current block height and the timeout height of an
+// unordered transaction.
+const DefaultMaxUnorderedTxTimeoutDelta = 1024
+
+// DefaultMaxUnorderedTxTimeoutDuration defines the default maximum duration
+// between the current block time and the timeout timestamp of an unordered
+// transaction.
+const DefaultMaxUnorderedTxTimeoutDuration = 10 * time.Minute
+
+// UnorderedTxKeeper defines the contract needed to keep track of the unordered
+// transactions until they expire. The transactions with a timeout timestamp
+// are kept until that timestamp, and the others until their timeout height.
+type UnorderedTxKeeper interface {
+	ContainsUnorderedTx(ctx context.Context, timeoutHeight uint64, timeoutTimestamp time.Time, txID []byte) (bool, error)
+	AddUnorderedTx(ctx context.Context, timeoutHeight uint64, timeoutTimestamp time.Time, txID []byte) error
+}
+
+// UnorderedTxDecorator defines an AnteHandler decorator that is responsible for
+// checking if a transaction is intended to be unordered and, if so, evaluates
+// the transaction accordingly. An unordered transaction bypasses the account
+// sequence checks and relies on its unordered ID, the hash of its signed body
+// and auth info, for replay protection instead: the ID is kept until the
+// transaction times out and any transaction with the same ID is rejected in
+// the meantime. Contrary to the tx hash, the ID does not cover the signatures,
+// so that a replay cannot get through by malleating them.
+//
+// The timeout height or the timeout timestamp of an unordered transaction must
+// be set, so that the IDs are not kept indefinitely. The timeout height must
+// not be further than maxTimeoutDelta blocks from the current block height,
+// and the timeout timestamp must not be further than maxTimeoutDuration from
+// the current block time. Unordered transactions cannot be signed
+// with SIGN_MODE_LEGACY_AMINO_JSON, as the amino JSON sign bytes do not cover
+// the unordered field.
+//
+// If no UnorderedTxKeeper is provided, unordered transactions are rejected.
+//
+// CONTRACT: The TxTimeoutHeightDecorator must run before this decorator, so that
+// expired transactions, by height or by timestamp, are rejected.
+type UnorderedTxDecorator struct {
+	maxTimeoutDelta    uint64
+	maxTimeoutDuration time.Duration
+	keeper             UnorderedTxKeeper
+}
+
+func NewUnorderedTxDecorator(maxTimeoutDelta uint64, maxTimeoutDuration time.Duration, keeper UnorderedTxKeeper) UnorderedTxDecorator {
+	return UnorderedTxDecorator{
+		maxTimeoutDelta:    maxTimeoutDelta,
+		maxTimeoutDuration: maxTimeoutDuration,
+		keeper:             keeper,
+	}
+}
+
+func (d UnorderedTxDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if !IsUnorderedTx(tx) {
+		return next(ctx, tx, simulate)
+	}
+
+	if d.keeper == nil {
+		return ctx, errorsmod.Wrap(sdkerrors.ErrNotSupported, "unordered transactions are not supported")
+	}
+
+	unorderedTx := tx.(sdk.TxWithUnordered)
+	timeoutHeight := unorderedTx.GetTimeoutHeight()
+	timeoutTimestamp := unorderedTx.GetTimeoutTimeStamp()
+	if timeoutHeight == 0 && timeoutTimestamp.IsZero() {
+		return ctx, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "unordered transaction must have timeout height or timeout timestamp set")
+	}
+
+	if timeoutHeight > uint64(ctx.BlockHeight())+d.maxTimeoutDelta {
+		return ctx, errorsmod.Wrapf(
+			sdkerrors.ErrInvalidRequest,
+			"unordered transaction has a timeout height too far in the future; block height: %d, timeout height: %d, max delta: %d",
+			ctx.BlockHeight(), timeoutHeight, d.maxTimeoutDelta,
+		)
+	}
+
+	if timeoutTimestamp.After(ctx.BlockTime().Add(d.maxTimeoutDuration)) {
+		return ctx, errorsmod.Wrapf(
+			sdkerrors.ErrInvalidRequest,
+			"unordered transaction has a timeout timestamp too far in the future; block time: %s, timeout timestamp: %s, max duration: %s",
+			ctx.BlockTime(), timeoutTimestamp, d.maxTimeoutDuration,
+		)
+	}
+
+	sigTx, ok := tx.(authsigning.SigVerifiableTx)
+	if !ok {
+		return ctx, errorsmod.Wrap(sdkerrors.ErrTxDecode, "invalid transaction type")
+	}
+
+	sigs, err := sigTx.GetSignaturesV2()
+	if err != nil {
+		return ctx, err
+	}
+
+	for _, sig := range sigs {
+		if hasLegacyAminoSigner(sig.Data) {
+			return ctx, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "unordered transactions cannot be signed with %s", signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON)
+		}
+	}
+
+	txID := unorderedTx.GetUnorderedID()
+
+	contains, err := d.keeper.ContainsUnorderedTx(ctx, timeoutHeight, timeoutTimestamp, txID)
+	if err != nil {
+		return ctx, err
+	}
+
+	if contains {
+		return ctx, errorsmod.Wrapf(sdkerrors.ErrTxInMempoolCache, "unordered transaction %X has already been processed", txID)
+	}
+
+	if err := d.keeper.AddUnorderedTx(ctx, timeoutHeight, timeoutTimestamp, txID); err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// IsUnorderedTx returns true if the transaction is an unordered transaction.
+func IsUnorderedTx(tx sdk.Tx) bool {
+	unorderedTx, ok := tx.(sdk.TxWithUnordered)
+	return ok && unorderedTx.GetUnordered()
+}
+
+// hasLegacyAminoSigner returns true if any of the signers, including nested
+// multisig signers, uses SIGN_MODE_LEGACY_AMINO_JSON.
+func hasLegacyAminoSigner(sigData signing.SignatureData) bool {
+	switch v := sigData.(type) {
+	case *signing.SingleSignatureData:
+		return v.SignMode == signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON
+	case *signing.MultiSignatureData:
+		for _, s := range v.Signatures {
+			if hasLegacyAminoSigner(s) {
+				return true
+			}
+		}
+		return false
+	default:
+		return false
+	}
+}
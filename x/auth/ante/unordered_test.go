@@ -0,0 +1,150 @@
+package ante_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+)
+
+func TestUnorderedTxDecorator(t *testing.T) {
+	priv1, _, addr1 := testdata.KeyTestPubAddr()
+	msg := testdata.NewTestMsg(addr1)
+	blockTime := time.Unix(1_000_000, 0)
+
+	testCases := []struct {
+		name          string
+		unordered     bool
+		timeoutHeight uint64
+		timeout       time.Duration
+		signMode      signing.SignMode
+		noKeeper      bool
+		duplicate     bool
+		malleated     bool
+		expectedErr   error
+	}{
+		{"ordered tx", false, 0, 0, signing.SignMode_SIGN_MODE_DIRECT, false, false, false, nil},
+		{"ordered tx without keeper", false, 0, 0, signing.SignMode_SIGN_MODE_DIRECT, true, false, false, nil},
+		{"unordered tx", true, 10, 0, signing.SignMode_SIGN_MODE_DIRECT, false, false, false, nil},
+		{"unordered tx without keeper", true, 10, 0, signing.SignMode_SIGN_MODE_DIRECT, true, false, false, sdkerrors.ErrNotSupported},
+		{"unordered tx without timeout height or timestamp", true, 0, 0, signing.SignMode_SIGN_MODE_DIRECT, false, false, false, sdkerrors.ErrInvalidRequest},
+		{"unordered tx with timeout height too far", true, 1 + ante.DefaultMaxUnorderedTxTimeoutDelta + 1, 0, signing.SignMode_SIGN_MODE_DIRECT, false, false, false, sdkerrors.ErrInvalidRequest},
+		{"unordered tx with timeout timestamp", true, 0, time.Minute, signing.SignMode_SIGN_MODE_DIRECT, false, false, false, nil},
+		{"unordered tx with timeout timestamp too far", true, 0, ante.DefaultMaxUnorderedTxTimeoutDuration + time.Second, signing.SignMode_SIGN_MODE_DIRECT, false, false, false, sdkerrors.ErrInvalidRequest},
+		{"unordered tx with timeout height and timestamp", true, 10, time.Minute, signing.SignMode_SIGN_MODE_DIRECT, false, false, false, nil},
+		{"unordered tx signed with amino json", true, 10, 0, signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON, false, false, false, sdkerrors.ErrInvalidRequest},
+		{"duplicate unordered tx", true, 10, 0, signing.SignMode_SIGN_MODE_DIRECT, false, true, false, sdkerrors.ErrTxInMempoolCache},
+		{"duplicate unordered tx with timeout timestamp", true, 0, time.Minute, signing.SignMode_SIGN_MODE_DIRECT, false, true, false, sdkerrors.ErrTxInMempoolCache},
+		{"duplicate unordered tx with a malleated signature", true, 10, 0, signing.SignMode_SIGN_MODE_DIRECT, false, true, true, sdkerrors.ErrTxInMempoolCache},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			suite := SetupTestSuite(t, true)
+
+			var keeper ante.UnorderedTxKeeper
+			if !tc.noKeeper {
+				keeper = suite.accountKeeper
+			}
+			antehandler := sdk.ChainAnteDecorators(ante.NewUnorderedTxDecorator(ante.DefaultMaxUnorderedTxTimeoutDelta, ante.DefaultMaxUnorderedTxTimeoutDuration, keeper))
+
+			require.NoError(t, suite.txBuilder.SetMsgs(msg))
+			suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+			suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+			suite.txBuilder.SetTimeoutHeight(tc.timeoutHeight)
+			if tc.timeout != 0 {
+				suite.txBuilder.SetTimeoutTimestamp(blockTime.Add(tc.timeout))
+			}
+
+			// amino JSON cannot sign unordered txs, the flag is set after signing
+			// as a malleated tx would do
+			if tc.signMode != signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON {
+				suite.txBuilder.SetUnordered(tc.unordered)
+			}
+
+			privs, accNums, accSeqs := []cryptotypes.PrivKey{priv1}, []uint64{0}, []uint64{0}
+			tx, err := suite.CreateTestTx(suite.ctx, privs, accNums, accSeqs, suite.ctx.ChainID(), tc.signMode)
+			require.NoError(t, err)
+
+			if tc.signMode == signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON {
+				suite.txBuilder.SetUnordered(tc.unordered)
+				tx = suite.txBuilder.GetTx()
+			}
+
+			txBytes, err := suite.clientCtx.TxConfig.TxEncoder()(tx)
+			require.NoError(t, err)
+			ctx := suite.ctx.WithTxBytes(txBytes).WithBlockTime(blockTime)
+
+			if tc.duplicate {
+				_, err = antehandler(ctx, tx, false)
+				require.NoError(t, err)
+			}
+
+			// a malleated signature changes the tx hash, but not the signed content
+			if tc.malleated {
+				sigs, err := tx.GetSignaturesV2()
+				require.NoError(t, err)
+				sigData := sigs[0].Data.(*signing.SingleSignatureData)
+				sigData.Signature = append([]byte{0x00}, sigData.Signature...)
+				require.NoError(t, suite.txBuilder.SetSignatures(sigs...))
+				tx = suite.txBuilder.GetTx()
+
+				malleatedBytes, err := suite.clientCtx.TxConfig.TxEncoder()(tx)
+				require.NoError(t, err)
+				require.NotEqual(t, txBytes, malleatedBytes)
+				ctx = ctx.WithTxBytes(malleatedBytes)
+			}
+
+			_, err = antehandler(ctx, tx, false)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestUnorderedTxSequence(t *testing.T) {
+	suite := SetupTestSuite(t, false)
+	accs := suite.CreateTestAccounts(1)
+
+	antehandler := sdk.ChainAnteDecorators(
+		ante.NewSetPubKeyDecorator(suite.accountKeeper),
+		ante.NewUnorderedTxDecorator(ante.DefaultMaxUnorderedTxTimeoutDelta, ante.DefaultMaxUnorderedTxTimeoutDuration, suite.accountKeeper),
+		ante.NewSigVerificationDecorator(suite.accountKeeper, suite.clientCtx.TxConfig.SignModeHandler()),
+		ante.NewIncrementSequenceDecorator(suite.accountKeeper),
+	)
+
+	require.NoError(t, suite.txBuilder.SetMsgs(testdata.NewTestMsg(accs[0].acc.GetAddress())))
+	suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+	suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+	suite.txBuilder.SetTimeoutHeight(10)
+	suite.txBuilder.SetUnordered(true)
+
+	// unordered txs are valid whatever their sequence and do not increment it
+	for i, seq := range []uint64{0, 5, 0} {
+		privs := []cryptotypes.PrivKey{accs[0].priv}
+		accNums := []uint64{accs[0].acc.GetAccountNumber()}
+		suite.txBuilder.SetMemo(fmt.Sprintf("unordered tx %d", i))
+		tx, err := suite.CreateTestTx(suite.ctx, privs, accNums, []uint64{seq}, suite.ctx.ChainID(), signing.SignMode_SIGN_MODE_DIRECT)
+		require.NoError(t, err)
+
+		txBytes, err := suite.clientCtx.TxConfig.TxEncoder()(tx)
+		require.NoError(t, err)
+
+		_, err = antehandler(suite.ctx.WithTxBytes(txBytes), tx, false)
+		require.NoError(t, err)
+		require.Equal(t, uint64(0), suite.accountKeeper.GetAccount(suite.ctx, accs[0].acc.GetAddress()).GetSequence())
+	}
+}
@@ -0,0 +1,117 @@
+package cli
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/input"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/version"
+	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
+)
+
+const (
+	flagMaxTxGas   = "max-tx-gas"
+	flagMaxRetries = "max-retries"
+)
+
+// GetBatchCommand returns the tx batch command.
+func GetBatchCommand() *cobra.Command {
+	defaultOpts := tx.DefaultBatchOptions()
+
+	cmd := &cobra.Command{
+		Use:   "batch [file]",
+		Short: "Broadcast the messages of a file in a batch of transactions",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Read messages from [file], one JSON encoded message per line, pack them into
+transactions under the --max-tx-gas gas limit, then sign and broadcast the transactions
+with consecutive account sequences, without waiting for their inclusion in a block. If you
+supply a dash (-) argument in place of an input filename, the command reads from standard input.
+
+With --gas=auto, the gas of the transactions is simulated; otherwise, each message is given
+the --gas amount. Every transaction pays the --fees fees, or the fees computed from its gas
+and the --gas-prices gas prices.
+
+A transaction rejected for a wrong account sequence is signed again with the account
+sequence queried from the node, and broadcast again, up to --max-retries times. The batch
+is only broadcast in sync mode. With --wait, the command waits for the inclusion of the
+transactions in a block once all of them are broadcast.
+
+Example:
+$ %s tx batch msgs.jsonl --from mykey --gas auto --gas-prices 0.025stake
+
+where each line of msgs.jsonl is a message, such as:
+{"@type":"/cosmos.bank.v1beta1.MsgSend","from_address":"cosmos1...","to_address":"cosmos1...","amount":[{"denom":"stake","amount":"10"}]}
+`, version.AppName),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if clientCtx.Offline || clientCtx.GenerateOnly {
+				return errors.New("cannot broadcast a batch in offline or generate only mode")
+			}
+
+			if clientCtx.BroadcastMode != flags.BroadcastSync {
+				return fmt.Errorf("a batch must be broadcast in %s mode", flags.BroadcastSync)
+			}
+
+			msgs, err := authclient.ReadMsgsFromFile(clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			txf, err = txf.Prepare(clientCtx)
+			if err != nil {
+				return err
+			}
+
+			opts := tx.DefaultBatchOptions()
+			opts.MaxGas, _ = cmd.Flags().GetUint64(flagMaxTxGas)
+			opts.MaxRetries, _ = cmd.Flags().GetInt(flagMaxRetries)
+
+			txs, err := tx.PackMsgs(clientCtx, txf, opts.MaxGas, msgs...)
+			if err != nil {
+				return err
+			}
+
+			if !clientCtx.SkipConfirm {
+				prompt := fmt.Sprintf("confirm broadcasting %d messages in %d transactions", len(msgs), len(txs))
+				ok, err := input.GetConfirmation(prompt, bufio.NewReader(cmd.InOrStdin()), cmd.ErrOrStderr())
+				if err != nil || !ok {
+					cmd.PrintErrln("canceled batch")
+					return err
+				}
+			}
+
+			responses, err := tx.BroadcastBatchTxs(clientCtx, txf, opts, txs)
+			for _, res := range responses {
+				if err := clientCtx.PrintProto(res); err != nil {
+					return err
+				}
+			}
+
+			return err
+		},
+	}
+
+	cmd.Flags().Uint64(flagMaxTxGas, defaultOpts.MaxGas, "The gas limit of the transactions of the batch")
+	cmd.Flags().Int(flagMaxRetries, defaultOpts.MaxRetries, "The number of times a transaction rejected for a wrong account sequence is broadcast again")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -2,6 +2,7 @@ package cli_test
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"io"
 	"strings"
@@ -708,6 +709,117 @@ func (s *CLITestSuite) TestCLIMultisign() {
 	s.Require().NoError(err)
 }
 
+func (s *CLITestSuite) TestCLIMultisignIncremental() {
+	account1, err := s.clientCtx.Keyring.Key("newAccount1")
+	s.Require().NoError(err)
+	addr1, err := account1.GetAddress()
+	s.Require().NoError(err)
+	account2, err := s.clientCtx.Keyring.Key("newAccount2")
+	s.Require().NoError(err)
+	addr2, err := account2.GetAddress()
+	s.Require().NoError(err)
+	multisigRecord, err := s.clientCtx.Keyring.Key("multi")
+	s.Require().NoError(err)
+	addr, err := multisigRecord.GetAddress()
+	s.Require().NoError(err)
+
+	multiGeneratedTx, err := clitestutil.MsgSendExec(
+		s.clientCtx,
+		addr,
+		s.val,
+		sdk.NewCoins(
+			sdk.NewInt64Coin("stake", 5),
+		),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin("stake", math.NewInt(10))).String()),
+		fmt.Sprintf("--%s=true", flags.FlagGenerateOnly),
+	)
+	s.Require().NoError(err)
+	multiGeneratedTxFile := testutil.WriteToNewTempFile(s.T(), multiGeneratedTx.String())
+	defer multiGeneratedTxFile.Close()
+
+	s.clientCtx.HomeDir = strings.Replace(s.clientCtx.HomeDir, "simd", "simcli", 1)
+	account1Signature, err := authtestutil.TxSignExec(s.clientCtx, addr1, multiGeneratedTxFile.Name(), "--multisig", addr.String())
+	s.Require().NoError(err)
+	sign1File := testutil.WriteToNewTempFile(s.T(), account1Signature.String())
+	defer sign1File.Close()
+	account2Signature, err := authtestutil.TxSignExec(s.clientCtx, addr2, multiGeneratedTxFile.Name(), "--multisig", addr.String())
+	s.Require().NoError(err)
+	sign2File := testutil.WriteToNewTempFile(s.T(), account2Signature.String())
+	defer sign2File.Close()
+
+	requireStatus := func(out testutil.BufferWriter, signed, missing []string, complete bool) {
+		var status struct {
+			Address   string   `json:"address"`
+			Threshold uint32   `json:"threshold"`
+			Signed    []string `json:"signed"`
+			Missing   []string `json:"missing"`
+			Complete  bool     `json:"complete"`
+		}
+		s.Require().NoError(json.Unmarshal(out.Bytes(), &status))
+		s.Require().Equal(addr.String(), status.Address)
+		s.Require().Equal(uint32(2), status.Threshold)
+		s.Require().Equal(signed, status.Signed)
+		s.Require().Equal(missing, status.Missing)
+		s.Require().Equal(complete, status.Complete)
+	}
+
+	out, err := authtestutil.TxMultiSignStatusExec(s.clientCtx, multisigRecord.Name, multiGeneratedTxFile.Name())
+	s.Require().NoError(err)
+	requireStatus(out, []string{}, []string{addr1.String(), addr2.String()}, false)
+
+	// the first signature gives a partially signed transaction
+	partialTx, err := authtestutil.TxMultiSignExec(s.clientCtx, multisigRecord.Name, multiGeneratedTxFile.Name(), sign1File.Name())
+	s.Require().NoError(err)
+	partialTxFile := testutil.WriteToNewTempFile(s.T(), partialTx.String())
+	defer partialTxFile.Close()
+
+	out, err = authtestutil.TxMultiSignStatusExec(s.clientCtx, multisigRecord.Name, partialTxFile.Name())
+	s.Require().NoError(err)
+	requireStatus(out, []string{addr1.String()}, []string{addr2.String()}, false)
+
+	out, err = authtestutil.TxMultiSignStatusExec(s.clientCtx, multisigRecord.Name, partialTxFile.Name(), sign2File.Name())
+	s.Require().NoError(err)
+	requireStatus(out, []string{addr1.String(), addr2.String()}, []string{}, true)
+
+	// the partial multisig signature can be combined with the next ones
+	partialSig, err := authtestutil.TxMultiSignExec(s.clientCtx, multisigRecord.Name, multiGeneratedTxFile.Name(), sign1File.Name(), "--signature-only")
+	s.Require().NoError(err)
+	partialSigFile := testutil.WriteToNewTempFile(s.T(), partialSig.String())
+	defer partialSigFile.Close()
+
+	out, err = authtestutil.TxMultiSignStatusExec(s.clientCtx, multisigRecord.Name, multiGeneratedTxFile.Name(), partialSigFile.Name(), sign2File.Name())
+	s.Require().NoError(err)
+	requireStatus(out, []string{addr1.String(), addr2.String()}, []string{}, true)
+
+	// the second signature completes the partially signed transaction
+	signedTx, err := authtestutil.TxMultiSignExec(s.clientCtx, multisigRecord.Name, partialTxFile.Name(), sign2File.Name())
+	s.Require().NoError(err)
+	signedTxFile := testutil.WriteToNewTempFile(s.T(), signedTx.String())
+	defer signedTxFile.Close()
+
+	_, err = authtestutil.TxValidateSignaturesExec(s.clientCtx, signedTxFile.Name())
+	s.Require().NoError(err)
+
+	// the signatures of another sign mode or account sequence are rejected
+	otherModeSignature, err := authtestutil.TxSignExec(s.clientCtx, addr2, multiGeneratedTxFile.Name(), "--multisig", addr.String(), "--sign-mode=direct")
+	s.Require().NoError(err)
+	otherModeFile := testutil.WriteToNewTempFile(s.T(), otherModeSignature.String())
+	defer otherModeFile.Close()
+
+	_, err = authtestutil.TxMultiSignExec(s.clientCtx, multisigRecord.Name, partialTxFile.Name(), otherModeFile.Name())
+	s.Require().ErrorContains(err, "sign mode")
+
+	otherSequenceSignature, err := authtestutil.TxSignExec(s.clientCtx, addr2, multiGeneratedTxFile.Name(), "--multisig", addr.String(),
+		"--offline", "--account-number=0", "--sequence=5")
+	s.Require().NoError(err)
+	otherSequenceFile := testutil.WriteToNewTempFile(s.T(), otherSequenceSignature.String())
+	defer otherSequenceFile.Close()
+
+	_, err = authtestutil.TxMultiSignExec(s.clientCtx, multisigRecord.Name, partialTxFile.Name(), otherSequenceFile.Name())
+	s.Require().ErrorContains(err, "account sequence 5")
+}
+
 func (s *CLITestSuite) TestSignBatchMultisig() {
 	// Fetch 2 accounts and a multisig.
 	account1, err := s.clientCtx.Keyring.Key("newAccount1")
@@ -810,6 +922,44 @@ func (s *CLITestSuite) TestGetBroadcastCommandWithoutOfflineFlag() {
 	s.Require().Contains(out.String(), "connect: connection refused")
 }
 
+func (s *CLITestSuite) TestCLIBatch() {
+	var lines []string
+	for i := 1; i <= 3; i++ {
+		msg := banktypes.NewMsgSend(s.val, s.val1, sdk.NewCoins(sdk.NewInt64Coin("stake", int64(i))))
+		bz, err := s.clientCtx.Codec.MarshalInterfaceJSON(msg)
+		s.Require().NoError(err)
+		lines = append(lines, string(bz))
+	}
+	msgsFile := testutil.WriteToNewTempFile(s.T(), strings.Join(lines, "\n")+"\n\n")
+	defer msgsFile.Close()
+
+	args := []string{
+		msgsFile.Name(),
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, s.val.String()),
+		fmt.Sprintf("--%s=%s", flags.FlagChainID, s.clientCtx.ChainID),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=100000", flags.FlagGas),
+		"--max-tx-gas=200000",
+		fmt.Sprintf("--%s=json", flags.FlagOutput),
+	}
+	out, err := clitestutil.ExecTestCLICmd(s.clientCtx, authcli.GetBatchCommand(), args)
+	s.Require().NoError(err)
+
+	// the 3 messages are packed into 2 transactions
+	s.Require().Equal(2, strings.Count(out.String(), `"txhash"`))
+
+	invalidFile := testutil.WriteToNewTempFile(s.T(), lines[0]+"\n{\"@type\":\"/cosmos.bank.v1beta1.MsgSend\",\"amount\":\"10\"}\n")
+	defer invalidFile.Close()
+	args[0] = invalidFile.Name()
+	_, err = clitestutil.ExecTestCLICmd(s.clientCtx, authcli.GetBatchCommand(), args)
+	s.Require().ErrorContains(err, "line 2")
+
+	args[0] = msgsFile.Name()
+	_, err = clitestutil.ExecTestCLICmd(s.clientCtx, authcli.GetBatchCommand(), append(args, "--max-tx-gas=50000"))
+	s.Require().ErrorContains(err, "more than the gas limit")
+}
+
 func (s *CLITestSuite) TestQueryParamsCmd() {
 	testCases := []struct {
 		name      string
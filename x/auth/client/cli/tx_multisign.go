@@ -1,6 +1,7 @@
 package cli
 
 import (
+	"encoding/json"
 	"fmt"
 	"os"
 	"strings"
@@ -41,6 +42,19 @@ multisig key [name], and attach the key name to the transaction read from [file]
 Example:
 $ %s tx multisign transaction.json k1k2k3 k1sig.json k2sig.json k3sig.json
 
+The signatures can be collected incrementally: until the threshold read from the multisig key
+is reached, the output is a partially signed transaction, which can be passed again as [file]
+along with the next signatures. The [signature] files can also hold a partial multisig
+signature, output with the --signature-only flag. Use the multisign-status command to see
+which members of the multisig key have signed.
+
+Example:
+$ %s tx multisign transaction.json k1k2k3 k1sig.json > partial.json
+$ %s tx multisign partial.json k1k2k3 k2sig.json
+
+The signatures must all use the same sign mode, and be made for the account sequence of the
+multisig account, or the command fails before verifying them.
+
 If --signature-only flag is on, output a JSON representation
 of only the generated signature.
 
@@ -51,7 +65,7 @@ set these parameters manually.
 The current multisig implementation defaults to amino-json sign mode.
 The SIGN_MODE_DIRECT sign mode is not supported.'
 `,
-				version.AppName,
+				version.AppName, version.AppName, version.AppName,
 			),
 		),
 		RunE: makeMultiSignCmd(),
@@ -67,141 +81,318 @@ The SIGN_MODE_DIRECT sign mode is not supported.'
 
 func makeMultiSignCmd() func(cmd *cobra.Command, args []string) (err error) {
 	return func(cmd *cobra.Command, args []string) (err error) {
-		clientCtx, err := client.GetClientTxContext(cmd)
+		clientCtx, txFactory, txBuilder, multisigPub, err := readMultisignInputs(cmd, args[0], args[1])
 		if err != nil {
 			return err
 		}
-		parsedTx, err := authclient.ReadTxFromFile(clientCtx, args[0])
-		if err != nil {
-			return
-		}
 
-		txFactory, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+		multisigSig, err := collectMultisignatures(cmd, clientCtx, txFactory, txBuilder, multisigPub, args[2:])
 		if err != nil {
 			return err
 		}
-		if txFactory.SignMode() == signingtypes.SignMode_SIGN_MODE_UNSPECIFIED {
-			txFactory = txFactory.WithSignMode(signingtypes.SignMode_SIGN_MODE_LEGACY_AMINO_JSON)
+
+		sigV2 := signingtypes.SignatureV2{
+			PubKey:   multisigPub,
+			Data:     multisigSig,
+			Sequence: txFactory.Sequence(),
 		}
 
-		txCfg := clientCtx.TxConfig
-		txBuilder, err := txCfg.WrapTxBuilder(parsedTx)
+		err = txBuilder.SetSignatures(sigV2)
 		if err != nil {
 			return err
 		}
 
-		k, err := getMultisigRecord(clientCtx, args[1])
+		sigOnly, _ := cmd.Flags().GetBool(flagSigOnly)
+
+		var json []byte
+		json, err = marshalSignatureJSON(clientCtx.TxConfig, txBuilder, sigOnly)
 		if err != nil {
 			return err
 		}
-		pubKey, err := k.GetPubKey()
-		if err != nil {
-			return err
+
+		outputDoc, _ := cmd.Flags().GetString(flags.FlagOutputDocument)
+		if outputDoc == "" {
+			cmd.Printf("%s\n", json)
+			return
 		}
 
-		addr, err := k.GetAddress()
+		fp, err := os.OpenFile(outputDoc, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0o644)
 		if err != nil {
 			return err
 		}
 
-		multisigPub := pubKey.(*kmultisig.LegacyAminoPubKey)
-		multisigSig := multisig.NewMultisig(len(multisigPub.PubKeys))
-		if !clientCtx.Offline {
-			accnum, seq, err := clientCtx.AccountRetriever.GetAccountNumberSequence(clientCtx, addr)
-			if err != nil {
-				return err
+		defer func() {
+			err2 := fp.Close()
+			if err == nil {
+				err = err2
 			}
+		}()
 
-			txFactory = txFactory.WithAccountNumber(accnum).WithSequence(seq)
-		}
+		err = clientCtx.PrintBytes(json)
 
-		// read each signature and add it to the multisig if valid
-		for i := 2; i < len(args); i++ {
-			sigs, err := unmarshalSignatureJSON(clientCtx, args[i])
+		return
+	}
+}
+
+// multisignStatus describes the signatures collected for a multisig account.
+type multisignStatus struct {
+	Address   string   `json:"address"`
+	Threshold uint32   `json:"threshold"`
+	Signed    []string `json:"signed"`
+	Missing   []string `json:"missing"`
+	Complete  bool     `json:"complete"`
+}
+
+// GetMultiSignStatusCommand returns the multi-sign-status command
+func GetMultiSignStatusCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "multi-sign-status [file] [name] [[signature]...]",
+		Aliases: []string{"multisign-status"},
+		Short:   "Show the members of a multisig key who signed a transaction",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Show the members of the multisig key [name] who signed the transaction read from [file],
+who are missing, and whether the threshold of the multisig key is reached.
+
+The signatures are those of the partially signed transaction output by the multisign command,
+and of the optional [signature] files. They are verified as by the multisign command.
+
+Example:
+$ %s tx multisign-status partial.json k1k2k3
+$ %s tx multisign-status transaction.json k1k2k3 k1sig.json k2sig.json
+`,
+				version.AppName, version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, txFactory, txBuilder, multisigPub, err := readMultisignInputs(cmd, args[0], args[1])
 			if err != nil {
 				return err
 			}
 
-			if txFactory.ChainID() == "" {
-				return fmt.Errorf("set the chain id with either the --chain-id flag or config file")
+			multisigSig, err := collectMultisignatures(cmd, clientCtx, txFactory, txBuilder, multisigPub, args[2:])
+			if err != nil {
+				return err
 			}
 
-			for _, sig := range sigs {
-				anyPk, err := codectypes.NewAnyWithValue(sig.PubKey)
-				if err != nil {
-					return err
-				}
-				txSignerData := txsigning.SignerData{
-					ChainID:       txFactory.ChainID(),
-					AccountNumber: txFactory.AccountNumber(),
-					Sequence:      txFactory.Sequence(),
-					Address:       sdk.AccAddress(sig.PubKey.Address()).String(),
-					PubKey: &anypb.Any{
-						TypeUrl: anyPk.TypeUrl,
-						Value:   anyPk.Value,
-					},
-				}
-				builtTx := txBuilder.GetTx()
-				adaptableTx, ok := builtTx.(signing.V2AdaptableTx)
-				if !ok {
-					return fmt.Errorf("expected Tx to be signing.V2AdaptableTx, got %T", builtTx)
-				}
-				txData := adaptableTx.GetSigningTxData()
-
-				err = signing.VerifySignature(cmd.Context(), sig.PubKey, txSignerData, sig.Data,
-					txCfg.SignModeHandler(), txData)
-				if err != nil {
-					addr, _ := sdk.AccAddressFromHexUnsafe(sig.PubKey.Address().String())
-					return fmt.Errorf("couldn't verify signature for address %s", addr)
+			status := multisignStatus{
+				Address:   sdk.AccAddress(multisigPub.Address()).String(),
+				Threshold: multisigPub.Threshold,
+				Signed:    []string{},
+				Missing:   []string{},
+				Complete:  len(multisigSig.Signatures) >= int(multisigPub.Threshold),
+			}
+			for i, pk := range multisigPub.GetPubKeys() {
+				addr := sdk.AccAddress(pk.Address()).String()
+				if multisigSig.BitArray.GetIndex(i) {
+					status.Signed = append(status.Signed, addr)
+				} else {
+					status.Missing = append(status.Missing, addr)
 				}
+			}
 
-				if err := multisig.AddSignatureV2(multisigSig, sig, multisigPub.GetPubKeys()); err != nil {
-					return err
-				}
+			out, err := json.Marshal(status)
+			if err != nil {
+				return err
 			}
+
+			return clientCtx.PrintRaw(out)
+		},
+		Args: cobra.MinimumNArgs(2),
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// readMultisignInputs reads the transaction to multisign and the multisig key, and sets the
+// account number and sequence of the multisig account in the factory, unless offline.
+func readMultisignInputs(cmd *cobra.Command, filename, name string) (
+	client.Context, tx.Factory, client.TxBuilder, *kmultisig.LegacyAminoPubKey, error,
+) {
+	clientCtx, err := client.GetClientTxContext(cmd)
+	if err != nil {
+		return clientCtx, tx.Factory{}, nil, nil, err
+	}
+
+	parsedTx, err := authclient.ReadTxFromFile(clientCtx, filename)
+	if err != nil {
+		return clientCtx, tx.Factory{}, nil, nil, err
+	}
+
+	txFactory, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+	if err != nil {
+		return clientCtx, tx.Factory{}, nil, nil, err
+	}
+	if txFactory.ChainID() == "" {
+		return clientCtx, tx.Factory{}, nil, nil, fmt.Errorf("set the chain id with either the --chain-id flag or config file")
+	}
+
+	txBuilder, err := clientCtx.TxConfig.WrapTxBuilder(parsedTx)
+	if err != nil {
+		return clientCtx, tx.Factory{}, nil, nil, err
+	}
+
+	k, err := getMultisigRecord(clientCtx, name)
+	if err != nil {
+		return clientCtx, tx.Factory{}, nil, nil, err
+	}
+	pubKey, err := k.GetPubKey()
+	if err != nil {
+		return clientCtx, tx.Factory{}, nil, nil, err
+	}
+	multisigPub, ok := pubKey.(*kmultisig.LegacyAminoPubKey)
+	if !ok {
+		return clientCtx, tx.Factory{}, nil, nil, fmt.Errorf("%s is not a multisig key", name)
+	}
+
+	if !clientCtx.Offline {
+		accnum, seq, err := clientCtx.AccountRetriever.GetAccountNumberSequence(clientCtx, sdk.AccAddress(multisigPub.Address()))
+		if err != nil {
+			return clientCtx, tx.Factory{}, nil, nil, err
 		}
 
-		sigV2 := signingtypes.SignatureV2{
-			PubKey:   multisigPub,
-			Data:     multisigSig,
-			Sequence: txFactory.Sequence(),
+		txFactory = txFactory.WithAccountNumber(accnum).WithSequence(seq)
+	}
+
+	return clientCtx, txFactory, txBuilder, multisigPub, nil
+}
+
+// collectMultisignatures verifies and combines into a multisig signature the signatures of the
+// members of a multisig key held by a partially signed transaction and by signature files. The
+// signature files hold either signatures of members, or partial multisig signatures. It fails
+// before verifying the signatures if they use different sign modes, or if they are made for
+// another sequence than the one of the multisig account. The signatures of the transaction are
+// cleared from the tx builder.
+func collectMultisignatures(
+	cmd *cobra.Command, clientCtx client.Context, txFactory tx.Factory, txBuilder client.TxBuilder,
+	multisigPub *kmultisig.LegacyAminoPubKey, sigFiles []string,
+) (*signingtypes.MultiSignatureData, error) {
+	txSigs, err := txBuilder.GetTx().GetSignaturesV2()
+	if err != nil {
+		return nil, err
+	}
+
+	var sigs []signingtypes.SignatureV2
+	for _, sig := range txSigs {
+		// the other signatures of the transaction are not the concern of the multisig
+		if multisigPub.Equals(sig.PubKey) {
+			sigs = append(sigs, sig)
 		}
+	}
+	for _, filename := range sigFiles {
+		fileSigs, err := unmarshalSignatureJSON(clientCtx, filename)
+		if err != nil {
+			return nil, err
+		}
+		sigs = append(sigs, fileSigs...)
+	}
 
-		err = txBuilder.SetSignatures(sigV2)
+	var memberSigs []signingtypes.SignatureV2
+	for _, sig := range sigs {
+		partialSigs, err := multisigMemberSignatures(multisigPub, sig)
 		if err != nil {
-			return err
+			return nil, err
 		}
+		memberSigs = append(memberSigs, partialSigs...)
+	}
 
-		sigOnly, _ := cmd.Flags().GetBool(flagSigOnly)
+	// the sign mode is the one given by the flags, or else the one of the first signature
+	signMode := txFactory.SignMode()
+	for _, sig := range memberSigs {
+		addr := sdk.AccAddress(sig.PubKey.Address())
+		if sig.Sequence != txFactory.Sequence() {
+			return nil, fmt.Errorf("the signature of %s is for the account sequence %d, but the sequence of the multisig account is %d",
+				addr, sig.Sequence, txFactory.Sequence())
+		}
 
-		var json []byte
-		json, err = marshalSignatureJSON(txCfg, txBuilder, sigOnly)
-		if err != nil {
-			return err
+		single, ok := sig.Data.(*signingtypes.SingleSignatureData)
+		if !ok {
+			return nil, fmt.Errorf("expected a single signature of %s, got %T", addr, sig.Data)
+		}
+		if signMode == signingtypes.SignMode_SIGN_MODE_UNSPECIFIED {
+			signMode = single.SignMode
+		}
+		if single.SignMode != signMode {
+			return nil, fmt.Errorf("the signature of %s uses the sign mode %s instead of %s", addr, single.SignMode, signMode)
 		}
+	}
 
-		outputDoc, _ := cmd.Flags().GetString(flags.FlagOutputDocument)
-		if outputDoc == "" {
-			cmd.Printf("%s\n", json)
-			return
+	// the members sign the transaction without signatures
+	if err := txBuilder.SetSignatures(); err != nil {
+		return nil, err
+	}
+	builtTx := txBuilder.GetTx()
+	adaptableTx, ok := builtTx.(signing.V2AdaptableTx)
+	if !ok {
+		return nil, fmt.Errorf("expected Tx to be signing.V2AdaptableTx, got %T", builtTx)
+	}
+	txData := adaptableTx.GetSigningTxData()
+
+	multisigSig := multisig.NewMultisig(len(multisigPub.PubKeys))
+	for _, sig := range memberSigs {
+		anyPk, err := codectypes.NewAnyWithValue(sig.PubKey)
+		if err != nil {
+			return nil, err
+		}
+		txSignerData := txsigning.SignerData{
+			ChainID:       txFactory.ChainID(),
+			AccountNumber: txFactory.AccountNumber(),
+			Sequence:      txFactory.Sequence(),
+			Address:       sdk.AccAddress(sig.PubKey.Address()).String(),
+			PubKey: &anypb.Any{
+				TypeUrl: anyPk.TypeUrl,
+				Value:   anyPk.Value,
+			},
 		}
 
-		fp, err := os.OpenFile(outputDoc, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0o644)
+		err = signing.VerifySignature(cmd.Context(), sig.PubKey, txSignerData, sig.Data,
+			clientCtx.TxConfig.SignModeHandler(), txData)
 		if err != nil {
-			return err
+			addr, _ := sdk.AccAddressFromHexUnsafe(sig.PubKey.Address().String())
+			return nil, fmt.Errorf("couldn't verify signature for address %s", addr)
 		}
 
-		defer func() {
-			err2 := fp.Close()
-			if err == nil {
-				err = err2
-			}
-		}()
+		if err := multisig.AddSignatureV2(multisigSig, sig, multisigPub.GetPubKeys()); err != nil {
+			return nil, err
+		}
+	}
 
-		err = clientCtx.PrintBytes(json)
+	return multisigSig, nil
+}
 
-		return
+// multisigMemberSignatures returns the signatures of the members of a multisig key held by a
+// signature: the signature itself if it is the signature of a member, or the signatures of the
+// members combined in a partial multisig signature of the multisig key.
+func multisigMemberSignatures(multisigPub *kmultisig.LegacyAminoPubKey, sig signingtypes.SignatureV2) ([]signingtypes.SignatureV2, error) {
+	if !multisigPub.Equals(sig.PubKey) {
+		return []signingtypes.SignatureV2{sig}, nil
+	}
+
+	multisigSig, ok := sig.Data.(*signingtypes.MultiSignatureData)
+	if !ok {
+		return nil, fmt.Errorf("expected %T, got %T", &signingtypes.MultiSignatureData{}, sig.Data)
+	}
+
+	var sigs []signingtypes.SignatureV2
+	sigIndex := 0
+	for i, pk := range multisigPub.GetPubKeys() {
+		if !multisigSig.BitArray.GetIndex(i) {
+			continue
+		}
+		if sigIndex >= len(multisigSig.Signatures) {
+			return nil, fmt.Errorf("the multisig signature has fewer signatures than its bit array")
+		}
+
+		sigs = append(sigs, signingtypes.SignatureV2{
+			PubKey:   pk,
+			Data:     multisigSig.Signatures[sigIndex],
+			Sequence: sig.Sequence,
+		})
+		sigIndex++
 	}
+
+	return sigs, nil
 }
 
 func GetMultiSignBatchCmd() *cobra.Command {
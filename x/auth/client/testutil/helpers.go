@@ -63,6 +63,17 @@ func TxMultiSignExec(clientCtx client.Context, from, filename string, extraArgs
 	return clitestutil.ExecTestCLICmd(clientCtx, cli.GetMultiSignCommand(), append(args, extraArgs...))
 }
 
+func TxMultiSignStatusExec(clientCtx client.Context, from, filename string, extraArgs ...string) (testutil.BufferWriter, error) {
+	args := []string{
+		fmt.Sprintf("--%s=%s", flags.FlagChainID, clientCtx.ChainID),
+		fmt.Sprintf("--%s=json", flags.FlagOutput),
+		filename,
+		from,
+	}
+
+	return clitestutil.ExecTestCLICmd(clientCtx, cli.GetMultiSignStatusCommand(), append(args, extraArgs...))
+}
+
 func TxSignBatchExec(clientCtx client.Context, from fmt.Stringer, filename string, extraArgs ...string) (testutil.BufferWriter, error) {
 	args := []string{
 		fmt.Sprintf("--from=%s", from.String()),
@@ -106,6 +106,37 @@ func ReadTxFromFile(ctx client.Context, filename string) (tx sdk.Tx, err error)
 	return ctx.TxConfig.TxJSONDecoder()(bytes)
 }
 
+// ReadMsgsFromFile reads and decodes newline-delimited JSON encoded messages, with their
+// type URL, from the given filename. Can pass "-" to read from stdin.
+func ReadMsgsFromFile(ctx client.Context, filename string) ([]sdk.Msg, error) {
+	var infile io.Reader = os.Stdin
+	if filename != "-" {
+		f, err := os.Open(filepath.Clean(filename))
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+
+		infile = f
+	}
+
+	var msgs []sdk.Msg
+	scanner := bufio.NewScanner(infile)
+	for line := 1; scanner.Scan(); line++ {
+		if len(bytes.TrimSpace(scanner.Bytes())) == 0 {
+			continue
+		}
+
+		var msg sdk.Msg
+		if err := ctx.Codec.UnmarshalInterfaceJSON(scanner.Bytes(), &msg); err != nil {
+			return nil, fmt.Errorf("couldn't decode the message of line %d: %w", line, err)
+		}
+		msgs = append(msgs, msg)
+	}
+
+	return msgs, scanner.Err()
+}
+
 // ReadTxsFromInput reads multiples txs from the given filename(s). Can pass "-" to read from stdin.
 // Unlike ReadTxFromFile, this function does not decode the txs.
 func ReadTxsFromInput(txCfg client.TxConfig, filenames ...string) (scanner *BatchScanner, err error) {
@@ -2,11 +2,11 @@ package keeper
 
 import (
 	"context"
+	"errors"
 
-	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/collections"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	"github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
 // NewAccountWithAddress implements AccountKeeperI.
@@ -31,8 +31,7 @@ func (ak AccountKeeper) NewAccount(ctx context.Context, acc sdk.AccountI) sdk.Ac
 
 // HasAccount implements AccountKeeperI.
 func (ak AccountKeeper) HasAccount(ctx context.Context, addr sdk.AccAddress) bool {
-	store := ak.storeService.OpenKVStore(ctx)
-	has, err := store.Has(types.AddressStoreKey(addr))
+	has, err := ak.Accounts.Has(ctx, addr)
 	if err != nil {
 		panic(err)
 	}
@@ -41,8 +40,7 @@ func (ak AccountKeeper) HasAccount(ctx context.Context, addr sdk.AccAddress) boo
 
 // HasAccountAddressByID checks account address exists by id.
 func (ak AccountKeeper) HasAccountAddressByID(ctx context.Context, id uint64) bool {
-	store := ak.storeService.OpenKVStore(ctx)
-	has, err := store.Has(types.AccountNumberStoreKey(id))
+	has, err := ak.AccountsByNumber.Has(ctx, id)
 	if err != nil {
 		panic(err)
 	}
@@ -51,31 +49,28 @@ func (ak AccountKeeper) HasAccountAddressByID(ctx context.Context, id uint64) bo
 
 // GetAccount implements AccountKeeperI.
 func (ak AccountKeeper) GetAccount(ctx context.Context, addr sdk.AccAddress) sdk.AccountI {
-	store := ak.storeService.OpenKVStore(ctx)
-	bz, err := store.Get(types.AddressStoreKey(addr))
+	acc, err := ak.Accounts.Get(ctx, addr)
 	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil
+		}
 		panic(err)
 	}
 
-	if bz == nil {
-		return nil
-	}
-
-	return ak.decodeAccount(bz)
+	return acc
 }
 
 // GetAccountAddressById returns account address by id.
 func (ak AccountKeeper) GetAccountAddressByID(ctx context.Context, id uint64) string {
-	store := ak.storeService.OpenKVStore(ctx)
-	bz, err := store.Get(types.AccountNumberStoreKey(id))
+	addr, err := ak.AccountsByNumber.Get(ctx, id)
 	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return ""
+		}
 		panic(err)
 	}
 
-	if bz == nil {
-		return ""
-	}
-	return sdk.AccAddress(bz).String()
+	return addr.String()
 }
 
 // GetAllAccounts returns all accounts in the accountKeeper.
@@ -91,29 +86,23 @@ func (ak AccountKeeper) GetAllAccounts(ctx context.Context) (accounts []sdk.Acco
 // SetAccount implements AccountKeeperI.
 func (ak AccountKeeper) SetAccount(ctx context.Context, acc sdk.AccountI) {
 	addr := acc.GetAddress()
-	store := ak.storeService.OpenKVStore(ctx)
-
-	bz, err := ak.MarshalAccount(acc)
-	if err != nil {
+	if err := ak.Accounts.Set(ctx, addr, acc); err != nil {
 		panic(err)
 	}
 
-	store.Set(types.AddressStoreKey(addr), bz)
-	store.Set(types.AccountNumberStoreKey(acc.GetAccountNumber()), addr.Bytes())
+	if err := ak.AccountsByNumber.Set(ctx, acc.GetAccountNumber(), addr); err != nil {
+		panic(err)
+	}
 }
 
 // RemoveAccount removes an account for the account mapper store.
 // NOTE: this will cause supply invariant violation if called
 func (ak AccountKeeper) RemoveAccount(ctx context.Context, acc sdk.AccountI) {
-	addr := acc.GetAddress()
-	store := ak.storeService.OpenKVStore(ctx)
-	err := store.Delete(types.AddressStoreKey(addr))
-	if err != nil {
+	if err := ak.Accounts.Remove(ctx, acc.GetAddress()); err != nil {
 		panic(err)
 	}
 
-	err = store.Delete(types.AccountNumberStoreKey(acc.GetAccountNumber()))
-	if err != nil {
+	if err := ak.AccountsByNumber.Remove(ctx, acc.GetAccountNumber()); err != nil {
 		panic(err)
 	}
 }
@@ -121,15 +110,17 @@ func (ak AccountKeeper) RemoveAccount(ctx context.Context, acc sdk.AccountI) {
 // IterateAccounts iterates over all the stored accounts and performs a callback function.
 // Stops iteration when callback returns true.
 func (ak AccountKeeper) IterateAccounts(ctx context.Context, cb func(account sdk.AccountI) (stop bool)) {
-	store := ak.storeService.OpenKVStore(ctx)
-	iterator, err := store.Iterator(types.AddressStoreKeyPrefix, storetypes.PrefixEndBytes(types.AddressStoreKeyPrefix))
+	iterator, err := ak.Accounts.Iterate(ctx, nil)
 	if err != nil {
 		panic(err)
 	}
 
 	defer iterator.Close()
 	for ; iterator.Valid(); iterator.Next() {
-		account := ak.decodeAccount(iterator.Value())
+		account, err := iterator.Value()
+		if err != nil {
+			panic(err)
+		}
 
 		if cb(account) {
 			break
@@ -6,7 +6,7 @@ import (
 	"fmt"
 
 	"cosmossdk.io/collections"
-
+	collcodec "cosmossdk.io/collections/codec"
 	"cosmossdk.io/core/address"
 	"cosmossdk.io/core/store"
 	errorsmod "cosmossdk.io/errors"
@@ -76,8 +76,20 @@ type AccountKeeper struct {
 	authority string
 
 	// State
+	Schema        collections.Schema
 	Params        collections.Item[types.Params]
 	AccountNumber collections.Sequence
+	// Accounts contains the accounts, keyed by address.
+	Accounts collections.Map[sdk.AccAddress, sdk.AccountI]
+	// AccountsByNumber contains the addresses of the accounts, keyed by account
+	// number.
+	AccountsByNumber collections.Map[uint64, sdk.AccAddress]
+	// UnorderedTxs contains the IDs of the unordered transactions, keyed by
+	// their timeout height, to prevent them from being replayed until they expire.
+	UnorderedTxs collections.KeySet[collections.Pair[uint64, []byte]]
+	// UnorderedTxsByTime contains the IDs of the unordered transactions with a
+	// timeout timestamp, keyed by the unix nanoseconds of that timestamp.
+	UnorderedTxsByTime collections.KeySet[collections.Pair[int64, []byte]]
 }
 
 var _ AccountKeeperI = &AccountKeeper{}
@@ -99,17 +111,29 @@ func NewAccountKeeper(
 
 	sb := collections.NewSchemaBuilder(storeService)
 
-	return AccountKeeper{
-		Codec:         authcodec.NewBech32Codec(bech32Prefix),
-		bech32Prefix:  bech32Prefix,
-		storeService:  storeService,
-		proto:         proto,
-		cdc:           cdc,
-		permAddrs:     permAddrs,
-		authority:     authority,
-		Params:        collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
-		AccountNumber: collections.NewSequence(sb, types.GlobalAccountNumberKey, "account_number"),
+	ak := AccountKeeper{
+		Codec:              authcodec.NewBech32Codec(bech32Prefix),
+		bech32Prefix:       bech32Prefix,
+		storeService:       storeService,
+		proto:              proto,
+		cdc:                cdc,
+		permAddrs:          permAddrs,
+		authority:          authority,
+		Params:             collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		AccountNumber:      collections.NewSequence(sb, types.GlobalAccountNumberKey, "account_number"),
+		Accounts:           collections.NewMap(sb, types.AccountsKey, "accounts", sdk.AccAddressKey, codec.CollInterfaceValue[sdk.AccountI](cdc)),
+		AccountsByNumber:   collections.NewMap(sb, types.AccountsByNumberKey, "accounts_by_number", collections.Uint64Key, collcodec.KeyToValueCodec(sdk.AccAddressKey)),
+		UnorderedTxs:       collections.NewKeySet(sb, types.UnorderedTxsKey, "unordered_txs", collections.PairKeyCodec(collections.Uint64Key, collections.BytesKey)),
+		UnorderedTxsByTime: collections.NewKeySet(sb, types.UnorderedTxsByTimeKey, "unordered_txs_by_time", collections.PairKeyCodec(collections.Int64Key, collections.BytesKey)),
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
 	}
+	ak.Schema = schema
+
+	return ak
 }
 
 // GetAuthority returns the x/auth module's authority.
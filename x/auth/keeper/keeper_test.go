@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/runtime"
 
@@ -289,3 +290,74 @@ func (suite *KeeperTestSuite) TestInitGenesis() {
 	// we expect nextNum to be 2 because we initialize fee_collector as account number 1
 	suite.Require().Equal(2, int(nextNum))
 }
+
+func (suite *KeeperTestSuite) TestUnorderedTxs() {
+	ctx := suite.ctx.WithBlockHeight(5)
+	txHash1, txHash2 := []byte("tx1"), []byte("tx2")
+
+	// nothing to remove
+	suite.Require().NoError(suite.accountKeeper.RemoveExpiredUnorderedTxs(ctx))
+
+	suite.Require().NoError(suite.accountKeeper.AddUnorderedTx(ctx, 5, time.Time{}, txHash1))
+	suite.Require().NoError(suite.accountKeeper.AddUnorderedTx(ctx, 10, time.Time{}, txHash2))
+
+	contains, err := suite.accountKeeper.ContainsUnorderedTx(ctx, 5, time.Time{}, txHash1)
+	suite.Require().NoError(err)
+	suite.Require().True(contains)
+
+	contains, err = suite.accountKeeper.ContainsUnorderedTx(ctx, 10, time.Time{}, txHash1)
+	suite.Require().NoError(err)
+	suite.Require().False(contains)
+
+	// only the txs whose timeout height has been reached are removed
+	suite.Require().NoError(suite.accountKeeper.RemoveExpiredUnorderedTxs(ctx))
+
+	contains, err = suite.accountKeeper.ContainsUnorderedTx(ctx, 5, time.Time{}, txHash1)
+	suite.Require().NoError(err)
+	suite.Require().False(contains)
+
+	contains, err = suite.accountKeeper.ContainsUnorderedTx(ctx, 10, time.Time{}, txHash2)
+	suite.Require().NoError(err)
+	suite.Require().True(contains)
+
+	suite.Require().NoError(suite.accountKeeper.RemoveExpiredUnorderedTxs(ctx.WithBlockHeight(10)))
+
+	contains, err = suite.accountKeeper.ContainsUnorderedTx(ctx, 10, time.Time{}, txHash2)
+	suite.Require().NoError(err)
+	suite.Require().False(contains)
+}
+
+func (suite *KeeperTestSuite) TestUnorderedTxsByTime() {
+	blockTime := time.Unix(1_000_000, 0)
+	ctx := suite.ctx.WithBlockHeight(5).WithBlockTime(blockTime)
+	txHash1, txHash2 := []byte("tx1"), []byte("tx2")
+
+	// the timeout timestamp takes precedence over the timeout height
+	suite.Require().NoError(suite.accountKeeper.AddUnorderedTx(ctx, 5, blockTime.Add(-time.Second), txHash1))
+	suite.Require().NoError(suite.accountKeeper.AddUnorderedTx(ctx, 5, blockTime, txHash2))
+
+	contains, err := suite.accountKeeper.ContainsUnorderedTx(ctx, 5, blockTime.Add(-time.Second), txHash1)
+	suite.Require().NoError(err)
+	suite.Require().True(contains)
+
+	contains, err = suite.accountKeeper.ContainsUnorderedTx(ctx, 5, time.Time{}, txHash1)
+	suite.Require().NoError(err)
+	suite.Require().False(contains)
+
+	// only the txs whose timeout timestamp is before the block time are removed
+	suite.Require().NoError(suite.accountKeeper.RemoveExpiredUnorderedTxs(ctx))
+
+	contains, err = suite.accountKeeper.ContainsUnorderedTx(ctx, 5, blockTime.Add(-time.Second), txHash1)
+	suite.Require().NoError(err)
+	suite.Require().False(contains)
+
+	contains, err = suite.accountKeeper.ContainsUnorderedTx(ctx, 5, blockTime, txHash2)
+	suite.Require().NoError(err)
+	suite.Require().True(contains)
+
+	suite.Require().NoError(suite.accountKeeper.RemoveExpiredUnorderedTxs(ctx.WithBlockTime(blockTime.Add(time.Nanosecond))))
+
+	contains, err = suite.accountKeeper.ContainsUnorderedTx(ctx, 5, blockTime, txHash2)
+	suite.Require().NoError(err)
+	suite.Require().False(contains)
+}
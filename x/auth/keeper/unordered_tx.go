@@ -0,0 +1,76 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ContainsUnorderedTx returns true if the unordered transaction with the given
+// ID and timeouts has already been processed and has not expired yet. The
+// transaction is looked up by timeout timestamp if it is set, and by timeout
+// height otherwise.
+func (ak AccountKeeper) ContainsUnorderedTx(ctx context.Context, timeoutHeight uint64, timeoutTimestamp time.Time, txID []byte) (bool, error) {
+	if !timeoutTimestamp.IsZero() {
+		return ak.UnorderedTxsByTime.Has(ctx, collections.Join(timeoutTimestamp.UnixNano(), txID))
+	}
+
+	return ak.UnorderedTxs.Has(ctx, collections.Join(timeoutHeight, txID))
+}
+
+// AddUnorderedTx records the ID of an unordered transaction until its timeout
+// timestamp if it is set, or until its timeout height otherwise, so that it
+// cannot be replayed.
+func (ak AccountKeeper) AddUnorderedTx(ctx context.Context, timeoutHeight uint64, timeoutTimestamp time.Time, txID []byte) error {
+	if !timeoutTimestamp.IsZero() {
+		return ak.UnorderedTxsByTime.Set(ctx, collections.Join(timeoutTimestamp.UnixNano(), txID))
+	}
+
+	return ak.UnorderedTxs.Set(ctx, collections.Join(timeoutHeight, txID))
+}
+
+// RemoveExpiredUnorderedTxs deletes the IDs of the unordered transactions
+// whose timeout height has been reached, or whose timeout timestamp is before
+// the block time, as they cannot be included in a block anymore.
+func (ak AccountKeeper) RemoveExpiredUnorderedTxs(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	height := uint64(sdkCtx.BlockHeight())
+	heightRng := new(collections.Range[collections.Pair[uint64, []byte]]).
+		EndExclusive(collections.PairPrefix[uint64, []byte](height + 1))
+	if err := removeUnorderedTxs[uint64](ctx, ak.UnorderedTxs, heightRng); err != nil {
+		return err
+	}
+
+	blockTime := sdkCtx.BlockTime().UnixNano()
+	timeRng := new(collections.Range[collections.Pair[int64, []byte]]).
+		EndExclusive(collections.PairPrefix[int64, []byte](blockTime))
+	return removeUnorderedTxs[int64](ctx, ak.UnorderedTxsByTime, timeRng)
+}
+
+// removeUnorderedTxs deletes the unordered transaction IDs of the given range.
+func removeUnorderedTxs[K any](ctx context.Context, txs collections.KeySet[collections.Pair[K, []byte]], rng collections.Ranger[collections.Pair[K, []byte]]) error {
+	iter, err := txs.Iterate(ctx, rng)
+	if errors.Is(err, collections.ErrInvalidIterator) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	keys, err := iter.Keys()
+	if err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		if err := txs.Remove(ctx, key); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
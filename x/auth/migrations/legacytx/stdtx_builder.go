@@ -1,6 +1,8 @@
 package legacytx
 
 import (
+	"time"
+
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -59,6 +61,22 @@ func (s *StdTxBuilder) SetTimeoutHeight(height uint64) {
 	s.TimeoutHeight = height
 }
 
+// SetTimeoutTimestamp panics for non-zero timestamps, as they are not supported
+// by StdTxBuilder.
+func (s *StdTxBuilder) SetTimeoutTimestamp(timestamp time.Time) {
+	if !timestamp.IsZero() {
+		panic("StdTxBuilder does not support timeout timestamps")
+	}
+}
+
+// SetUnordered panics for unordered transactions, as they are not supported by
+// StdTxBuilder.
+func (s *StdTxBuilder) SetUnordered(v bool) {
+	if v {
+		panic("StdTxBuilder does not support unordered transactions")
+	}
+}
+
 // SetFeeGranter does nothing for stdtx
 func (s *StdTxBuilder) SetFeeGranter(_ sdk.AccAddress) {}
 
@@ -16,6 +16,7 @@ import (
 	"cosmossdk.io/core/appmodule"
 
 	modulev1 "cosmossdk.io/api/cosmos/auth/module/v1"
+	"cosmossdk.io/collections"
 
 	"cosmossdk.io/core/store"
 
@@ -25,6 +26,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
 	"github.com/cosmos/cosmos-sdk/x/auth/client/cli"
 	"github.com/cosmos/cosmos-sdk/x/auth/exported"
 	"github.com/cosmos/cosmos-sdk/x/auth/keeper"
@@ -106,7 +108,10 @@ type AppModule struct {
 	legacySubspace exported.Subspace
 }
 
-var _ appmodule.AppModule = AppModule{}
+var (
+	_ appmodule.AppModule     = AppModule{}
+	_ appmodule.HasEndBlocker = AppModule{}
+)
 
 // IsOnePerModuleType implements the depinject.OnePerModuleType interface.
 func (am AppModule) IsOnePerModuleType() {}
@@ -169,9 +174,21 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 	return cdc.MustMarshalJSON(gs)
 }
 
+// CollectionsSchema returns the collections schema of the auth module store,
+// it is used by the reflection service to decode the module state.
+func (am AppModule) CollectionsSchema() collections.Schema {
+	return am.accountKeeper.Schema
+}
+
 // ConsensusVersion implements AppModule/ConsensusVersion.
 func (AppModule) ConsensusVersion() uint64 { return ConsensusVersion }
 
+// EndBlock returns the end blocker for the auth module. It removes the expired
+// unordered transactions.
+func (am AppModule) EndBlock(ctx context.Context) error {
+	return am.accountKeeper.RemoveExpiredUnorderedTxs(ctx)
+}
+
 // AppModuleSimulation functions
 
 // GenerateGenesisState creates a randomized GenState of the auth module
@@ -230,6 +247,9 @@ type ModuleOutputs struct {
 
 	AccountKeeper keeper.AccountKeeper
 	Module        appmodule.AppModule
+	// SigGasConsumer accepts the account key types of the module config, it
+	// is used by the ante handler of the tx module.
+	SigGasConsumer ante.SignatureVerificationGasConsumer
 }
 
 func ProvideModule(in ModuleInputs) ModuleOutputs {
@@ -252,8 +272,13 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 		in.AccountI = types.ProtoBaseAccount
 	}
 
+	sigGasConsumer := ante.DefaultSigVerificationGasConsumer
+	if len(in.Config.AccountKeyTypes) > 0 {
+		sigGasConsumer = ante.NewSigVerificationGasConsumer(in.Config.AccountKeyTypes...)
+	}
+
 	k := keeper.NewAccountKeeper(in.Cdc, in.StoreService, in.AccountI, maccPerms, in.Config.Bech32Prefix, authority.String())
 	m := NewAppModule(in.Cdc, k, in.RandomGenesisAccountsFn, in.LegacySubspace)
 
-	return ModuleOutputs{AccountKeeper: k, Module: m}
+	return ModuleOutputs{AccountKeeper: k, Module: m, SigGasConsumer: sigGasConsumer}
 }
@@ -3,12 +3,20 @@ package auth_test
 import (
 	"testing"
 
+	modulev1 "cosmossdk.io/api/cosmos/auth/module/v1"
 	"cosmossdk.io/depinject"
 	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	"github.com/stretchr/testify/require"
 
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/runtime"
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/auth/keeper"
 	"github.com/cosmos/cosmos-sdk/x/auth/testutil"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -28,3 +36,40 @@ func TestItCreatesModuleAccountOnInitBlock(t *testing.T) {
 	acc := accountKeeper.GetAccount(ctx, types.NewModuleAddress(types.FeeCollectorName))
 	require.NotNil(t, acc)
 }
+
+func TestProvideModuleAccountKeyTypes(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig(auth.AppModuleBasic{})
+	storeService := runtime.NewKVStoreService(storetypes.NewKVStoreKey(types.StoreKey))
+
+	ed25519Sig := signing.SignatureV2{PubKey: ed25519.GenPrivKey().PubKey(), Data: &signing.SingleSignatureData{}}
+	secp256k1Sig := signing.SignatureV2{PubKey: secp256k1.GenPrivKey().PubKey(), Data: &signing.SingleSignatureData{}}
+
+	testCases := []struct {
+		name            string
+		accountKeyTypes []string
+		expectEd25519   bool
+		expectSecp256k1 bool
+	}{
+		{"default key types", nil, false, true},
+		{"ed25519 only", []string{"ed25519"}, true, false},
+		{"ed25519 and secp256k1", []string{"ed25519", "secp256k1"}, true, true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			out := auth.ProvideModule(auth.ModuleInputs{
+				Config:       &modulev1.Module{Bech32Prefix: "cosmos", AccountKeyTypes: tc.accountKeyTypes},
+				StoreService: storeService,
+				Cdc:          encCfg.Codec,
+			})
+
+			err := out.SigGasConsumer(storetypes.NewInfiniteGasMeter(), ed25519Sig, types.DefaultParams())
+			require.Equal(t, tc.expectEd25519, err == nil, err)
+
+			err = out.SigGasConsumer(storetypes.NewInfiniteGasMeter(), secp256k1Sig, types.DefaultParams())
+			require.Equal(t, tc.expectSecp256k1, err == nil, err)
+		})
+	}
+}
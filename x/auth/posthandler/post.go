@@ -1,15 +1,38 @@
 package posthandler
 
 import (
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 // HandlerOptions are the options required for constructing a default SDK PostHandler.
-type HandlerOptions struct{}
+type HandlerOptions struct {
+	// BankKeeper refunds the fees of the unused gas, it is required if
+	// GasRefundRatio is set.
+	BankKeeper RefundBankKeeper
+	// GasRefundRatio is the fraction of the fees paid for the unused gas which
+	// is refunded, between 0 and 1. Gas is not refunded if it is not set.
+	GasRefundRatio sdkmath.LegacyDec
+}
 
-// NewPostHandler returns an empty PostHandler chain.
-func NewPostHandler(_ HandlerOptions) (sdk.PostHandler, error) {
+// NewPostHandler returns a PostHandler chain, refunding unused gas if enabled
+// by the options.
+func NewPostHandler(options HandlerOptions) (sdk.PostHandler, error) {
 	postDecorators := []sdk.PostDecorator{}
 
+	if !options.GasRefundRatio.IsNil() && options.GasRefundRatio.IsPositive() {
+		if options.BankKeeper == nil {
+			return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "bank keeper is required for gas refunds")
+		}
+		if options.GasRefundRatio.GT(sdkmath.LegacyOneDec()) {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrLogic, "gas refund ratio must be between 0 and 1, got %s", options.GasRefundRatio)
+		}
+
+		postDecorators = append(postDecorators, NewGasRefundDecorator(options.BankKeeper, options.GasRefundRatio))
+	}
+
 	return sdk.ChainPostDecorators(postDecorators...), nil
 }
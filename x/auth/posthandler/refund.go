@@ -0,0 +1,107 @@
+package posthandler
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// RefundBankKeeper defines the bank keeper methods used to refund fees.
+type RefundBankKeeper interface {
+	SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+}
+
+// GasRefundDecorator refunds a fraction of the fees paid for the unused gas of
+// a transaction, from the fee collector to the fee payer, or to the fee granter
+// if the fees were granted. The refund of every fee coin is the refund ratio
+// times the fee times the unused gas over the gas limit, rounded down.
+//
+// CONTRACT: the fees of the transaction were deducted by the DeductFeeDecorator
+// with a fee checker returning the fees of the transaction, which the default
+// one does. Only the transactions whose messages succeeded get a refund, as the
+// post handlers only run for them.
+type GasRefundDecorator struct {
+	bankKeeper  RefundBankKeeper
+	refundRatio sdkmath.LegacyDec
+}
+
+// NewGasRefundDecorator returns a new decorator refunding the given fraction
+// of the fees paid for the unused gas, which must be between 0 and 1.
+func NewGasRefundDecorator(bk RefundBankKeeper, refundRatio sdkmath.LegacyDec) GasRefundDecorator {
+	if refundRatio.IsNil() || refundRatio.IsNegative() || refundRatio.GT(sdkmath.LegacyOneDec()) {
+		panic("gas refund ratio must be between 0 and 1")
+	}
+
+	return GasRefundDecorator{
+		bankKeeper:  bk,
+		refundRatio: refundRatio,
+	}
+}
+
+func (d GasRefundDecorator) PostHandle(ctx sdk.Context, tx sdk.Tx, simulate, success bool, next sdk.PostHandler) (sdk.Context, error) {
+	if err := d.refundUnusedGas(ctx, tx); err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, tx, simulate, success)
+}
+
+// refundUnusedGas refunds the fees paid for the unused gas.
+func (d GasRefundDecorator) refundUnusedGas(ctx sdk.Context, sdkTx sdk.Tx) error {
+	feeTx, ok := sdkTx.(sdk.FeeTx)
+	if !ok {
+		return errorsmod.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	refund := d.RefundedFees(feeTx, ctx.GasMeter().GasConsumedToLimit())
+	if refund.IsZero() {
+		return nil
+	}
+
+	refundTo := feeTx.FeePayer()
+	if feeGranter := feeTx.FeeGranter(); feeGranter != nil {
+		refundTo = feeGranter
+	}
+
+	if err := d.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.FeeCollectorName, refundTo, refund); err != nil {
+		return errorsmod.Wrapf(err, "failed to refund fees to %s", refundTo)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeTx,
+			sdk.NewAttribute(sdk.AttributeKeyFeeRefund, refund.String()),
+			sdk.NewAttribute(sdk.AttributeKeyFeePayer, refundTo.String()),
+		),
+	)
+
+	return nil
+}
+
+// RefundedFees returns the fees refunded to a transaction which consumed the
+// given gas.
+func (d GasRefundDecorator) RefundedFees(feeTx sdk.FeeTx, gasUsed uint64) sdk.Coins {
+	gasLimit := feeTx.GetGas()
+	if gasUsed >= gasLimit {
+		return sdk.Coins{}
+	}
+
+	unusedRatio := sdkmath.LegacyNewDecFromInt(sdkmath.NewIntFromUint64(gasLimit - gasUsed)).
+		QuoInt(sdkmath.NewIntFromUint64(gasLimit)).
+		Mul(d.refundRatio)
+
+	refund := sdk.Coins{}
+	for _, fee := range feeTx.GetFee() {
+		amount := unusedRatio.MulInt(fee.Amount).TruncateInt()
+		if amount.IsPositive() {
+			refund = append(refund, sdk.NewCoin(fee.Denom, amount))
+		}
+	}
+
+	return refund
+}
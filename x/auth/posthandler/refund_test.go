@@ -0,0 +1,109 @@
+package posthandler_test
+
+import (
+	"context"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/x/auth/posthandler"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+type refund struct {
+	module string
+	to     sdk.AccAddress
+	amount sdk.Coins
+}
+
+type mockBankKeeper struct {
+	refunds []refund
+}
+
+func (bk *mockBankKeeper) SendCoinsFromModuleToAccount(_ context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error {
+	bk.refunds = append(bk.refunds, refund{module: senderModule, to: recipientAddr, amount: amt})
+	return nil
+}
+
+func TestGasRefundDecorator(t *testing.T) {
+	_, _, payer := testdata.KeyTestPubAddr()
+	_, _, granter := testdata.KeyTestPubAddr()
+	fee := sdk.NewCoins(sdk.NewInt64Coin("atom", 10), sdk.NewInt64Coin("stake", 1000))
+
+	testCases := map[string]struct {
+		gasUsed   uint64
+		granter   sdk.AccAddress
+		expRefund []refund
+	}{
+		"half of the gas used": {
+			gasUsed: 50000,
+			expRefund: []refund{{
+				module: types.FeeCollectorName,
+				to:     payer,
+				amount: sdk.NewCoins(sdk.NewInt64Coin("atom", 2), sdk.NewInt64Coin("stake", 250)),
+			}},
+		},
+		"granted fees": {
+			gasUsed: 50000,
+			granter: granter,
+			expRefund: []refund{{
+				module: types.FeeCollectorName,
+				to:     granter,
+				amount: sdk.NewCoins(sdk.NewInt64Coin("atom", 2), sdk.NewInt64Coin("stake", 250)),
+			}},
+		},
+		"refund rounded down to zero": {
+			gasUsed: 99990,
+		},
+		"all the gas used": {
+			gasUsed: 100000,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			builder := moduletestutil.MakeTestTxConfig().NewTxBuilder()
+			builder.SetFeeAmount(fee)
+			builder.SetGasLimit(100000)
+			builder.SetFeePayer(payer)
+			builder.SetFeeGranter(tc.granter)
+
+			key := storetypes.NewKVStoreKey(types.StoreKey)
+			ctx := testutil.DefaultContext(key, storetypes.NewTransientStoreKey("transient_test"))
+			ctx = ctx.WithGasMeter(storetypes.NewGasMeter(100000))
+			ctx.GasMeter().ConsumeGas(tc.gasUsed, "test")
+
+			bk := &mockBankKeeper{}
+			postHandler, err := posthandler.NewPostHandler(posthandler.HandlerOptions{
+				BankKeeper:     bk,
+				GasRefundRatio: sdkmath.LegacyNewDecWithPrec(5, 1),
+			})
+			require.NoError(t, err)
+
+			_, err = postHandler(ctx, builder.GetTx(), false, true)
+			require.NoError(t, err)
+			require.Equal(t, tc.expRefund, bk.refunds)
+		})
+	}
+}
+
+func TestNewPostHandlerGasRefund(t *testing.T) {
+	_, err := posthandler.NewPostHandler(posthandler.HandlerOptions{GasRefundRatio: sdkmath.LegacyOneDec()})
+	require.ErrorContains(t, err, "bank keeper is required")
+
+	_, err = posthandler.NewPostHandler(posthandler.HandlerOptions{
+		BankKeeper:     &mockBankKeeper{},
+		GasRefundRatio: sdkmath.LegacyNewDec(2),
+	})
+	require.ErrorContains(t, err, "gas refund ratio must be between 0 and 1")
+
+	postHandler, err := posthandler.NewPostHandler(posthandler.HandlerOptions{})
+	require.NoError(t, err)
+	require.Nil(t, postHandler)
+}
@@ -1,6 +1,9 @@
 package tx
 
 import (
+	"crypto/sha256"
+	"time"
+
 	"github.com/cosmos/gogoproto/proto"
 
 	errorsmod "cosmossdk.io/errors"
@@ -168,6 +171,29 @@ func (w *wrapper) GetTimeoutHeight() uint64 {
 	return w.tx.Body.TimeoutHeight
 }
 
+// GetTimeoutTimeStamp returns the transaction's timeout timestamp, or the zero
+// time if it is not set.
+func (w *wrapper) GetTimeoutTimeStamp() time.Time {
+	if w.tx.Body.TimeoutTimestamp == nil {
+		return time.Time{}
+	}
+	return *w.tx.Body.TimeoutTimestamp
+}
+
+// GetUnordered returns whether the transaction is unordered.
+func (w *wrapper) GetUnordered() bool {
+	return w.tx.Body.Unordered
+}
+
+// GetUnorderedID returns the hash of the body and auth info bytes of the
+// transaction, which are covered by the signatures, contrary to the tx hash.
+func (w *wrapper) GetUnorderedID() []byte {
+	bodyHash := sha256.Sum256(w.getBodyBytes())
+	authInfoHash := sha256.Sum256(w.getAuthInfoBytes())
+	id := sha256.Sum256(append(bodyHash[:], authInfoHash[:]...))
+	return id[:]
+}
+
 func (w *wrapper) GetSignaturesV2() ([]signing.SignatureV2, error) {
 	signerInfos := w.tx.AuthInfo.SignerInfos
 	sigs := w.tx.Signatures
@@ -226,6 +252,27 @@ func (w *wrapper) SetTimeoutHeight(height uint64) {
 	w.bodyBz = nil
 }
 
+// SetTimeoutTimestamp sets the transaction's time based timeout, a zero time
+// unsets it.
+func (w *wrapper) SetTimeoutTimestamp(timestamp time.Time) {
+	if timestamp.IsZero() {
+		w.tx.Body.TimeoutTimestamp = nil
+	} else {
+		w.tx.Body.TimeoutTimestamp = &timestamp
+	}
+
+	// set bodyBz to nil because the cached bodyBz no longer matches tx.Body
+	w.bodyBz = nil
+}
+
+// SetUnordered sets the transaction's unordered field.
+func (w *wrapper) SetUnordered(v bool) {
+	w.tx.Body.Unordered = v
+
+	// set bodyBz to nil because the cached bodyBz no longer matches tx.Body
+	w.bodyBz = nil
+}
+
 func (w *wrapper) SetMemo(memo string) {
 	w.tx.Body.Memo = memo
 
@@ -396,6 +443,12 @@ func (w *wrapper) AddAuxSignerData(data tx.AuxSignerData) error {
 	if w.tx.Body.TimeoutHeight != 0 && w.tx.Body.TimeoutHeight != body.TimeoutHeight {
 		return sdkerrors.ErrInvalidRequest.Wrapf("TxBuilder has timeout height %d, got %d in AuxSignerData", w.tx.Body.TimeoutHeight, body.TimeoutHeight)
 	}
+	if timeout := w.GetTimeoutTimeStamp(); !timeout.IsZero() && (body.TimeoutTimestamp == nil || !timeout.Equal(*body.TimeoutTimestamp)) {
+		return sdkerrors.ErrInvalidRequest.Wrapf("TxBuilder has timeout timestamp %s, got %v in AuxSignerData", timeout, body.TimeoutTimestamp)
+	}
+	if w.tx.Body.Unordered && !body.Unordered {
+		return sdkerrors.ErrInvalidRequest.Wrap("TxBuilder is unordered, got an ordered tx in AuxSignerData")
+	}
 	if len(w.tx.Body.ExtensionOptions) != 0 {
 		if len(w.tx.Body.ExtensionOptions) != len(body.ExtensionOptions) {
 			return sdkerrors.ErrInvalidRequest.Wrapf("TxBuilder has %d extension options, got %d in AuxSignerData", len(w.tx.Body.ExtensionOptions), len(body.ExtensionOptions))
@@ -437,6 +490,10 @@ func (w *wrapper) AddAuxSignerData(data tx.AuxSignerData) error {
 
 	w.SetMemo(body.Memo)
 	w.SetTimeoutHeight(body.TimeoutHeight)
+	if body.TimeoutTimestamp != nil {
+		w.SetTimeoutTimestamp(*body.TimeoutTimestamp)
+	}
+	w.SetUnordered(body.Unordered)
 	w.SetExtensionOptions(body.ExtensionOptions...)
 	w.SetNonCriticalExtensionOptions(body.NonCriticalExtensionOptions...)
 	msgs := make([]sdk.Msg, len(body.Messages))
@@ -46,6 +46,9 @@ type ModuleInputs struct {
 	AccountKeeper          ante.AccountKeeper                 `optional:"true"`
 	FeeGrantKeeper         ante.FeegrantKeeper                `optional:"true"`
 	CustomSignModeHandlers func() []txsigning.SignModeHandler `optional:"true"`
+	// SigGasConsumer is provided by the auth module, accepting the account key
+	// types of its config.
+	SigGasConsumer ante.SignatureVerificationGasConsumer `optional:"true"`
 }
 
 type ModuleOutputs struct {
@@ -126,13 +129,22 @@ func newAnteHandler(txConfig client.TxConfig, in ModuleInputs) (sdk.AnteHandler,
 		return nil, fmt.Errorf("both AccountKeeper and BankKeeper are required")
 	}
 
+	// the account keeper keeps track of the unordered transactions when it supports it
+	unorderedTxKeeper, _ := in.AccountKeeper.(ante.UnorderedTxKeeper)
+
+	sigGasConsumer := in.SigGasConsumer
+	if sigGasConsumer == nil {
+		sigGasConsumer = ante.DefaultSigVerificationGasConsumer
+	}
+
 	anteHandler, err := ante.NewAnteHandler(
 		ante.HandlerOptions{
-			AccountKeeper:   in.AccountKeeper,
-			BankKeeper:      in.BankKeeper,
-			SignModeHandler: txConfig.SignModeHandler(),
-			FeegrantKeeper:  in.FeeGrantKeeper,
-			SigGasConsumer:  ante.DefaultSigVerificationGasConsumer,
+			AccountKeeper:     in.AccountKeeper,
+			BankKeeper:        in.BankKeeper,
+			SignModeHandler:   txConfig.SignModeHandler(),
+			FeegrantKeeper:    in.FeeGrantKeeper,
+			SigGasConsumer:    sigGasConsumer,
+			UnorderedTxKeeper: unorderedTxKeeper,
 		},
 	)
 	if err != nil {
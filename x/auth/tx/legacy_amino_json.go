@@ -54,6 +54,14 @@ func (s signModeLegacyAminoJSONHandler) GetSignBytes(mode signingtypes.SignMode,
 		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "%s does not support protobuf extension options", signingtypes.SignMode_SIGN_MODE_LEGACY_AMINO_JSON)
 	}
 
+	if body.Unordered {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "%s does not support unordered transactions", signingtypes.SignMode_SIGN_MODE_LEGACY_AMINO_JSON)
+	}
+
+	if body.TimeoutTimestamp != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "%s does not support timeout timestamps", signingtypes.SignMode_SIGN_MODE_LEGACY_AMINO_JSON)
+	}
+
 	addr := data.Address
 	if addr == "" {
 		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "got empty address in %s handler", signingtypes.SignMode_SIGN_MODE_LEGACY_AMINO_JSON)
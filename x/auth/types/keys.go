@@ -29,6 +29,22 @@ var (
 
 	// AccountNumberStoreKeyPrefix prefix for account-by-id store
 	AccountNumberStoreKeyPrefix = []byte("accountNumber")
+
+	// AccountsKey is the prefix of the accounts collection, keyed by address.
+	AccountsKey = collections.NewPrefix(AddressStoreKeyPrefix)
+
+	// AccountsByNumberKey is the prefix of the account addresses collection,
+	// keyed by account number.
+	AccountsByNumberKey = collections.NewPrefix(AccountNumberStoreKeyPrefix)
+
+	// UnorderedTxsKey is the prefix of the IDs of the unordered transactions,
+	// kept by timeout height until they expire.
+	UnorderedTxsKey = collections.NewPrefix(3)
+
+	// UnorderedTxsByTimeKey is the prefix of the IDs of the unordered
+	// transactions with a timeout timestamp, kept by timeout timestamp until
+	// they expire.
+	UnorderedTxsByTimeKey = collections.NewPrefix(4)
 )
 
 // AddressStoreKey turn an address to key used to get it from the account store
@@ -100,7 +100,7 @@ type suite struct {
 	App                *runtime.App
 }
 
-func createTestSuite(t *testing.T, genesisAccounts []authtypes.GenesisAccount) suite {
+func createTestSuite(t *testing.T, genesisAccounts []authtypes.GenesisAccount, opts ...runtime.BaseAppOption) suite {
 	res := suite{}
 
 	var genAccounts []simtestutil.GenesisAccount
@@ -110,6 +110,11 @@ func createTestSuite(t *testing.T, genesisAccounts []authtypes.GenesisAccount) s
 
 	startupCfg := simtestutil.DefaultStartUpConfig()
 	startupCfg.GenesisAccounts = genAccounts
+	startupCfg.BaseAppOption = func(app *baseapp.BaseApp) {
+		for _, opt := range opts {
+			opt(app)
+		}
+	}
 
 	app, err := simtestutil.SetupWithConfiguration(
 		depinject.Configs(
@@ -1,6 +1,7 @@
 package bank_test
 
 import (
+	"fmt"
 	"math/rand"
 	"testing"
 	"time"
@@ -9,13 +10,16 @@ import (
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	"github.com/stretchr/testify/require"
 
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
@@ -146,3 +150,65 @@ func BenchmarkOneBankMultiSendTxPerBlock(b *testing.B) {
 		height++
 	}
 }
+
+func BenchmarkBankSendTxsPerBlock(b *testing.B) {
+	// stays within the block gas limit of the test app
+	const numSenders = 16
+
+	for _, workers := range []int{0, 4, 8} {
+		b.Run(fmt.Sprintf("%d workers", workers), func(b *testing.B) {
+			b.ReportAllocs()
+
+			// every sender sends to its own recipient, so that the transactions
+			// of a block do not conflict
+			privs := make([]cryptotypes.PrivKey, numSenders)
+			genAccs := make([]authtypes.GenesisAccount, 0, 2*numSenders)
+			for i := range privs {
+				privs[i] = secp256k1.GenPrivKey()
+				genAccs = append(genAccs,
+					&authtypes.BaseAccount{Address: sdk.AccAddress(privs[i].PubKey().Address()).String()},
+					&authtypes.BaseAccount{Address: sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()},
+				)
+			}
+
+			s := createTestSuite(&testing.T{}, genAccs, baseapp.SetTxExecutionWorkers(workers))
+			baseApp := s.App.BaseApp
+			ctx := baseApp.NewContext(false, cmtproto.Header{})
+
+			txGen := moduletestutil.MakeTestTxConfig()
+			blocks := make([][]abci.RequestDeliverTx, b.N)
+			for i, priv := range privs {
+				from := sdk.AccAddress(priv.PubKey().Address())
+				to, err := sdk.AccAddressFromBech32(genAccs[2*i+1].GetAddress().String())
+				require.NoError(b, err)
+				require.NoError(b, testutil.FundAccount(ctx, s.BankKeeper, from, sdk.NewCoins(sdk.NewInt64Coin("foocoin", 100000000000))))
+
+				// Precompute all txs
+				accNum := s.AccountKeeper.GetAccount(ctx, from).GetAccountNumber()
+				msg := types.NewMsgSend(from, to, sdk.Coins{sdk.NewInt64Coin("foocoin", 10)})
+				txs, err := genSequenceOfTxs(txGen, []sdk.Msg{msg}, []uint64{accNum}, []uint64{0}, b.N, priv)
+				require.NoError(b, err)
+
+				for n, tx := range txs {
+					txBytes, err := txGen.TxEncoder()(tx)
+					require.NoError(b, err)
+					blocks[n] = append(blocks[n], abci.RequestDeliverTx{Tx: txBytes})
+				}
+			}
+
+			baseApp.Commit()
+			b.ResetTimer()
+
+			height := int64(3)
+			for i := 0; i < b.N; i++ {
+				baseApp.BeginBlock(abci.RequestBeginBlock{Header: cmtproto.Header{Height: height}})
+				for _, res := range baseApp.DeliverTxs(blocks[i]) {
+					require.True(b, res.IsOK(), res.Log)
+				}
+				baseApp.EndBlock(abci.RequestEndBlock{Height: height})
+				baseApp.Commit()
+				height++
+			}
+		})
+	}
+}
@@ -23,6 +23,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/runtime"
 	"github.com/cosmos/cosmos-sdk/testutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -631,6 +632,118 @@ func (suite *KeeperTestSuite) TestSendCoins_Invalid_SendLockedCoins() {
 	suite.Require().Error(suite.bankKeeper.SendCoins(suite.ctx, accAddrs[0], accAddrs[1], sendCoins))
 }
 
+func (suite *KeeperTestSuite) TestSendCoins_SendRestrictions() {
+	ctx := suite.ctx
+	require := suite.Require()
+	balances := sdk.NewCoins(newFooCoin(100), newBarCoin(50))
+	sendAmt := sdk.NewCoins(newFooCoin(10))
+
+	acc0 := authtypes.NewBaseAccountWithAddress(accAddrs[0])
+	suite.mockFundAccount(accAddrs[0])
+	require.NoError(banktestutil.FundAccount(ctx, suite.bankKeeper, accAddrs[0], balances))
+
+	var calls []string
+	redirect := func(_ context.Context, _, _ sdk.AccAddress, _ sdk.Coins) (sdk.AccAddress, error) {
+		calls = append(calls, "redirect")
+		return accAddrs[2], nil
+	}
+	record := func(_ context.Context, _, toAddr sdk.AccAddress, _ sdk.Coins) (sdk.AccAddress, error) {
+		calls = append(calls, "record")
+		return toAddr, nil
+	}
+	reject := func(_ context.Context, _, _ sdk.AccAddress, _ sdk.Coins) (sdk.AccAddress, error) {
+		calls = append(calls, "reject")
+		return nil, fmt.Errorf("rejected")
+	}
+
+	// restrictions run in order and the recipient returned by one is given to the next
+	suite.bankKeeper.AppendSendRestriction(redirect)
+	suite.bankKeeper.PrependSendRestriction(record)
+	suite.mockSendCoins(ctx, acc0, accAddrs[2])
+	require.NoError(suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[1], sendAmt))
+	require.Equal([]string{"record", "redirect"}, calls)
+	require.Empty(suite.bankKeeper.GetAllBalances(ctx, accAddrs[1]))
+	require.Equal(sendAmt, suite.bankKeeper.GetAllBalances(ctx, accAddrs[2]))
+
+	// a rejection stops the transfer before any balance is changed
+	calls = nil
+	suite.bankKeeper.AppendSendRestriction(reject)
+	require.ErrorContains(suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[1], sendAmt), "rejected")
+	require.Equal([]string{"record", "redirect", "reject"}, calls)
+	require.Equal(balances.Sub(sendAmt...), suite.bankKeeper.GetAllBalances(ctx, accAddrs[0]))
+
+	// restrictions also apply to sends out of module accounts
+	calls = nil
+	suite.mockMintCoins(mintAcc)
+	require.NoError(suite.bankKeeper.MintCoins(ctx, minttypes.ModuleName, sendAmt))
+	suite.authKeeper.EXPECT().GetModuleAddress(mintAcc.Name).Return(mintAcc.GetAddress())
+	require.ErrorContains(suite.bankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, accAddrs[1], sendAmt), "rejected")
+	require.Equal([]string{"record", "redirect", "reject"}, calls)
+
+	// clearing the restrictions restores the default behavior
+	calls = nil
+	suite.bankKeeper.ClearSendRestriction()
+	suite.mockSendCoins(ctx, acc0, accAddrs[1])
+	require.NoError(suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[1], sendAmt))
+	require.Empty(calls)
+	require.Equal(sendAmt, suite.bankKeeper.GetAllBalances(ctx, accAddrs[1]))
+
+	// an empty recipient returned by a restriction is rejected
+	suite.bankKeeper.AppendSendRestriction(func(_ context.Context, _, _ sdk.AccAddress, _ sdk.Coins) (sdk.AccAddress, error) {
+		return nil, nil
+	})
+	require.ErrorIs(suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[1], sendAmt), sdkerrors.ErrInvalidAddress)
+}
+
+func (suite *KeeperTestSuite) TestInputOutputCoins_SendRestrictions() {
+	ctx := suite.ctx
+	require := suite.Require()
+	balances := sdk.NewCoins(newFooCoin(90), newBarCoin(30))
+
+	acc0 := authtypes.NewBaseAccountWithAddress(accAddrs[0])
+	suite.mockFundAccount(accAddrs[0])
+	require.NoError(banktestutil.FundAccount(ctx, suite.bankKeeper, accAddrs[0], balances))
+
+	// redirect everything sent to accAddrs[1] to accAddrs[3]
+	suite.bankKeeper.AppendSendRestriction(func(_ context.Context, _, toAddr sdk.AccAddress, _ sdk.Coins) (sdk.AccAddress, error) {
+		if toAddr.Equals(accAddrs[1]) {
+			return accAddrs[3], nil
+		}
+		return toAddr, nil
+	})
+
+	input := banktypes.Input{
+		Address: accAddrs[0].String(), Coins: sdk.NewCoins(newFooCoin(60), newBarCoin(20)),
+	}
+	outputs := []banktypes.Output{
+		{Address: accAddrs[1].String(), Coins: sdk.NewCoins(newFooCoin(30), newBarCoin(10))},
+		{Address: accAddrs[2].String(), Coins: sdk.NewCoins(newFooCoin(30), newBarCoin(10))},
+	}
+
+	suite.mockInputOutputCoins([]sdk.AccountI{acc0}, []sdk.AccAddress{accAddrs[3], accAddrs[2]})
+	require.NoError(suite.bankKeeper.InputOutputCoins(ctx, input, outputs))
+
+	expected := sdk.NewCoins(newFooCoin(30), newBarCoin(10))
+	require.Empty(suite.bankKeeper.GetAllBalances(ctx, accAddrs[1]))
+	require.Equal(expected, suite.bankKeeper.GetAllBalances(ctx, accAddrs[2]))
+	require.Equal(expected, suite.bankKeeper.GetAllBalances(ctx, accAddrs[3]))
+
+	// the transfer event reports the address that actually received the funds
+	var recipients []string
+	for _, e := range sdk.UnwrapSDKContext(ctx).EventManager().ABCIEvents() {
+		if e.Type != banktypes.EventTypeTransfer {
+			continue
+		}
+		for _, attr := range e.Attributes {
+			if attr.Key == banktypes.AttributeKeyRecipient {
+				recipients = append(recipients, attr.Value)
+			}
+		}
+	}
+	require.Contains(recipients, accAddrs[3].String())
+	require.NotContains(recipients, accAddrs[1].String())
+}
+
 func (suite *KeeperTestSuite) TestValidateBalance() {
 	ctx := suite.ctx
 	require := suite.Require()
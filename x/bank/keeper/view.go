@@ -101,6 +101,11 @@ func (k BaseViewKeeper) HasBalance(ctx context.Context, addr sdk.AccAddress, amt
 	return k.GetBalance(ctx, addr, amt.Denom).IsGTE(amt)
 }
 
+// CollectionsSchema returns the collections schema of the bank module store.
+func (k BaseViewKeeper) CollectionsSchema() collections.Schema {
+	return k.Schema
+}
+
 // Logger returns a module-specific logger.
 func (k BaseViewKeeper) Logger() log.Logger {
 	return k.logger
@@ -4,15 +4,18 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"sort"
 	"time"
 
 	modulev1 "cosmossdk.io/api/cosmos/bank/module/v1"
+	"cosmossdk.io/collections"
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/depinject"
 	"cosmossdk.io/log"
 	abci "github.com/cometbft/cometbft/abci/types"
 	gwruntime "github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"github.com/spf13/cobra"
+	"golang.org/x/exp/maps"
 
 	corestore "cosmossdk.io/core/store"
 
@@ -174,6 +177,12 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 	return cdc.MustMarshalJSON(gs)
 }
 
+// CollectionsSchema returns the collections schema of the bank module store,
+// it is used by the reflection service to decode the module state.
+func (am AppModule) CollectionsSchema() collections.Schema {
+	return am.keeper.CollectionsSchema()
+}
+
 // ConsensusVersion implements AppModule/ConsensusVersion.
 func (AppModule) ConsensusVersion() uint64 { return ConsensusVersion }
 
@@ -204,6 +213,7 @@ func (am AppModule) WeightedOperations(simState module.SimulationState) []simtyp
 func init() {
 	appmodule.Register(&modulev1.Module{},
 		appmodule.Provide(ProvideModule),
+		appmodule.Invoke(InvokeSetSendRestrictions),
 	)
 }
 
@@ -262,3 +272,30 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 
 	return ModuleOutputs{BankKeeper: bankKeeper, Module: m}
 }
+
+// InvokeSetSendRestrictions registers the send restrictions provided by other
+// modules with the bank keeper.
+func InvokeSetSendRestrictions(
+	config *modulev1.Module,
+	keeper keeper.BaseKeeper,
+	restrictions map[string]types.SendRestrictionFn,
+) error {
+	if config == nil {
+		return nil
+	}
+
+	// Default ordering is lexical by module name.
+	modNames := maps.Keys(restrictions)
+	sort.Strings(modNames)
+
+	for _, modName := range modNames {
+		restriction := restrictions[modName]
+		if restriction == nil {
+			continue
+		}
+
+		keeper.AppendSendRestriction(restriction)
+	}
+
+	return nil
+}
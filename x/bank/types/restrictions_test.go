@@ -0,0 +1,82 @@
+package types_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestSendRestrictionFn_Then(t *testing.T) {
+	addr1 := sdk.AccAddress("addr1_______________")
+	addr2 := sdk.AccAddress("addr2_______________")
+	addr3 := sdk.AccAddress("addr3_______________")
+	coins := sdk.NewCoins(sdk.NewInt64Coin("stake", 10))
+
+	redirectTo := func(addr sdk.AccAddress, calls *[]string) types.SendRestrictionFn {
+		return func(_ context.Context, _, _ sdk.AccAddress, _ sdk.Coins) (sdk.AccAddress, error) {
+			*calls = append(*calls, addr.String())
+			return addr, nil
+		}
+	}
+	errFn := func(calls *[]string) types.SendRestrictionFn {
+		return func(_ context.Context, _, _ sdk.AccAddress, _ sdk.Coins) (sdk.AccAddress, error) {
+			*calls = append(*calls, "error")
+			return nil, errors.New("restricted")
+		}
+	}
+
+	t.Run("nil then nil is nil", func(t *testing.T) {
+		var r types.SendRestrictionFn
+		require.Nil(t, r.Then(nil))
+	})
+
+	t.Run("nil then fn is fn", func(t *testing.T) {
+		var calls []string
+		var r types.SendRestrictionFn
+		toAddr, err := r.Then(redirectTo(addr2, &calls))(context.Background(), addr1, addr1, coins)
+		require.NoError(t, err)
+		require.Equal(t, addr2, toAddr)
+		require.Equal(t, []string{addr2.String()}, calls)
+	})
+
+	t.Run("receiver is passed down the chain", func(t *testing.T) {
+		var calls []string
+		r := redirectTo(addr2, &calls).Then(redirectTo(addr3, &calls))
+		toAddr, err := r(context.Background(), addr1, addr1, coins)
+		require.NoError(t, err)
+		require.Equal(t, addr3, toAddr)
+		require.Equal(t, []string{addr2.String(), addr3.String()}, calls)
+	})
+
+	t.Run("error stops the chain", func(t *testing.T) {
+		var calls []string
+		r := types.ComposeSendRestrictions(redirectTo(addr2, &calls), errFn(&calls), redirectTo(addr3, &calls))
+		_, err := r(context.Background(), addr1, addr1, coins)
+		require.EqualError(t, err, "restricted")
+		require.Equal(t, []string{addr2.String(), "error"}, calls)
+	})
+
+	t.Run("no-op keeps the receiver", func(t *testing.T) {
+		toAddr, err := types.NoOpSendRestrictionFn(context.Background(), addr1, addr2, coins)
+		require.NoError(t, err)
+		require.Equal(t, addr2, toAddr)
+	})
+}
+
+func TestComposeSendRestrictions(t *testing.T) {
+	require.Nil(t, types.ComposeSendRestrictions())
+	require.Nil(t, types.ComposeSendRestrictions(nil, nil))
+
+	r := types.ComposeSendRestrictions(nil, types.NoOpSendRestrictionFn, nil)
+	require.NotNil(t, r)
+
+	addr := sdk.AccAddress("addr1_______________")
+	toAddr, err := r(context.Background(), addr, addr, nil)
+	require.NoError(t, err)
+	require.Equal(t, addr, toAddr)
+}
@@ -0,0 +1,71 @@
+package ante
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/cosmos/cosmos-sdk/x/group"
+)
+
+// CircuitBreaker is an interface that defines the methods for a circuit breaker.
+type CircuitBreaker interface {
+	IsAllowed(ctx context.Context, typeURL string) (bool, error)
+}
+
+// CircuitBreakerDecorator is an AnteDecorator that checks if the transaction type is allowed to enter the mempool or be executed
+type CircuitBreakerDecorator struct {
+	circuitKeeper CircuitBreaker
+}
+
+func NewCircuitBreakerDecorator(ck CircuitBreaker) CircuitBreakerDecorator {
+	return CircuitBreakerDecorator{
+		circuitKeeper: ck,
+	}
+}
+
+// AnteHandle rejects the transaction if any of its messages, or any message
+// nested in an authz MsgExec or a group MsgSubmitProposal, has been disabled
+// through the circuit breaker. It only rejects them early, before they enter
+// the mempool: the circuit breaker set on the MsgServiceRouter with
+// BaseApp.SetCircuitBreaker is what prevents their execution.
+func (cbd CircuitBreakerDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if err := cbd.checkMsgs(ctx, tx.GetMsgs()); err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+func (cbd CircuitBreakerDecorator) checkMsgs(ctx sdk.Context, msgs []sdk.Msg) error {
+	for _, msg := range msgs {
+		isAllowed, err := cbd.circuitKeeper.IsAllowed(ctx, sdk.MsgTypeURL(msg))
+		if err != nil {
+			return err
+		}
+
+		if !isAllowed {
+			return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "tx type not allowed: %s", sdk.MsgTypeURL(msg))
+		}
+
+		var nested []sdk.Msg
+		switch m := msg.(type) {
+		case *authz.MsgExec:
+			nested, err = m.GetMessages()
+		case *group.MsgSubmitProposal:
+			nested, err = m.GetMsgs()
+		}
+		if err != nil {
+			return err
+		}
+
+		if err := cbd.checkMsgs(ctx, nested); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,79 @@
+package ante_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/x/circuit/ante"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/group"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+type mockCircuitBreaker struct {
+	disabled map[string]bool
+}
+
+func (m mockCircuitBreaker) IsAllowed(_ context.Context, typeURL string) (bool, error) {
+	return !m.disabled[typeURL], nil
+}
+
+type mockTx struct {
+	msgs []sdk.Msg
+}
+
+func (tx mockTx) GetMsgs() []sdk.Msg { return tx.msgs }
+
+func (tx mockTx) ValidateBasic() error { return nil }
+
+func TestCircuitBreakerDecorator(t *testing.T) {
+	addr := sdk.AccAddress("addr1_______________")
+	send := &banktypes.MsgSend{FromAddress: addr.String(), ToAddress: addr.String()}
+	delegate := &stakingtypes.MsgDelegate{DelegatorAddress: addr.String()}
+
+	exec := authz.NewMsgExec(addr, []sdk.Msg{send})
+	nestedExec := authz.NewMsgExec(addr, []sdk.Msg{delegate, &exec})
+
+	proposal, err := group.NewMsgSubmitProposal(addr.String(), []string{addr.String()}, []sdk.Msg{send}, "", group.Exec_EXEC_TRY, "title", "summary")
+	require.NoError(t, err)
+
+	decorator := ante.NewCircuitBreakerDecorator(mockCircuitBreaker{
+		disabled: map[string]bool{sdk.MsgTypeURL(send): true},
+	})
+
+	testCases := []struct {
+		name   string
+		msgs   []sdk.Msg
+		expErr bool
+	}{
+		{"allowed msg", []sdk.Msg{delegate}, false},
+		{"disabled msg", []sdk.Msg{delegate, send}, true},
+		{"disabled msg in authz exec", []sdk.Msg{&exec}, true},
+		{"disabled msg in nested authz exec", []sdk.Msg{&nestedExec}, true},
+		{"disabled msg in group proposal", []sdk.Msg{proposal}, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			nextCalled := false
+			next := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+				nextCalled = true
+				return ctx, nil
+			}
+
+			_, err := decorator.AnteHandle(sdk.Context{}, mockTx{msgs: tc.msgs}, false, next)
+			if tc.expErr {
+				require.ErrorContains(t, err, "tx type not allowed")
+				require.False(t, nextCalled)
+			} else {
+				require.NoError(t, err)
+				require.True(t, nextCalled)
+			}
+		})
+	}
+}
@@ -0,0 +1,82 @@
+package circuit
+
+import (
+	"fmt"
+
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+	circuitv1 "cosmossdk.io/api/cosmos/circuit/v1"
+
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"cosmossdk.io/x/circuit/types"
+)
+
+// AutoCLIOptions implements the autocli.HasAutoCLIConfig interface.
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service: circuitv1.Query_ServiceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "Account",
+					Use:       "account [address]",
+					Short:     "Query a specific account's permissions",
+					Example:   fmt.Sprintf(`%s query %s account <address>`, version.AppName, types.ModuleName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "address"},
+					},
+				},
+				{
+					RpcMethod: "Accounts",
+					Use:       "accounts",
+					Short:     "Query all account permissions",
+					Example:   fmt.Sprintf(`%s query %s accounts`, version.AppName, types.ModuleName),
+				},
+				{
+					RpcMethod: "DisabledList",
+					Use:       "disabled-list",
+					Short:     "Query a list of all disabled message types",
+					Example:   fmt.Sprintf(`%s query %s disabled-list`, version.AppName, types.ModuleName),
+				},
+			},
+		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service: circuitv1.Msg_ServiceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "AuthorizeCircuitBreaker",
+					Use:       "authorize [grantee] [permissions_json] --from [granter]",
+					Short:     "Authorize an account to trip the circuit breaker.",
+					Long: `Authorize an account to trip the circuit breaker.
+"LEVEL_NONE_UNSPECIFIED" revokes all permissions,
+"LEVEL_SOME_MSGS" allows tripping the circuit breaker for the type URLs in "limit_type_urls",
+"LEVEL_ALL_MSGS" allows tripping the circuit breaker for any message,
+"LEVEL_SUPER_ADMIN" additionally allows authorizing other accounts.`,
+					Example: fmt.Sprintf(`%s tx %s authorize <address> '{"level":"LEVEL_SOME_MSGS","limit_type_urls":["/cosmos.bank.v1beta1.MsgSend"]}' --from <granter>`, version.AppName, types.ModuleName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "grantee"},
+						{ProtoField: "permissions"},
+					},
+				},
+				{
+					RpcMethod: "TripCircuitBreaker",
+					Use:       "disable [type_url]... --from [authority]",
+					Short:     "Disable a message from being executed",
+					Example:   fmt.Sprintf(`%s tx %s disable /cosmos.bank.v1beta1.MsgSend --from <authority>`, version.AppName, types.ModuleName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "msg_type_urls", Varargs: true},
+					},
+				},
+				{
+					RpcMethod: "ResetCircuitBreaker",
+					Use:       "reset [type_url]... --from [authority]",
+					Short:     "Enable a message to be executed",
+					Example:   fmt.Sprintf(`%s tx %s reset /cosmos.bank.v1beta1.MsgSend --from <authority>`, version.AppName, types.ModuleName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "msg_type_urls", Varargs: true},
+					},
+				},
+			},
+		},
+	}
+}
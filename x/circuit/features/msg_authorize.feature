@@ -29,20 +29,20 @@ Feature: MsgAuthorizeCircuitBreaker
         """
       Then expect an "unauthorized" error
 
-  Rule: limit_msg_types must be used with LEVEL_SOME_MSGS
+  Rule: limit_type_urls must be used with LEVEL_SOME_MSGS
 
-    Example: granting LEVEL_SOME_MSGS with limit_msg_types
+    Example: granting LEVEL_SOME_MSGS with limit_type_urls
       Given "acct1" has permission "LEVEL_ALL_MSGS"
       When "acct1" attempts to grant "acct2" the permissions
         """
         {
-         "level": "LEVEL_SOME_MSGS"
-         "limit_msg_types": "cosmos.bank.v1beta1.MsgSend"
+          "level": "LEVEL_SOME_MSGS",
+          "limit_type_urls": ["/cosmos.bank.v1beta1.MsgSend"]
         }
         """
       Then expect success
 
-    Example: granting LEVEL_SOME_MSGS without limit_msg_types
+    Example: granting LEVEL_SOME_MSGS without limit_type_urls
       Given "acct1" has permission "LEVEL_SUPER_ADMIN"
       When "acct1" attempts to grant "acct2" the permissions
         """
@@ -50,24 +50,24 @@ Feature: MsgAuthorizeCircuitBreaker
         """
       Then expect an "invalid request" error
 
-    Example: granting LEVEL_ALL_MSGS with limit_msg_types
+    Example: granting LEVEL_ALL_MSGS with limit_type_urls
       Given "acct1" has permission "LEVEL_SUPER_ADMIN"
       When "acct1" attempts to grant "acct2" the permissions
         """
         {
           "level": "LEVEL_ALL_MSGS",
-          "limit_msg_types": "cosmos.bank.v1beta1.MsgSend"
+          "limit_type_urls": ["/cosmos.bank.v1beta1.MsgSend"]
         }
         """
       Then expect an "invalid request" error
 
-    Example: attempting to revoke with limit_msg_types
+    Example: attempting to revoke with limit_type_urls
       Given "acct1" has permission "LEVEL_SUPER_ADMIN"
       When "acct1" attempts to revoke "acct2" the permissions
         """
         {
           "level": "LEVEL_NONE_UNSPECIFIED",
-          "limit_msg_types": "cosmos.bank.v1beta1.MsgSend"
+          "limit_type_urls": ["/cosmos.bank.v1beta1.MsgSend"]
         }
         """
       Then expect an "invalid request" error
@@ -79,9 +79,7 @@ Feature: MsgAuthorizeCircuitBreaker
       And "acct2" has permission "LEVEL_ALL_MSGS"
       When "acct1" attempts to revoke "acct2" the permissions
         """
-        {
-          "level": "LEVEL_NONE_UNSPECIFIED",
-        }
+        { "level": "LEVEL_NONE_UNSPECIFIED" }
         """
-      Then expect sucesss
+      Then expect success
       And expect that "acct2" has no permissions
@@ -1,7 +1,7 @@
 Feature: MsgTripCircuitBreaker
-	Circuit breaker can disable message execution:
-	- when the caller trips the circuitbreaker for a message(s)
-	- when the caller has the correct permissions
+  Circuit breaker can disable message execution:
+  - when the caller trips the circuit breaker for a message(s)
+  - when the caller has the correct permissions
 
   Rule: a user must have permission to trip the circuit breaker for a message(s)
 
@@ -9,19 +9,16 @@ Feature: MsgTripCircuitBreaker
       Given "acct1" has permission "LEVEL_SUPER_ADMIN"
       When "acct1" attempts to disable msg execution
         """
-        {
-        	"msg": "cosmos.bank.v1beta1.MsgSend"
-        }
+        { "msg_type_urls": ["/cosmos.bank.v1beta1.MsgSend"] }
         """
       Then expect success
+      And expect that "/cosmos.bank.v1beta1.MsgSend" is disabled
 
     Example: user has no permissions
       Given "acct1" has no permissions
       When "acct1" attempts to disable msg execution
         """
-        {
-        	"msg": "cosmos.bank.v1beta1.MsgSend"
-        }
+        { "msg_type_urls": ["/cosmos.bank.v1beta1.MsgSend"] }
         """
       Then expect an "unauthorized" error
 
@@ -29,48 +26,41 @@ Feature: MsgTripCircuitBreaker
       Given "acct1" has permission "LEVEL_ALL_MSGS"
       When "acct1" attempts to disable msg execution
         """
-        {
-        	"msg": "cosmos.bank.v1beta1.MsgSend"
-        }
+        { "msg_type_urls": ["/cosmos.bank.v1beta1.MsgSend"] }
         """
       Then expect success
 
     Example: user has permission for the messages
-      Given "acct1" has permission to disable "cosmos.bank.v1beta1.MsgSend" and "cosmos.staking.v1beta1.MsgDelegate"
+      Given "acct1" has permission to trip circuit breaker for "/cosmos.bank.v1beta1.MsgSend" and "/cosmos.staking.v1beta1.MsgDelegate"
       When "acct1" attempts to disable msg execution
         """
-        {
-        "msgs": ["cosmos.bank.v1beta1.MsgSend",cosmos.staking.v1beta1.MsgDelegate"]
-        }
+        { "msg_type_urls": ["/cosmos.bank.v1beta1.MsgSend", "/cosmos.staking.v1beta1.MsgDelegate"] }
         """
       Then expect success
 
     Example: user does not have permission for 1 of the messages in the list
-      Given "acct1" has permission to disable "cosmos.bank.v1beta1.MsgSend"
+      Given "acct1" has permission to trip circuit breaker for "/cosmos.bank.v1beta1.MsgSend"
       When "acct1" attempts to disable msg execution
         """
-        {
-        "msgs": ["cosmos.bank.v1beta1.MsgSend","cosmos.staking.v1beta1.MsgCreateValidator"]
-        }
+        { "msg_type_urls": ["/cosmos.bank.v1beta1.MsgSend", "/cosmos.staking.v1beta1.MsgCreateValidator"] }
         """
       Then expect an "unauthorized" error
 
     Example: user does not have permission for the message
-      Given "acct1" has permission to diable "cosmos.bank.v1beta1.MsgSend"
+      Given "acct1" has permission to trip circuit breaker for "/cosmos.bank.v1beta1.MsgSend"
       When "acct1" attempts to disable msg execution
         """
-        {
-        	"msg": "cosmos.bank.v1beta1.MultiSend"
-        }
+        { "msg_type_urls": ["/cosmos.bank.v1beta1.MsgMultiSend"] }
         """
       Then expect an "unauthorized" error
 
+  Rule: a message can only be tripped once
+
     Example: user tries to trip an already tripped circuit breaker
-      Given "acct1" has permission to diable "cosmos.bank.v1beta1.MsgSend" & is already tripped
+      Given "acct1" has permission to trip circuit breaker for "/cosmos.bank.v1beta1.MsgSend"
+      And "/cosmos.bank.v1beta1.MsgSend" has been disabled
       When "acct1" attempts to disable msg execution
         """
-        {
-        	"msg": "cosmos.bank.v1beta1.MultiSend"
-        }
+        { "msg_type_urls": ["/cosmos.bank.v1beta1.MsgSend"] }
         """
       Then expect an "msg disabled" error
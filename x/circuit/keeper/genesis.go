@@ -0,0 +1,68 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/x/circuit/types"
+)
+
+// InitGenesis initializes the circuit module's state from a given genesis state.
+func (k *Keeper) InitGenesis(ctx context.Context, genState *types.GenesisState) {
+	for _, accounts := range genState.AccountPermissions {
+		add, err := k.addressCodec.StringToBytes(accounts.Address)
+		if err != nil {
+			panic(err)
+		}
+
+		// Set the permissions for the account
+		if err := k.Permissions.Set(ctx, add, *accounts.Permissions); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, url := range genState.DisabledTypeUrls {
+		// Set the disabled type urls
+		if err := k.DisableList.Set(ctx, url); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// ExportGenesis exports the current state of the circuit module.
+func (k *Keeper) ExportGenesis(ctx context.Context) *types.GenesisState {
+	var (
+		permissions  []*types.GenesisAccountPermissions
+		disabledMsgs []string
+	)
+
+	err := k.Permissions.Walk(ctx, nil, func(address []byte, perm types.Permissions) bool {
+		add, err := k.addressCodec.BytesToString(address)
+		if err != nil {
+			panic(err)
+		}
+
+		permissions = append(permissions, &types.GenesisAccountPermissions{
+			Address:     add,
+			Permissions: &perm,
+		})
+		return false
+	})
+	if err != nil && !errors.Is(err, collections.ErrInvalidIterator) {
+		panic(err)
+	}
+
+	err = k.DisableList.Walk(ctx, nil, func(msgURL string) bool {
+		disabledMsgs = append(disabledMsgs, msgURL)
+		return false
+	})
+	if err != nil && !errors.Is(err, collections.ErrInvalidIterator) {
+		panic(err)
+	}
+
+	return &types.GenesisState{
+		AccountPermissions: permissions,
+		DisabledTypeUrls:   disabledMsgs,
+	}
+}
@@ -0,0 +1,47 @@
+package keeper
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	"cosmossdk.io/x/circuit/types"
+)
+
+func TestGenesis(t *testing.T) {
+	f := initFixture(t)
+
+	genesis := &types.GenesisState{
+		AccountPermissions: []*types.GenesisAccountPermissions{
+			{
+				Address:     f.addr("acct1").String(),
+				Permissions: &types.Permissions{Level: types.Permissions_LEVEL_SUPER_ADMIN},
+			},
+			{
+				Address: f.addr("acct2").String(),
+				Permissions: &types.Permissions{
+					Level:         types.Permissions_LEVEL_SOME_MSGS,
+					LimitTypeUrls: []string{"/cosmos.bank.v1beta1.MsgSend"},
+				},
+			},
+		},
+		DisabledTypeUrls: []string{"/cosmos.bank.v1beta1.MsgMultiSend"},
+	}
+	assert.NilError(t, genesis.Validate(f.k.addressCodec))
+
+	f.k.InitGenesis(f.ctx, genesis)
+
+	allowed, err := f.k.IsAllowed(f.ctx, "/cosmos.bank.v1beta1.MsgMultiSend")
+	assert.NilError(t, err)
+	assert.Assert(t, !allowed)
+
+	exported := f.k.ExportGenesis(f.ctx)
+	assert.Equal(t, len(genesis.AccountPermissions), len(exported.AccountPermissions))
+	assert.DeepEqual(t, genesis.DisabledTypeUrls, exported.DisabledTypeUrls)
+
+	for _, account := range genesis.AccountPermissions {
+		res, err := NewQueryServer(f.k).Account(f.ctx, &types.QueryAccountRequest{Address: account.Address})
+		assert.NilError(t, err)
+		assert.Equal(t, account.Permissions.String(), res.Permission.String())
+	}
+}
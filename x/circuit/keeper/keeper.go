@@ -0,0 +1,91 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/x/circuit/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+// Keeper defines the circuit module's keeper.
+type Keeper struct {
+	storeService store.KVStoreService
+	authority    []byte
+	addressCodec address.Codec
+
+	Schema collections.Schema
+	// Permissions contains the permissions for each account
+	Permissions collections.Map[[]byte, types.Permissions]
+	// DisableList contains the message URLs that are disabled
+	DisableList collections.KeySet[string]
+}
+
+// NewKeeper constructs a new Circuit Keeper instance
+func NewKeeper(cdc codec.BinaryCodec, storeService store.KVStoreService, authority string, addressCodec address.Codec) Keeper {
+	auth, err := addressCodec.StringToBytes(authority)
+	if err != nil {
+		panic(err)
+	}
+
+	sb := collections.NewSchemaBuilder(storeService)
+
+	k := Keeper{
+		storeService: storeService,
+		authority:    auth,
+		addressCodec: addressCodec,
+		Permissions: collections.NewMap(
+			sb,
+			types.AccountPermissionPrefix,
+			"permissions",
+			collections.BytesKey,
+			codec.CollValue[types.Permissions](cdc),
+		),
+		DisableList: collections.NewKeySet(
+			sb,
+			types.DisableListPrefix,
+			"disable_list",
+			collections.StringKey,
+		),
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.Schema = schema
+
+	return k
+}
+
+// GetAuthority returns the x/circuit module's authority.
+func (k *Keeper) GetAuthority() []byte {
+	return k.authority
+}
+
+// IsAllowed returns true when the msg type URL has not been disabled.
+func (k *Keeper) IsAllowed(ctx context.Context, msgURL string) (bool, error) {
+	has, err := k.DisableList.Has(ctx, msgURL)
+	return !has, err
+}
+
+// GetPermissions returns the permissions of the given account. An account
+// without stored permissions has LEVEL_NONE_UNSPECIFIED. The module authority
+// always has LEVEL_SUPER_ADMIN.
+func (k *Keeper) GetPermissions(ctx context.Context, address []byte) (types.Permissions, error) {
+	if bytes.Equal(address, k.authority) {
+		return types.Permissions{Level: types.Permissions_LEVEL_SUPER_ADMIN}, nil
+	}
+
+	perms, err := k.Permissions.Get(ctx, address)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return types.Permissions{}, err
+	}
+
+	return perms, nil
+}
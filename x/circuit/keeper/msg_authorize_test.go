@@ -5,10 +5,11 @@ import (
 
 	"github.com/regen-network/gocuke"
 	"gotest.tools/v3/assert"
+
+	"cosmossdk.io/x/circuit/types"
 )
 
 func TestAuthorize(t *testing.T) {
-	t.Skip("TODO: uncomment this after implementing")
 	gocuke.NewRunner(t, &authorizeSuite{}).Path("../features/msg_authorize.feature").Run()
 }
 
@@ -16,30 +17,51 @@ type authorizeSuite struct {
 	*baseFixture
 }
 
-func (s *authorizeSuite) Before(t *testing.T) {
+func (s *authorizeSuite) Before(t gocuke.TestingT) {
 	s.baseFixture = initFixture(t)
 }
 
-func (s *authorizeSuite) HasPermission(a, b string) {
-	panic("PENDING")
+func (s *authorizeSuite) AttemptsToGrantThePermissions(a, b string, c gocuke.DocString) {
+	s.authorize(a, b, c)
 }
 
-func (s *authorizeSuite) HasNoPermissions(a string) {
-	panic("PENDING")
+func (s *authorizeSuite) AttemptsToRevokeThePermissions(a, b string, c gocuke.DocString) {
+	s.authorize(a, b, c)
 }
 
-func (s *authorizeSuite) AttemptsToGrantThePermissions(a, b string, c gocuke.DocString) {
-	panic("PENDING")
-}
+func (s *authorizeSuite) authorize(granter, grantee string, perms gocuke.DocString) {
+	var permissions types.Permissions
+	assert.NilError(s.t, s.cdc.UnmarshalJSON([]byte(perms.Content), &permissions))
 
-func (s *authorizeSuite) ExpectSuccess() {
-	assert.NilError(s.t, s.err)
+	_, s.err = s.msgServer.AuthorizeCircuitBreaker(s.ctx, types.NewMsgAuthorizeCircuitBreaker(
+		s.addr(granter).String(), s.addr(grantee).String(), &permissions,
+	))
 }
 
-func (s *authorizeSuite) ExpectAnError(a string) {
-	assert.Error(s.t, s.err, a)
-}
+func TestAuthorizeAllMsgsGranter(t *testing.T) {
+	s := initFixture(t)
+	s.HasPermission("acct1", "LEVEL_ALL_MSGS")
+	someMsgs := &types.Permissions{Level: types.Permissions_LEVEL_SOME_MSGS, LimitTypeUrls: []string{"/cosmos.bank.v1beta1.MsgSend"}}
+
+	// LEVEL_SOME_MSGS can be granted to an account with lower permissions
+	_, err := s.msgServer.AuthorizeCircuitBreaker(s.ctx, types.NewMsgAuthorizeCircuitBreaker(
+		s.addr("acct1").String(), s.addr("acct2").String(), someMsgs,
+	))
+	assert.NilError(t, err)
+
+	// but it does not downgrade an account with LEVEL_ALL_MSGS or above
+	for _, level := range []string{"LEVEL_ALL_MSGS", "LEVEL_SUPER_ADMIN"} {
+		s.HasPermission("acct2", level)
+		_, err = s.msgServer.AuthorizeCircuitBreaker(s.ctx, types.NewMsgAuthorizeCircuitBreaker(
+			s.addr("acct1").String(), s.addr("acct2").String(), someMsgs,
+		))
+		assert.ErrorContains(t, err, "unauthorized")
+	}
 
-func (s *authorizeSuite) ExpectThatHasNoPermissions(a string) {
-	panic("PENDING")
+	// and it cannot revoke permissions
+	s.HasPermission("acct2", "LEVEL_SOME_MSGS")
+	_, err = s.msgServer.AuthorizeCircuitBreaker(s.ctx, types.NewMsgAuthorizeCircuitBreaker(
+		s.addr("acct1").String(), s.addr("acct2").String(), &types.Permissions{},
+	))
+	assert.ErrorContains(t, err, "unauthorized")
 }
@@ -5,10 +5,11 @@ import (
 
 	"github.com/regen-network/gocuke"
 	"gotest.tools/v3/assert"
+
+	"cosmossdk.io/x/circuit/types"
 )
 
 func TestReset(t *testing.T) {
-	t.Skip("TODO: uncomment this after implementing")
 	gocuke.NewRunner(t, &resetSuite{}).Path("../features/msg_reset.feature").Run()
 }
 
@@ -16,30 +17,14 @@ type resetSuite struct {
 	*baseFixture
 }
 
-func (s *resetSuite) Before(t *testing.T) {
+func (s *resetSuite) Before(t gocuke.TestingT) {
 	s.baseFixture = initFixture(t)
 }
 
-func (s *resetSuite) HasPermission(a, b string) {
-	panic("PENDING")
-}
-
-func (s *resetSuite) HasNoPermissions(a string) {
-	panic("PENDING")
-}
-
-func (s *resetSuite) AttemptsToResetCircuit(a, b string, c gocuke.DocString) {
-	panic("PENDING")
-}
-
-func (s *resetSuite) ExpectSuccess() {
-	assert.NilError(s.t, s.err)
-}
-
-func (s *resetSuite) ExpectAnError(a string) {
-	assert.Error(s.t, s.err, a)
-}
+func (s *resetSuite) AttemptsToResetADisabledMessage(a string, b gocuke.DocString) {
+	var msg types.MsgResetCircuitBreaker
+	assert.NilError(s.t, s.cdc.UnmarshalJSON([]byte(b.Content), &msg))
+	msg.Authority = s.addr(a).String()
 
-func (s *resetSuite) ExpectThatHasNoPermissions(a string) {
-	panic("PENDING")
+	_, s.err = s.msgServer.ResetCircuitBreaker(s.ctx, &msg)
 }
@@ -0,0 +1,204 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/circuit/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+var _ types.MsgServer = msgServer{}
+
+// NewMsgServerImpl returns an implementation of the circuit MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+// AuthorizeCircuitBreaker grants (or revokes) the circuit breaker permissions
+// of the grantee. The module authority and the accounts with LEVEL_SUPER_ADMIN
+// can grant any permissions, the accounts with LEVEL_ALL_MSGS can only grant
+// LEVEL_SOME_MSGS to the accounts with lower permissions.
+func (srv msgServer) AuthorizeCircuitBreaker(goCtx context.Context, msg *types.MsgAuthorizeCircuitBreaker) (*types.MsgAuthorizeCircuitBreakerResponse, error) {
+	granter, err := srv.addressCodec.StringToBytes(msg.Granter)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid granter address: %s", err)
+	}
+
+	grantee, err := srv.addressCodec.StringToBytes(msg.Grantee)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid grantee address: %s", err)
+	}
+
+	perms, err := srv.GetPermissions(goCtx, granter)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Permissions == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "permissions cannot be nil")
+	}
+
+	switch perms.Level {
+	case types.Permissions_LEVEL_SUPER_ADMIN:
+	case types.Permissions_LEVEL_ALL_MSGS:
+		if msg.Permissions.Level != types.Permissions_LEVEL_SOME_MSGS {
+			return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "accounts with LEVEL_ALL_MSGS can only grant LEVEL_SOME_MSGS")
+		}
+
+		granteePerms, err := srv.GetPermissions(goCtx, grantee)
+		if err != nil {
+			return nil, err
+		}
+
+		// the permissions of accounts at the granter's level or above are not
+		// downgraded
+		if granteePerms.Level == types.Permissions_LEVEL_ALL_MSGS || granteePerms.Level == types.Permissions_LEVEL_SUPER_ADMIN {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "accounts with LEVEL_ALL_MSGS cannot change the permissions of accounts with %s", granteePerms.Level)
+		}
+	default:
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "only super admins and accounts with LEVEL_ALL_MSGS can authorize circuit breaker permissions")
+	}
+
+	if err := msg.Permissions.Validate(); err != nil {
+		return nil, err
+	}
+
+	if msg.Permissions.Level == types.Permissions_LEVEL_NONE_UNSPECIFIED {
+		err = srv.Permissions.Remove(goCtx, grantee)
+	} else {
+		err = srv.Permissions.Set(goCtx, grantee, *msg.Permissions)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	sdk.UnwrapSDKContext(goCtx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			"authorize_circuit_breaker",
+			sdk.NewAttribute("granter", msg.Granter),
+			sdk.NewAttribute("grantee", msg.Grantee),
+			sdk.NewAttribute("permission", msg.Permissions.String()),
+		),
+	)
+
+	return &types.MsgAuthorizeCircuitBreakerResponse{Success: true}, nil
+}
+
+// TripCircuitBreaker disables the execution of the given Msg type URLs. The
+// authority must have permissions for every type URL in the request.
+func (srv msgServer) TripCircuitBreaker(goCtx context.Context, msg *types.MsgTripCircuitBreaker) (*types.MsgTripCircuitBreakerResponse, error) {
+	authority, err := srv.addressCodec.StringToBytes(msg.Authority)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address: %s", err)
+	}
+
+	if len(msg.MsgTypeUrls) == 0 {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "msg type urls cannot be empty")
+	}
+
+	perms, err := srv.GetPermissions(goCtx, authority)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, msgTypeURL := range msg.MsgTypeUrls {
+		if !perms.CanManage(msgTypeURL) {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "account does not have permission to trip circuit breaker for %s", msgTypeURL)
+		}
+
+		isAllowed, err := srv.IsAllowed(goCtx, msgTypeURL)
+		if err != nil {
+			return nil, err
+		}
+
+		if !isAllowed {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "msg disabled: %s", msgTypeURL)
+		}
+
+		if err := srv.DisableList.Set(goCtx, msgTypeURL); err != nil {
+			return nil, err
+		}
+	}
+
+	sdk.UnwrapSDKContext(goCtx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			"trip_circuit_breaker",
+			sdk.NewAttribute("authority", msg.Authority),
+			sdk.NewAttribute("msg_url", strings.Join(msg.MsgTypeUrls, ",")),
+		),
+	)
+
+	return &types.MsgTripCircuitBreakerResponse{Success: true}, nil
+}
+
+// ResetCircuitBreaker re-enables the execution of the given Msg type URLs. If
+// no type URL is given, every disabled type URL the authority has permissions
+// for is re-enabled.
+func (srv msgServer) ResetCircuitBreaker(goCtx context.Context, msg *types.MsgResetCircuitBreaker) (*types.MsgResetCircuitBreakerResponse, error) {
+	authority, err := srv.addressCodec.StringToBytes(msg.Authority)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address: %s", err)
+	}
+
+	perms, err := srv.GetPermissions(goCtx, authority)
+	if err != nil {
+		return nil, err
+	}
+
+	if perms.Level == types.Permissions_LEVEL_NONE_UNSPECIFIED {
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "account does not have permission to reset circuit breaker")
+	}
+
+	msgTypeURLs := msg.MsgTypeUrls
+	if len(msgTypeURLs) == 0 {
+		err := srv.DisableList.Walk(goCtx, nil, func(msgTypeURL string) bool {
+			if perms.CanManage(msgTypeURL) {
+				msgTypeURLs = append(msgTypeURLs, msgTypeURL)
+			}
+			return false
+		})
+		if err != nil && !errors.Is(err, collections.ErrInvalidIterator) {
+			return nil, err
+		}
+	}
+
+	for _, msgTypeURL := range msgTypeURLs {
+		if !perms.CanManage(msgTypeURL) {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "account does not have permission to reset circuit breaker for %s", msgTypeURL)
+		}
+
+		isAllowed, err := srv.IsAllowed(goCtx, msgTypeURL)
+		if err != nil {
+			return nil, err
+		}
+
+		if isAllowed {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "msg enabled: %s", msgTypeURL)
+		}
+
+		if err := srv.DisableList.Remove(goCtx, msgTypeURL); err != nil {
+			return nil, err
+		}
+	}
+
+	sdk.UnwrapSDKContext(goCtx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			"reset_circuit_breaker",
+			sdk.NewAttribute("authority", msg.Authority),
+			sdk.NewAttribute("msg_url", strings.Join(msgTypeURLs, ",")),
+		),
+	)
+
+	return &types.MsgResetCircuitBreakerResponse{Success: true}, nil
+}
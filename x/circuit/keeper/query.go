@@ -0,0 +1,91 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/x/circuit/types"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ types.QueryServer = QueryServer{}
+
+// QueryServer implements the circuit module's gRPC query service.
+type QueryServer struct {
+	keeper Keeper
+}
+
+// NewQueryServer returns an implementation of the circuit QueryServer interface
+// for the provided Keeper.
+func NewQueryServer(keeper Keeper) types.QueryServer {
+	return &QueryServer{keeper: keeper}
+}
+
+// Account returns the permissions of a single account.
+func (qs QueryServer) Account(ctx context.Context, req *types.QueryAccountRequest) (*types.AccountResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	add, err := qs.keeper.addressCodec.StringToBytes(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	perms, err := qs.keeper.GetPermissions(ctx, add)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.AccountResponse{Permission: &perms}, nil
+}
+
+// Accounts returns the permissions of all accounts with stored permissions.
+func (qs QueryServer) Accounts(ctx context.Context, req *types.QueryAccountsRequest) (*types.AccountsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	results, pageRes, err := query.CollectionPaginate[[]byte, types.Permissions](ctx, qs.keeper.Permissions, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	accounts := make([]*types.GenesisAccountPermissions, len(results))
+	for i, result := range results {
+		addr, err := qs.keeper.addressCodec.BytesToString(result.Key)
+		if err != nil {
+			return nil, err
+		}
+
+		perms := result.Value
+		accounts[i] = &types.GenesisAccountPermissions{
+			Address:     addr,
+			Permissions: &perms,
+		}
+	}
+
+	return &types.AccountsResponse{Accounts: accounts, Pagination: pageRes}, nil
+}
+
+// DisabledList returns the list of disabled Msg type URLs.
+func (qs QueryServer) DisabledList(ctx context.Context, req *types.QueryDisabledListRequest) (*types.DisabledListResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	var msgs []string
+	err := qs.keeper.DisableList.Walk(ctx, nil, func(msgURL string) bool {
+		msgs = append(msgs, msgURL)
+		return false
+	})
+	if err != nil && !errors.Is(err, collections.ErrInvalidIterator) {
+		return nil, err
+	}
+
+	return &types.DisabledListResponse{DisabledList: msgs}, nil
+}
@@ -1,24 +1,108 @@
 package keeper
 
 import (
-	"testing"
+	"context"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/regen-network/gocuke"
+	"gotest.tools/v3/assert"
+
+	"cosmossdk.io/x/circuit/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
 type baseFixture struct {
-	t   *testing.T
+	t   gocuke.TestingT
 	err error
+	ctx context.Context
 
-	// TODO: uncomment these after implementing.
-	// ctx context.Context
-
-	// k        Keeper
-	// addrs    []sdk.AccAddress
-	// storeKey *storetypes.KVStoreKey
-	// sdkCtx   sdk.Context
+	k         Keeper
+	cdc       codec.Codec
+	msgServer types.MsgServer
+	addrs     map[string]sdk.AccAddress
 }
 
-func initFixture(t *testing.T) *baseFixture {
+func initFixture(t gocuke.TestingT) *baseFixture {
 	s := &baseFixture{t: t}
 
+	encCfg := moduletestutil.MakeTestEncodingConfig()
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	s.ctx = testutil.DefaultContext(key, storetypes.NewTransientStoreKey("transient_test"))
+	s.cdc = encCfg.Codec
+	s.k = NewKeeper(encCfg.Codec, runtime.NewKVStoreService(key), authtypes.NewModuleAddress("gov").String(), addresscodec.NewBech32Codec("cosmos"))
+	s.msgServer = NewMsgServerImpl(s.k)
+
+	addrs := simtestutil.CreateIncrementalAccounts(2)
+	s.addrs = map[string]sdk.AccAddress{
+		"acct1": addrs[0],
+		"acct2": addrs[1],
+	}
+
 	return s
 }
+
+func (s *baseFixture) addr(name string) sdk.AccAddress {
+	addr, ok := s.addrs[name]
+	assert.Assert(s.t, ok, "unknown account %s", name)
+	return addr
+}
+
+func (s *baseFixture) setPermissions(name string, perms types.Permissions) {
+	assert.NilError(s.t, s.k.Permissions.Set(s.ctx, s.addr(name), perms))
+}
+
+func (s *baseFixture) HasPermission(a, b string) {
+	level, ok := types.Permissions_Level_value[b]
+	assert.Assert(s.t, ok, "unknown permission level %s", b)
+	s.setPermissions(a, types.Permissions{Level: types.Permissions_Level(level)})
+}
+
+func (s *baseFixture) HasNoPermissions(a string) {
+	assert.NilError(s.t, s.k.Permissions.Remove(s.ctx, s.addr(a)))
+}
+
+func (s *baseFixture) HasPermissionToTripCircuitBreakerFor(a, b string) {
+	s.setPermissions(a, types.Permissions{Level: types.Permissions_LEVEL_SOME_MSGS, LimitTypeUrls: []string{b}})
+}
+
+func (s *baseFixture) HasPermissionToTripCircuitBreakerForAnd(a, b, c string) {
+	s.setPermissions(a, types.Permissions{Level: types.Permissions_LEVEL_SOME_MSGS, LimitTypeUrls: []string{b, c}})
+}
+
+func (s *baseFixture) HasBeenDisabled(a string) {
+	assert.NilError(s.t, s.k.DisableList.Set(s.ctx, a))
+}
+
+func (s *baseFixture) ExpectSuccess() {
+	assert.NilError(s.t, s.err)
+}
+
+func (s *baseFixture) ExpectAnError(a string) {
+	assert.ErrorContains(s.t, s.err, a)
+}
+
+func (s *baseFixture) ExpectThatHasNoPermissions(a string) {
+	has, err := s.k.Permissions.Has(s.ctx, s.addr(a))
+	assert.NilError(s.t, err)
+	assert.Assert(s.t, !has)
+}
+
+func (s *baseFixture) ExpectThatIsDisabled(a string) {
+	allowed, err := s.k.IsAllowed(s.ctx, a)
+	assert.NilError(s.t, err)
+	assert.Assert(s.t, !allowed)
+}
+
+func (s *baseFixture) ExpectThatIsEnabled(a string) {
+	allowed, err := s.k.IsAllowed(s.ctx, a)
+	assert.NilError(s.t, err)
+	assert.Assert(s.t, allowed)
+}
@@ -0,0 +1,46 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/core/address"
+)
+
+// DefaultGenesisState returns the default genesis state of the circuit module.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{}
+}
+
+// Validate performs basic validation of the circuit genesis state.
+func (gs *GenesisState) Validate(ac address.Codec) error {
+	seenAccounts := make(map[string]bool, len(gs.AccountPermissions))
+	for _, account := range gs.AccountPermissions {
+		if _, err := ac.StringToBytes(account.Address); err != nil {
+			return fmt.Errorf("invalid account address %s: %w", account.Address, err)
+		}
+		if seenAccounts[account.Address] {
+			return fmt.Errorf("duplicate permissions for account %s", account.Address)
+		}
+		seenAccounts[account.Address] = true
+
+		if account.Permissions == nil {
+			return fmt.Errorf("missing permissions for account %s", account.Address)
+		}
+		if err := account.Permissions.Validate(); err != nil {
+			return fmt.Errorf("invalid permissions for account %s: %w", account.Address, err)
+		}
+	}
+
+	seenURLs := make(map[string]bool, len(gs.DisabledTypeUrls))
+	for _, url := range gs.DisabledTypeUrls {
+		if url == "" {
+			return fmt.Errorf("disabled type url cannot be empty")
+		}
+		if seenURLs[url] {
+			return fmt.Errorf("duplicate disabled type url %s", url)
+		}
+		seenURLs[url] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,54 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	_ sdk.Msg = &MsgAuthorizeCircuitBreaker{}
+	_ sdk.Msg = &MsgTripCircuitBreaker{}
+	_ sdk.Msg = &MsgResetCircuitBreaker{}
+)
+
+// NewMsgAuthorizeCircuitBreaker creates a new MsgAuthorizeCircuitBreaker instance.
+func NewMsgAuthorizeCircuitBreaker(granter, grantee string, permission *Permissions) *MsgAuthorizeCircuitBreaker {
+	return &MsgAuthorizeCircuitBreaker{
+		Granter:     granter,
+		Grantee:     grantee,
+		Permissions: permission,
+	}
+}
+
+// GetSigners returns the expected signers for a MsgAuthorizeCircuitBreaker.
+func (m MsgAuthorizeCircuitBreaker) GetSigners() []sdk.AccAddress {
+	granter, _ := sdk.AccAddressFromBech32(m.Granter)
+	return []sdk.AccAddress{granter}
+}
+
+// NewMsgTripCircuitBreaker creates a new MsgTripCircuitBreaker instance.
+func NewMsgTripCircuitBreaker(authority string, urls []string) *MsgTripCircuitBreaker {
+	return &MsgTripCircuitBreaker{
+		Authority:   authority,
+		MsgTypeUrls: urls,
+	}
+}
+
+// GetSigners returns the expected signers for a MsgTripCircuitBreaker.
+func (m MsgTripCircuitBreaker) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{authority}
+}
+
+// NewMsgResetCircuitBreaker creates a new MsgResetCircuitBreaker instance.
+func NewMsgResetCircuitBreaker(authority string, urls []string) *MsgResetCircuitBreaker {
+	return &MsgResetCircuitBreaker{
+		Authority:   authority,
+		MsgTypeUrls: urls,
+	}
+}
+
+// GetSigners returns the expected signers for a MsgResetCircuitBreaker.
+func (m MsgResetCircuitBreaker) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{authority}
+}
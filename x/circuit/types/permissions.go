@@ -0,0 +1,43 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Validate checks that the permission level and the list of type URLs are
+// consistent with each other.
+func (p Permissions) Validate() error {
+	switch p.Level {
+	case Permissions_LEVEL_SOME_MSGS:
+		if len(p.LimitTypeUrls) == 0 {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "limit_type_urls must be provided with LEVEL_SOME_MSGS")
+		}
+	case Permissions_LEVEL_NONE_UNSPECIFIED, Permissions_LEVEL_ALL_MSGS, Permissions_LEVEL_SUPER_ADMIN:
+		if len(p.LimitTypeUrls) != 0 {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "limit_type_urls cannot be used with %s", p.Level)
+		}
+	default:
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "unknown permission level %d", p.Level)
+	}
+
+	return nil
+}
+
+// CanManage returns true if the permissions allow tripping and resetting the
+// circuit breaker for the given Msg type URL.
+func (p Permissions) CanManage(msgTypeURL string) bool {
+	switch p.Level {
+	case Permissions_LEVEL_ALL_MSGS, Permissions_LEVEL_SUPER_ADMIN:
+		return true
+	case Permissions_LEVEL_SOME_MSGS:
+		for _, url := range p.LimitTypeUrls {
+			if url == msgTypeURL {
+				return true
+			}
+		}
+	}
+
+	return false
+}
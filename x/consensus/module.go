@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 
 	modulev1 "cosmossdk.io/api/cosmos/consensus/module/v1"
+	"cosmossdk.io/collections"
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/core/event"
 	"cosmossdk.io/depinject"
@@ -131,6 +132,12 @@ func (am AppModule) ExportGenesis(sdk.Context, codec.JSONCodec) json.RawMessage
 	return nil
 }
 
+// CollectionsSchema returns the collections schema of the consensus module
+// store, it is used by the reflection service to decode the module state.
+func (am AppModule) CollectionsSchema() collections.Schema {
+	return am.keeper.Schema
+}
+
 // ConsensusVersion implements AppModule/ConsensusVersion.
 func (AppModule) ConsensusVersion() uint64 { return ConsensusVersion }
 
@@ -0,0 +1,78 @@
+package ante
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/x/feemarket/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
+)
+
+// FeeMarketKeeper defines the feemarket keeper methods used by the fee checker.
+type FeeMarketKeeper interface {
+	GetParams(ctx context.Context) (types.Params, error)
+	GetBaseFee(ctx context.Context) (sdkmath.LegacyDec, error)
+}
+
+// NewDynamicFeeChecker returns a TxFeeChecker to be used by the x/auth
+// DeductFeeDecorator. When the feemarket is enabled, the fee of a transaction
+// must cover the base fee times its gas limit in the fee denom, both in CheckTx
+// and DeliverTx. The validator minimum gas prices are additionally enforced in
+// CheckTx, as done by the default fee checker.
+func NewDynamicFeeChecker(k FeeMarketKeeper) authante.TxFeeChecker {
+	return func(ctx sdk.Context, tx sdk.Tx) (sdk.Coins, int64, error) {
+		feeTx, ok := tx.(sdk.FeeTx)
+		if !ok {
+			return nil, 0, errorsmod.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+		}
+
+		feeCoins := feeTx.GetFee()
+		gas := feeTx.GetGas()
+
+		params, err := k.GetParams(ctx)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		if params.Enabled {
+			baseFee, err := k.GetBaseFee(ctx)
+			if err != nil {
+				return nil, 0, err
+			}
+
+			requiredFee := sdk.NewCoin(params.FeeDenom, baseFee.MulInt64(int64(gas)).Ceil().RoundInt())
+			if feeCoins.AmountOf(params.FeeDenom).LT(requiredFee.Amount) {
+				return nil, 0, errorsmod.Wrapf(sdkerrors.ErrInsufficientFee, "insufficient fees; got: %s required base fee: %s", feeCoins, requiredFee)
+			}
+		}
+
+		// Ensure that the provided fees meet a minimum threshold for the validator,
+		// if this is a CheckTx. This is only for local mempool purposes, and thus
+		// is only ran on check tx.
+		if ctx.IsCheckTx() {
+			minGasPrices := ctx.MinGasPrices()
+			if !minGasPrices.IsZero() {
+				requiredFees := make(sdk.Coins, len(minGasPrices))
+
+				// Determine the required fees by multiplying each required minimum gas
+				// price by the gas limit, where fee = ceil(minGasPrice * gasLimit).
+				glDec := sdkmath.LegacyNewDec(int64(gas))
+				for i, gp := range minGasPrices {
+					fee := gp.Amount.Mul(glDec)
+					requiredFees[i] = sdk.NewCoin(gp.Denom, fee.Ceil().RoundInt())
+				}
+
+				if !feeCoins.IsAnyGTE(requiredFees) {
+					return nil, 0, errorsmod.Wrapf(sdkerrors.ErrInsufficientFee, "insufficient fees; got: %s required: %s", feeCoins, requiredFees)
+				}
+			}
+		}
+
+		priority := authante.GetTxPriority(feeCoins, int64(gas))
+		return feeCoins, priority, nil
+	}
+}
@@ -0,0 +1,92 @@
+package ante_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	"cosmossdk.io/x/feemarket/ante"
+	"cosmossdk.io/x/feemarket/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type mockFeeMarketKeeper struct {
+	params  types.Params
+	baseFee sdkmath.LegacyDec
+}
+
+func (m mockFeeMarketKeeper) GetParams(context.Context) (types.Params, error) {
+	return m.params, nil
+}
+
+func (m mockFeeMarketKeeper) GetBaseFee(context.Context) (sdkmath.LegacyDec, error) {
+	return m.baseFee, nil
+}
+
+type mockFeeTx struct {
+	sdk.FeeTx
+	fee sdk.Coins
+	gas uint64
+}
+
+func (tx mockFeeTx) GetFee() sdk.Coins { return tx.fee }
+
+func (tx mockFeeTx) GetGas() uint64 { return tx.gas }
+
+func TestDynamicFeeChecker(t *testing.T) {
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(key, storetypes.NewTransientStoreKey("transient_test"))
+
+	params := types.NewParams(true, "stake", sdkmath.LegacyNewDec(1), 8, 1000)
+	checker := ante.NewDynamicFeeChecker(mockFeeMarketKeeper{params: params, baseFee: sdkmath.LegacyNewDec(2)})
+
+	disabledParams := params
+	disabledParams.Enabled = false
+	disabledChecker := ante.NewDynamicFeeChecker(mockFeeMarketKeeper{params: disabledParams, baseFee: sdkmath.LegacyNewDec(2)})
+
+	minGasPrices := sdk.NewDecCoins(sdk.NewDecCoin("atom", sdkmath.NewInt(1)))
+
+	testCases := []struct {
+		name        string
+		disabled    bool
+		checkTx     bool
+		fee         sdk.Coins
+		expErr      bool
+		expPriority int64
+	}{
+		{"base fee covered", false, false, sdk.NewCoins(sdk.NewInt64Coin("stake", 200)), false, 2},
+		{"base fee not covered in deliver tx", false, false, sdk.NewCoins(sdk.NewInt64Coin("stake", 199)), true, 0},
+		{"base fee not covered in check tx", false, true, sdk.NewCoins(sdk.NewInt64Coin("stake", 199), sdk.NewInt64Coin("atom", 100)), true, 0},
+		{"base fee paid in another denom", false, false, sdk.NewCoins(sdk.NewInt64Coin("atom", 200)), true, 0},
+		{"base fee and min gas prices covered", false, true, sdk.NewCoins(sdk.NewInt64Coin("stake", 300), sdk.NewInt64Coin("atom", 100)), false, 1},
+		{"min gas prices not covered", false, true, sdk.NewCoins(sdk.NewInt64Coin("stake", 200)), true, 0},
+		{"disabled", true, false, sdk.NewCoins(sdk.NewInt64Coin("stake", 1)), false, 0},
+		{"disabled min gas prices not covered", true, true, sdk.NewCoins(sdk.NewInt64Coin("stake", 1)), true, 0},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			feeChecker := checker
+			if tc.disabled {
+				feeChecker = disabledChecker
+			}
+
+			ctx := ctx.WithIsCheckTx(tc.checkTx).WithMinGasPrices(minGasPrices)
+			fee, priority, err := feeChecker(ctx, mockFeeTx{fee: tc.fee, gas: 100})
+			if tc.expErr {
+				require.ErrorContains(t, err, "insufficient fees")
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.fee, fee)
+			require.Equal(t, tc.expPriority, priority)
+		})
+	}
+}
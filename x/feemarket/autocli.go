@@ -0,0 +1,38 @@
+package feemarket
+
+import (
+	"fmt"
+
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+	feemarketv1 "cosmossdk.io/api/cosmos/feemarket/v1"
+
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"cosmossdk.io/x/feemarket/types"
+)
+
+// AutoCLIOptions implements the autocli.HasAutoCLIConfig interface.
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service: feemarketv1.Query_ServiceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "Params",
+					Use:       "params",
+					Short:     "Query the feemarket module parameters",
+					Example:   fmt.Sprintf(`%s query %s params`, version.AppName, types.ModuleName),
+				},
+				{
+					RpcMethod: "BaseFee",
+					Use:       "base-fee",
+					Short:     "Query the current base fee, per unit of gas",
+					Example:   fmt.Sprintf(`%s query %s base-fee`, version.AppName, types.ModuleName),
+				},
+			},
+		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service: feemarketv1.Msg_ServiceDesc.ServiceName,
+		},
+	}
+}
@@ -0,0 +1,33 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/x/feemarket/types"
+)
+
+// InitGenesis initializes the feemarket module's state from a given genesis state.
+func (k *Keeper) InitGenesis(ctx context.Context, genState *types.GenesisState) {
+	if err := k.Params.Set(ctx, genState.Params); err != nil {
+		panic(err)
+	}
+
+	if err := k.SetBaseFee(ctx, genState.BaseFee); err != nil {
+		panic(err)
+	}
+}
+
+// ExportGenesis exports the current state of the feemarket module.
+func (k *Keeper) ExportGenesis(ctx context.Context) *types.GenesisState {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	baseFee, err := k.GetBaseFee(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	return types.NewGenesisState(params, baseFee)
+}
@@ -0,0 +1,152 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/feemarket/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Keeper defines the feemarket module's keeper.
+type Keeper struct {
+	storeService store.KVStoreService
+	authority    string
+
+	Schema collections.Schema
+	// Params contains the module parameters
+	Params collections.Item[types.Params]
+	// BaseFee contains the current base fee, per unit of gas
+	BaseFee collections.Item[sdk.DecProto]
+}
+
+// NewKeeper constructs a new feemarket Keeper instance
+func NewKeeper(cdc codec.BinaryCodec, storeService store.KVStoreService, authority string) Keeper {
+	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
+		panic(err)
+	}
+
+	sb := collections.NewSchemaBuilder(storeService)
+
+	k := Keeper{
+		storeService: storeService,
+		authority:    authority,
+		Params: collections.NewItem(
+			sb,
+			types.ParamsKey,
+			"params",
+			codec.CollValue[types.Params](cdc),
+		),
+		BaseFee: collections.NewItem(
+			sb,
+			types.BaseFeeKey,
+			"base_fee",
+			codec.CollValue[sdk.DecProto](cdc),
+		),
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.Schema = schema
+
+	return k
+}
+
+// GetAuthority returns the x/feemarket module's authority.
+func (k *Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// GetParams returns the feemarket parameters. The default parameters are
+// returned when none have been set, e.g. when the module genesis is missing.
+func (k *Keeper) GetParams(ctx context.Context) (types.Params, error) {
+	params, err := k.Params.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.DefaultParams(), nil
+	}
+
+	return params, err
+}
+
+// GetBaseFee returns the current base fee, per unit of gas. The min base fee is
+// returned when no base fee has been set.
+func (k *Keeper) GetBaseFee(ctx context.Context) (math.LegacyDec, error) {
+	baseFee, err := k.BaseFee.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		params, err := k.GetParams(ctx)
+		if err != nil {
+			return math.LegacyDec{}, err
+		}
+
+		return params.MinBaseFee, nil
+	}
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	return baseFee.Dec, nil
+}
+
+// SetBaseFee sets the current base fee, per unit of gas.
+func (k *Keeper) SetBaseFee(ctx context.Context, baseFee math.LegacyDec) error {
+	return k.BaseFee.Set(ctx, sdk.DecProto{Dec: baseFee})
+}
+
+// UpdateBaseFee adjusts the base fee from the gas consumed by the last block.
+// The base fee increases when the block consumed more gas than the target and
+// decreases when it consumed less, by at most 1/BaseFeeChangeDenominator of
+// its value. It never goes below the min base fee.
+func (k *Keeper) UpdateBaseFee(ctx context.Context, gasUsed uint64) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	baseFee, err := k.GetBaseFee(ctx)
+	if err != nil {
+		return err
+	}
+
+	return k.SetBaseFee(ctx, NextBaseFee(params, baseFee, gasUsed))
+}
+
+// NextBaseFee computes the base fee following a block that consumed gasUsed,
+// given the current base fee.
+func NextBaseFee(params types.Params, baseFee math.LegacyDec, gasUsed uint64) math.LegacyDec {
+	target := params.TargetBlockGas
+	// the change is bounded by consuming twice the target
+	if gasUsed > 2*target {
+		gasUsed = 2 * target
+	}
+
+	var delta math.LegacyDec
+	if gasUsed >= target {
+		delta = math.LegacyNewDecFromInt(math.NewIntFromUint64(gasUsed - target))
+	} else {
+		delta = math.LegacyNewDecFromInt(math.NewIntFromUint64(target - gasUsed)).Neg()
+	}
+
+	change := baseFee.Mul(delta).
+		QuoInt(math.NewIntFromUint64(target)).
+		QuoInt64(int64(params.BaseFeeChangeDenominator))
+
+	next := baseFee.Add(change)
+	if next.LT(params.MinBaseFee) {
+		return params.MinBaseFee
+	}
+
+	return next
+}
+
+// EndBlocker updates the base fee from the gas consumed by the block.
+func (k *Keeper) EndBlocker(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	return k.UpdateBaseFee(ctx, sdkCtx.BlockGasMeter().GasConsumed())
+}
@@ -0,0 +1,156 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	"cosmossdk.io/x/feemarket/keeper"
+	"cosmossdk.io/x/feemarket/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+var authority = authtypes.NewModuleAddress("gov").String()
+
+func setupKeeper(t *testing.T) (sdk.Context, keeper.Keeper) {
+	t.Helper()
+
+	encCfg := moduletestutil.MakeTestEncodingConfig()
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(key, storetypes.NewTransientStoreKey("transient_test"))
+	k := keeper.NewKeeper(encCfg.Codec, runtime.NewKVStoreService(key), authority)
+
+	params := types.NewParams(true, "stake", math.LegacyNewDec(1), 8, 1000)
+	k.InitGenesis(ctx, types.NewGenesisState(params, math.LegacyNewDec(100)))
+
+	return ctx, k
+}
+
+func TestGenesis(t *testing.T) {
+	ctx, k := setupKeeper(t)
+
+	exported := k.ExportGenesis(ctx)
+	require.NoError(t, exported.Validate())
+	require.Equal(t, math.LegacyNewDec(100), exported.BaseFee)
+	require.True(t, exported.Params.Enabled)
+
+	require.Error(t, types.NewGenesisState(exported.Params, math.LegacyZeroDec()).Validate())
+	require.NoError(t, types.DefaultGenesisState().Validate())
+}
+
+func TestNextBaseFee(t *testing.T) {
+	params := types.NewParams(true, "stake", math.LegacyNewDec(1), 8, 1000)
+
+	testCases := []struct {
+		name    string
+		baseFee math.LegacyDec
+		gasUsed uint64
+		expFee  math.LegacyDec
+	}{
+		{"target gas used", math.LegacyNewDec(100), 1000, math.LegacyNewDec(100)},
+		{"twice the target gas used", math.LegacyNewDec(100), 2000, math.LegacyNewDecWithPrec(1125, 1)},
+		{"more than twice the target gas used", math.LegacyNewDec(100), 10000, math.LegacyNewDecWithPrec(1125, 1)},
+		{"half the target gas used", math.LegacyNewDec(100), 500, math.LegacyNewDecWithPrec(9375, 2)},
+		{"no gas used", math.LegacyNewDec(100), 0, math.LegacyNewDecWithPrec(875, 1)},
+		{"min base fee", math.LegacyNewDec(1), 0, math.LegacyNewDec(1)},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expFee, keeper.NextBaseFee(params, tc.baseFee, tc.gasUsed))
+		})
+	}
+}
+
+func TestEndBlocker(t *testing.T) {
+	ctx, k := setupKeeper(t)
+
+	ctx = ctx.WithBlockGasMeter(storetypes.NewGasMeter(10000))
+	ctx.BlockGasMeter().ConsumeGas(2000, "test")
+	require.NoError(t, k.EndBlocker(ctx))
+
+	baseFee, err := k.GetBaseFee(ctx)
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyNewDecWithPrec(1125, 1), baseFee)
+}
+
+func TestBaseFeeRisesFromDefaultGenesis(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig()
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(key, storetypes.NewTransientStoreKey("transient_test"))
+	k := keeper.NewKeeper(encCfg.Codec, runtime.NewKVStoreService(key), authority)
+
+	genesis := types.DefaultGenesisState()
+	genesis.Params.Enabled = true
+	require.NoError(t, genesis.Validate())
+	k.InitGenesis(ctx, genesis)
+
+	// full blocks raise the base fee from the default min base fee
+	baseFee := types.DefaultMinBaseFee
+	for i := 0; i < 3; i++ {
+		ctx = ctx.WithBlockGasMeter(storetypes.NewInfiniteGasMeter())
+		ctx.BlockGasMeter().ConsumeGas(2*types.DefaultTargetBlockGas, "test")
+		require.NoError(t, k.EndBlocker(ctx))
+
+		next, err := k.GetBaseFee(ctx)
+		require.NoError(t, err)
+		require.True(t, next.GT(baseFee), "base fee %s did not rise from %s", next, baseFee)
+		baseFee = next
+	}
+
+	// a zero min base fee would keep the base fee at zero
+	genesis.Params.MinBaseFee = math.LegacyZeroDec()
+	genesis.BaseFee = math.LegacyZeroDec()
+	require.ErrorContains(t, genesis.Validate(), "min base fee must be positive")
+}
+
+func TestUpdateParams(t *testing.T) {
+	ctx, k := setupKeeper(t)
+	msgServer := keeper.NewMsgServerImpl(k)
+
+	params := types.NewParams(true, "stake", math.LegacyNewDec(200), 4, 5000)
+
+	_, err := msgServer.UpdateParams(ctx, types.NewMsgUpdateParams(sdk.AccAddress("addr1_______________").String(), params))
+	require.ErrorContains(t, err, "invalid authority")
+
+	invalid := params
+	invalid.BaseFeeChangeDenominator = 0
+	_, err = msgServer.UpdateParams(ctx, types.NewMsgUpdateParams(authority, invalid))
+	require.ErrorContains(t, err, "base fee change denominator cannot be 0")
+
+	_, err = msgServer.UpdateParams(ctx, types.NewMsgUpdateParams(authority, params))
+	require.NoError(t, err)
+
+	res, err := keeper.NewQueryServer(k).Params(ctx, &types.QueryParamsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, params, res.Params)
+
+	// the base fee is raised to the new min base fee
+	baseFee, err := keeper.NewQueryServer(k).BaseFee(ctx, &types.QueryBaseFeeRequest{})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDecCoin("stake", math.NewInt(200)), baseFee.BaseFee)
+	require.True(t, baseFee.Enabled)
+}
+
+func TestDefaultState(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig()
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(key, storetypes.NewTransientStoreKey("transient_test"))
+	k := keeper.NewKeeper(encCfg.Codec, runtime.NewKVStoreService(key), authority)
+
+	params, err := k.GetParams(ctx)
+	require.NoError(t, err)
+	require.Equal(t, types.DefaultParams(), params)
+
+	baseFee, err := k.GetBaseFee(ctx)
+	require.NoError(t, err)
+	require.Equal(t, types.DefaultMinBaseFee, baseFee)
+}
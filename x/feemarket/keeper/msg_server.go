@@ -0,0 +1,52 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/feemarket/types"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+var _ types.MsgServer = msgServer{}
+
+// NewMsgServerImpl returns an implementation of the feemarket MsgServer
+// interface for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+// UpdateParams updates the feemarket parameters. Only the module authority can
+// call it. The current base fee is raised to the new min base fee if needed.
+func (srv msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if srv.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", srv.authority, msg.Authority)
+	}
+
+	if err := msg.Params.Validate(); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	if err := srv.Params.Set(goCtx, msg.Params); err != nil {
+		return nil, err
+	}
+
+	baseFee, err := srv.GetBaseFee(goCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	if baseFee.LT(msg.Params.MinBaseFee) {
+		if err := srv.SetBaseFee(goCtx, msg.Params.MinBaseFee); err != nil {
+			return nil, err
+		}
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
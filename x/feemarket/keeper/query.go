@@ -0,0 +1,60 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/x/feemarket/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ types.QueryServer = QueryServer{}
+
+// QueryServer implements the feemarket module's gRPC query service.
+type QueryServer struct {
+	keeper Keeper
+}
+
+// NewQueryServer returns an implementation of the feemarket QueryServer
+// interface for the provided Keeper.
+func NewQueryServer(keeper Keeper) types.QueryServer {
+	return &QueryServer{keeper: keeper}
+}
+
+// Params returns the feemarket parameters.
+func (qs QueryServer) Params(ctx context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	params, err := qs.keeper.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryParamsResponse{Params: params}, nil
+}
+
+// BaseFee returns the current base fee, per unit of gas, in the fee denom.
+func (qs QueryServer) BaseFee(ctx context.Context, req *types.QueryBaseFeeRequest) (*types.QueryBaseFeeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	params, err := qs.keeper.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	baseFee, err := qs.keeper.GetBaseFee(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryBaseFeeResponse{
+		BaseFee: sdk.NewDecCoinFromDec(params.FeeDenom, baseFee),
+		Enabled: params.Enabled,
+	}, nil
+}
@@ -0,0 +1,33 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+)
+
+// NewGenesisState creates a new GenesisState instance.
+func NewGenesisState(params Params, baseFee math.LegacyDec) *GenesisState {
+	return &GenesisState{
+		Params:  params,
+		BaseFee: baseFee,
+	}
+}
+
+// DefaultGenesisState returns the default genesis state of the feemarket module.
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState(DefaultParams(), DefaultMinBaseFee)
+}
+
+// Validate performs basic validation of the feemarket genesis state.
+func (gs *GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	if gs.BaseFee.IsNil() || gs.BaseFee.LT(gs.Params.MinBaseFee) {
+		return fmt.Errorf("base fee %s cannot be lower than the min base fee %s", gs.BaseFee, gs.Params.MinBaseFee)
+	}
+
+	return nil
+}
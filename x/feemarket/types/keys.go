@@ -0,0 +1,17 @@
+package types
+
+import "cosmossdk.io/collections"
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "feemarket"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+)
+
+// KVStore keys
+var (
+	ParamsKey  = collections.NewPrefix(1)
+	BaseFeeKey = collections.NewPrefix(2)
+)
@@ -0,0 +1,64 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// DefaultBaseFeeChangeDenominator bounds the base fee change to 12.5% per block.
+	DefaultBaseFeeChangeDenominator = 8
+	// DefaultTargetBlockGas is the default block gas consumption for which the
+	// base fee stays unchanged.
+	DefaultTargetBlockGas = 15_000_000
+)
+
+// DefaultMinBaseFee is the default lower bound of the base fee, per unit of gas.
+// It must be positive for the base fee, which changes proportionally to its
+// value, to be able to rise.
+var DefaultMinBaseFee = math.LegacyNewDecWithPrec(1, 3)
+
+// NewParams creates a new Params instance.
+func NewParams(enabled bool, feeDenom string, minBaseFee math.LegacyDec, changeDenominator uint32, targetBlockGas uint64) Params {
+	return Params{
+		Enabled:                  enabled,
+		FeeDenom:                 feeDenom,
+		MinBaseFee:               minBaseFee,
+		BaseFeeChangeDenominator: changeDenominator,
+		TargetBlockGas:           targetBlockGas,
+	}
+}
+
+// DefaultParams returns the default feemarket parameters. The base fee is not
+// enforced by default.
+func DefaultParams() Params {
+	return NewParams(false, sdk.DefaultBondDenom, DefaultMinBaseFee, DefaultBaseFeeChangeDenominator, DefaultTargetBlockGas)
+}
+
+// Validate performs basic validation of the feemarket parameters.
+func (p Params) Validate() error {
+	if err := sdk.ValidateDenom(p.FeeDenom); err != nil {
+		return fmt.Errorf("invalid fee denom: %w", err)
+	}
+
+	if p.MinBaseFee.IsNil() || p.MinBaseFee.IsNegative() {
+		return fmt.Errorf("min base fee must be non-negative: %s", p.MinBaseFee)
+	}
+
+	if p.Enabled && !p.MinBaseFee.IsPositive() {
+		return fmt.Errorf("min base fee must be positive when the feemarket is enabled: %s", p.MinBaseFee)
+	}
+
+	if p.BaseFeeChangeDenominator == 0 {
+		return fmt.Errorf("base fee change denominator cannot be 0")
+	}
+
+	if p.TargetBlockGas == 0 {
+		return fmt.Errorf("target block gas cannot be 0")
+	}
+
+	return nil
+}
@@ -13,7 +13,17 @@ import (
 	"github.com/cosmos/cosmos-sdk/version"
 )
 
+// Flags for the nft transactions.
+const (
+	FlagURI     = "uri"
+	FlagURIHash = "uri-hash"
+)
+
 // GetTxCmd returns the transaction commands for this module
+//
+// Deprecated: the nft transaction commands are generated by autocli, see
+// the AutoCLIOptions of the nft module. This command will be removed in a
+// future release.
 func GetTxCmd() *cobra.Command {
 	nftTxCmd := &cobra.Command{
 		Use:                        nft.ModuleName,
@@ -26,12 +36,17 @@ func GetTxCmd() *cobra.Command {
 
 	nftTxCmd.AddCommand(
 		NewCmdSend(),
+		NewCmdMint(),
+		NewCmdBurn(),
+		NewCmdUpdateNFT(),
 	)
 
 	return nftTxCmd
 }
 
 // NewCmdSend creates a CLI command for MsgSend.
+//
+// Deprecated: use the MsgSend command generated by autocli instead.
 func NewCmdSend() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "send [class-id] [nft-id] [receiver] --from [sender]",
@@ -63,3 +78,139 @@ func NewCmdSend() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+// NewCmdMint creates a CLI command for MsgMint.
+//
+// Deprecated: use the MsgMint command generated by autocli instead.
+func NewCmdMint() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mint [class-id] [nft-id] [receiver] --from [minter]",
+		Args:  cobra.ExactArgs(3),
+		Short: "mint a new nft, the minter must be allowed by the mint policy of the class",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s mint <class-id> <nft-id> <receiver> --uri <uri> --uri-hash <uri-hash> --from <minter> --chain-id <chain-id>`, version.AppName, nft.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if args[0] == "" || args[1] == "" || args[2] == "" {
+				return fmt.Errorf("class-id, nft-id and receiver cannot be empty")
+			}
+
+			token, err := nftFromFlags(cmd, args[0], args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := nft.MsgMint{
+				Minter:   clientCtx.GetFromAddress().String(),
+				Nft:      token,
+				Receiver: args[2],
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	cmd.Flags().String(FlagURI, "", "The uri of the nft metadata stored off chain")
+	cmd.Flags().String(FlagURIHash, "", "The hash of the document pointed by uri")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewCmdBurn creates a CLI command for MsgBurn.
+//
+// Deprecated: use the MsgBurn command generated by autocli instead.
+func NewCmdBurn() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "burn [class-id] [nft-id] --from [owner]",
+		Args:  cobra.ExactArgs(2),
+		Short: "burn an nft",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s burn <class-id> <nft-id> --from <owner> --chain-id <chain-id>`, version.AppName, nft.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if args[0] == "" || args[1] == "" {
+				return fmt.Errorf("class-id and nft-id cannot be empty")
+			}
+
+			msg := nft.MsgBurn{
+				Sender:  clientCtx.GetFromAddress().String(),
+				ClassId: args[0],
+				Id:      args[1],
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewCmdUpdateNFT creates a CLI command for MsgUpdateNFT.
+//
+// Deprecated: use the MsgUpdateNFT command generated by autocli instead.
+func NewCmdUpdateNFT() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-nft [class-id] [nft-id] --from [class-owner]",
+		Args:  cobra.ExactArgs(2),
+		Short: "update the uri of an nft, the sender must be the class owner",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s update-nft <class-id> <nft-id> --uri <uri> --uri-hash <uri-hash> --from <class-owner> --chain-id <chain-id>`, version.AppName, nft.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if args[0] == "" || args[1] == "" {
+				return fmt.Errorf("class-id and nft-id cannot be empty")
+			}
+
+			token, err := nftFromFlags(cmd, args[0], args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := nft.MsgUpdateNFT{
+				Sender: clientCtx.GetFromAddress().String(),
+				Nft:    token,
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	cmd.Flags().String(FlagURI, "", "The uri of the nft metadata stored off chain")
+	cmd.Flags().String(FlagURIHash, "", "The hash of the document pointed by uri")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// nftFromFlags returns the nft of the given class and id with the uri and uri
+// hash set from the command flags.
+func nftFromFlags(cmd *cobra.Command, classID, nftID string) (nft.NFT, error) {
+	uri, err := cmd.Flags().GetString(FlagURI)
+	if err != nil {
+		return nft.NFT{}, err
+	}
+
+	uriHash, err := cmd.Flags().GetString(FlagURIHash)
+	if err != nil {
+		return nft.NFT{}, err
+	}
+
+	return nft.NFT{
+		ClassId: classID,
+		Id:      nftID,
+		Uri:     uri,
+		UriHash: uriHash,
+	}, nil
+}
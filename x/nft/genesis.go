@@ -2,6 +2,7 @@ package nft
 
 import (
 	"cosmossdk.io/core/address"
+	"cosmossdk.io/errors"
 )
 
 // ValidateGenesis checks that the given genesis state has no integrity issues
@@ -11,6 +12,18 @@ func ValidateGenesis(data GenesisState, ac address.Codec) error {
 			return ErrEmptyClassID
 		}
 	}
+	classIDs := make(map[string]bool, len(data.Classes))
+	for _, class := range data.Classes {
+		classIDs[class.Id] = true
+	}
+	for _, policy := range data.ClassPolicies {
+		if err := policy.Validate(ac); err != nil {
+			return err
+		}
+		if !classIDs[policy.ClassId] {
+			return errors.Wrap(ErrClassNotExists, policy.ClassId)
+		}
+	}
 	for _, entry := range data.Entries {
 		for _, nft := range entry.Nfts {
 			if len(nft.Id) == 0 {
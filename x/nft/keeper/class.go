@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/errors"
 	storetypes "cosmossdk.io/store/types"
 	"cosmossdk.io/x/nft"
@@ -75,3 +76,40 @@ func (k Keeper) HasClass(ctx context.Context, classID string) bool {
 	}
 	return has
 }
+
+// SetClassPolicy defines a method for setting the policy of an exist nft class
+func (k Keeper) SetClassPolicy(ctx context.Context, policy nft.ClassPolicy) error {
+	if !k.HasClass(ctx, policy.ClassId) {
+		return errors.Wrap(nft.ErrClassNotExists, policy.ClassId)
+	}
+	return k.classPolicies.Set(ctx, policy.ClassId, policy)
+}
+
+// GetClassPolicy defines a method for returning the policy of the specified class.
+// It returns collections.ErrNotFound if the class has no policy.
+func (k Keeper) GetClassPolicy(ctx context.Context, classID string) (nft.ClassPolicy, error) {
+	return k.classPolicies.Get(ctx, classID)
+}
+
+// getClassPolicyOrDefault returns the policy of the specified class. A class saved
+// without policy has no owner and the MINT_POLICY_OWNER_ONLY mint policy, it is
+// only managed by the module authority.
+func (k Keeper) getClassPolicyOrDefault(ctx context.Context, classID string) (nft.ClassPolicy, error) {
+	policy, err := k.GetClassPolicy(ctx, classID)
+	if errors.IsOf(err, collections.ErrNotFound) {
+		return nft.ClassPolicy{ClassId: classID}, nil
+	}
+	return policy, err
+}
+
+// GetClassPolicies defines a method for returning all the class policies
+func (k Keeper) GetClassPolicies(ctx context.Context) (policies []*nft.ClassPolicy) {
+	err := k.classPolicies.Walk(ctx, nil, func(_ string, policy nft.ClassPolicy) bool {
+		policies = append(policies, &policy)
+		return false
+	})
+	if err != nil && !errors.IsOf(err, collections.ErrInvalidIterator) {
+		panic(err)
+	}
+	return
+}
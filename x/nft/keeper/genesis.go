@@ -15,6 +15,11 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *nft.GenesisState) {
 			panic(err)
 		}
 	}
+	for _, policy := range data.ClassPolicies {
+		if err := k.SetClassPolicy(ctx, *policy); err != nil {
+			panic(err)
+		}
+	}
 	for _, entry := range data.Entries {
 		for _, nft := range entry.Nfts {
 			owner, err := k.ac.StringToBytes(entry.Owner)
@@ -59,7 +64,8 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *nft.GenesisState {
 		})
 	}
 	return &nft.GenesisState{
-		Classes: classes,
-		Entries: entries,
+		Classes:       classes,
+		Entries:       entries,
+		ClassPolicies: k.GetClassPolicies(ctx),
 	}
 }
@@ -168,6 +168,28 @@ func (k Keeper) Class(goCtx context.Context, r *nft.QueryClassRequest) (*nft.Que
 	return &nft.QueryClassResponse{Class: &class}, nil
 }
 
+// ClassPolicy return the policy of an NFT class
+func (k Keeper) ClassPolicy(goCtx context.Context, r *nft.QueryClassPolicyRequest) (*nft.QueryClassPolicyResponse, error) {
+	if r == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+
+	if len(r.ClassId) == 0 {
+		return nil, nft.ErrEmptyClassID
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if !k.HasClass(ctx, r.ClassId) {
+		return nil, nft.ErrClassNotExists.Wrapf("not found class: %s", r.ClassId)
+	}
+
+	policy, err := k.getClassPolicyOrDefault(ctx, r.ClassId)
+	if err != nil {
+		return nil, err
+	}
+	return &nft.QueryClassPolicyResponse{Policy: &policy}, nil
+}
+
 // Classes return all NFT classes
 func (k Keeper) Classes(goCtx context.Context, r *nft.QueryClassesRequest) (*nft.QueryClassesResponse, error) {
 	if r == nil {
@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/x/nft"
 )
 
@@ -532,6 +533,32 @@ func (s *TestSuite) TestClass() {
 	}
 }
 
+func (s *TestSuite) TestClassPolicy() {
+	_, err := s.queryClient.ClassPolicy(gocontext.Background(), &nft.QueryClassPolicyRequest{})
+	s.Require().ErrorContains(err, nft.ErrEmptyClassID.Error())
+
+	_, err = s.queryClient.ClassPolicy(gocontext.Background(), &nft.QueryClassPolicyRequest{ClassId: testClassID})
+	s.Require().ErrorContains(err, "not found class")
+
+	// a class saved without policy is only managed by the module authority
+	s.TestSaveClass()
+	res, err := s.queryClient.ClassPolicy(gocontext.Background(), &nft.QueryClassPolicyRequest{ClassId: testClassID})
+	s.Require().NoError(err)
+	s.Require().Equal(&nft.ClassPolicy{ClassId: testClassID}, res.Policy)
+	_, err = s.nftKeeper.GetClassPolicy(s.ctx, testClassID)
+	s.Require().ErrorIs(err, collections.ErrNotFound)
+
+	policy := nft.ClassPolicy{
+		ClassId:    testClassID,
+		Owner:      s.addrs[0].String(),
+		MintPolicy: nft.MintPolicy_MINT_POLICY_EVERYONE,
+	}
+	s.Require().NoError(s.nftKeeper.SetClassPolicy(s.ctx, policy))
+	res, err = s.queryClient.ClassPolicy(gocontext.Background(), &nft.QueryClassPolicyRequest{ClassId: testClassID})
+	s.Require().NoError(err)
+	s.Require().Equal(&policy, res.Policy)
+}
+
 func (s *TestSuite) TestClasses() {
 	var (
 		req     *nft.QueryClassesRequest
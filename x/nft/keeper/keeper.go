@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"cosmossdk.io/collections"
 	"cosmossdk.io/core/address"
 	store "cosmossdk.io/core/store"
 	"cosmossdk.io/x/nft"
@@ -14,21 +15,49 @@ type Keeper struct {
 	storeService store.KVStoreService
 	bk           nft.BankKeeper
 	ac           address.Codec
+
+	// the address capable of executing governance operations, e.g. creating a
+	// class. Typically, this should be the x/gov module account.
+	authority string
+
+	// classPolicies stores the policies of the classes by class id.
+	classPolicies collections.Map[string, nft.ClassPolicy]
 }
 
 // NewKeeper creates a new nft Keeper instance
 func NewKeeper(storeService store.KVStoreService,
-	cdc codec.BinaryCodec, ak nft.AccountKeeper, bk nft.BankKeeper,
+	cdc codec.BinaryCodec, ak nft.AccountKeeper, bk nft.BankKeeper, authority string,
 ) Keeper {
 	// ensure nft module account is set
 	if addr := ak.GetModuleAddress(nft.ModuleName); addr == nil {
 		panic("the nft module account has not been set")
 	}
 
-	return Keeper{
+	sb := collections.NewSchemaBuilder(storeService)
+
+	k := Keeper{
 		cdc:          cdc,
 		storeService: storeService,
 		bk:           bk,
 		ac:           ak,
+		authority:    authority,
+		classPolicies: collections.NewMap(
+			sb,
+			collections.NewPrefix(ClassPolicyKey),
+			"class_policies",
+			collections.StringKey,
+			codec.CollValue[nft.ClassPolicy](cdc),
+		),
+	}
+
+	if _, err := sb.Build(); err != nil {
+		panic(err)
 	}
+
+	return k
+}
+
+// GetAuthority returns the x/nft module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
 }
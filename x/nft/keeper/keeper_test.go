@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"errors"
 	"testing"
 
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
@@ -20,6 +21,8 @@ import (
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 const (
@@ -41,6 +44,8 @@ type TestSuite struct {
 	addrs         []sdk.AccAddress
 	queryClient   nft.QueryClient
 	nftKeeper     keeper.Keeper
+	msgServer     nft.MsgServer
+	authority     string
 	accountKeeper *nfttestutil.MockAccountKeeper
 
 	encCfg moduletestutil.TestEncodingConfig
@@ -64,14 +69,19 @@ func (s *TestSuite) SetupTest() {
 	for _, addr := range s.addrs {
 		accountKeeper.EXPECT().StringToBytes(addr.String()).Return(addr, nil).AnyTimes()
 	}
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName)
+	accountKeeper.EXPECT().StringToBytes(authority.String()).Return(authority, nil).AnyTimes()
+	accountKeeper.EXPECT().StringToBytes("invalid").Return(nil, errors.New("invalid address")).AnyTimes()
 
 	s.accountKeeper = accountKeeper
 
-	nftKeeper := keeper.NewKeeper(storeService, s.encCfg.Codec, accountKeeper, bankKeeper)
+	s.authority = authority.String()
+	nftKeeper := keeper.NewKeeper(storeService, s.encCfg.Codec, accountKeeper, bankKeeper, s.authority)
 	queryHelper := baseapp.NewQueryServerTestHelper(ctx, s.encCfg.InterfaceRegistry)
 	nft.RegisterQueryServer(queryHelper, nftKeeper)
 
 	s.nftKeeper = nftKeeper
+	s.msgServer = keeper.NewMsgServerImpl(nftKeeper)
 	s.queryClient = nft.NewQueryClient(queryHelper)
 	s.ctx = ctx
 }
@@ -346,12 +356,21 @@ func (s *TestSuite) TestExportGenesis() {
 	err = s.nftKeeper.Mint(s.ctx, expNFT, s.addrs[0])
 	s.Require().NoError(err)
 
+	policy := nft.ClassPolicy{
+		ClassId:    testClassID,
+		Owner:      s.addrs[1].String(),
+		MintPolicy: nft.MintPolicy_MINT_POLICY_EVERYONE,
+	}
+	err = s.nftKeeper.SetClassPolicy(s.ctx, policy)
+	s.Require().NoError(err)
+
 	expGenesis := &nft.GenesisState{
 		Classes: []*nft.Class{&class},
 		Entries: []*nft.Entry{{
 			Owner: s.addrs[0].String(),
 			Nfts:  []*nft.NFT{&expNFT},
 		}},
+		ClassPolicies: []*nft.ClassPolicy{&policy},
 	}
 	genesis := s.nftKeeper.ExportGenesis(s.ctx)
 	s.Require().Equal(expGenesis, genesis)
@@ -371,12 +390,18 @@ func (s *TestSuite) TestInitGenesis() {
 		Id:      testID,
 		Uri:     testURI,
 	}
+	expPolicy := nft.ClassPolicy{
+		ClassId:    testClassID,
+		Owner:      s.addrs[1].String(),
+		MintPolicy: nft.MintPolicy_MINT_POLICY_EVERYONE,
+	}
 	expGenesis := &nft.GenesisState{
 		Classes: []*nft.Class{&expClass},
 		Entries: []*nft.Entry{{
 			Owner: s.addrs[0].String(),
 			Nfts:  []*nft.NFT{&expNFT},
 		}},
+		ClassPolicies: []*nft.ClassPolicy{&expPolicy},
 	}
 	s.nftKeeper.InitGenesis(s.ctx, expGenesis)
 
@@ -384,6 +409,10 @@ func (s *TestSuite) TestInitGenesis() {
 	s.Require().True(has)
 	s.Require().EqualValues(expClass, actual)
 
+	actPolicy, err := s.nftKeeper.GetClassPolicy(s.ctx, testClassID)
+	s.Require().NoError(err)
+	s.Require().EqualValues(expPolicy, actPolicy)
+
 	// test GetNFT
 	actNFT, has := s.nftKeeper.GetNFT(s.ctx, testClassID, testID)
 	s.Require().True(has)
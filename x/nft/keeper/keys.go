@@ -15,6 +15,7 @@ var (
 	NFTOfClassByOwnerKey = []byte{0x03}
 	OwnerKey             = []byte{0x04}
 	ClassTotalSupply     = []byte{0x05}
+	ClassPolicyKey       = []byte{0x06}
 
 	Delimiter   = []byte{0x00}
 	Placeholder = []byte{0x01}
@@ -11,10 +11,20 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
-var _ nft.MsgServer = Keeper{}
+type msgServer struct {
+	Keeper
+}
+
+var _ nft.MsgServer = msgServer{}
+
+// NewMsgServerImpl returns an implementation of the nft MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) nft.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
 
 // Send implements Send method of the types.MsgServer.
-func (k Keeper) Send(goCtx context.Context, msg *nft.MsgSend) (*nft.MsgSendResponse, error) {
+func (k msgServer) Send(goCtx context.Context, msg *nft.MsgSend) (*nft.MsgSendResponse, error) {
 	if len(msg.ClassId) == 0 {
 		return nil, nft.ErrEmptyClassID
 	}
@@ -51,3 +61,186 @@ func (k Keeper) Send(goCtx context.Context, msg *nft.MsgSend) (*nft.MsgSendRespo
 	})
 	return &nft.MsgSendResponse{}, nil
 }
+
+// SaveClass implements SaveClass method of the types.MsgServer.
+func (k msgServer) SaveClass(goCtx context.Context, msg *nft.MsgSaveClass) (*nft.MsgSaveClassResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	policy := nft.ClassPolicy{
+		ClassId:    msg.Class.Id,
+		Owner:      msg.Owner,
+		MintPolicy: msg.MintPolicy,
+	}
+	if err := policy.Validate(k.ac); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.Keeper.SaveClass(ctx, msg.Class); err != nil {
+		return nil, err
+	}
+
+	if err := k.SetClassPolicy(ctx, policy); err != nil {
+		return nil, err
+	}
+
+	return &nft.MsgSaveClassResponse{}, nil
+}
+
+// UpdateClass implements UpdateClass method of the types.MsgServer.
+func (k msgServer) UpdateClass(goCtx context.Context, msg *nft.MsgUpdateClass) (*nft.MsgUpdateClassResponse, error) {
+	if _, err := k.ac.StringToBytes(msg.Sender); err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", msg.Sender)
+	}
+
+	// the stored policy is kept unless the msg sets a new one
+	var policy *nft.ClassPolicy
+	if msg.Policy != nil {
+		if len(msg.Policy.ClassId) != 0 && msg.Policy.ClassId != msg.Class.Id {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "policy class id %s does not match class id %s", msg.Policy.ClassId, msg.Class.Id)
+		}
+
+		policy = &nft.ClassPolicy{
+			ClassId:    msg.Class.Id,
+			Owner:      msg.Policy.Owner,
+			MintPolicy: msg.Policy.MintPolicy,
+		}
+		if err := policy.Validate(k.ac); err != nil {
+			return nil, err
+		}
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.assertClassOwner(ctx, msg.Class.Id, msg.Sender); err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.UpdateClass(ctx, msg.Class); err != nil {
+		return nil, err
+	}
+
+	if policy != nil {
+		if err := k.SetClassPolicy(ctx, *policy); err != nil {
+			return nil, err
+		}
+	}
+
+	return &nft.MsgUpdateClassResponse{}, nil
+}
+
+// Mint implements Mint method of the types.MsgServer.
+func (k msgServer) Mint(goCtx context.Context, msg *nft.MsgMint) (*nft.MsgMintResponse, error) {
+	if len(msg.Nft.ClassId) == 0 {
+		return nil, nft.ErrEmptyClassID
+	}
+
+	if len(msg.Nft.Id) == 0 {
+		return nil, nft.ErrEmptyNFTID
+	}
+
+	if _, err := k.ac.StringToBytes(msg.Minter); err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid minter address (%s)", msg.Minter)
+	}
+
+	receiver, err := k.ac.StringToBytes(msg.Receiver)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid receiver address (%s)", msg.Receiver)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	policy, err := k.getClassPolicyOrDefault(ctx, msg.Nft.ClassId)
+	if err != nil {
+		return nil, err
+	}
+
+	if policy.MintPolicy != nft.MintPolicy_MINT_POLICY_EVERYONE {
+		if err := k.assertClassOwner(ctx, msg.Nft.ClassId, msg.Minter); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := k.Keeper.Mint(ctx, msg.Nft, receiver); err != nil {
+		return nil, err
+	}
+
+	return &nft.MsgMintResponse{}, nil
+}
+
+// Burn implements Burn method of the types.MsgServer.
+func (k msgServer) Burn(goCtx context.Context, msg *nft.MsgBurn) (*nft.MsgBurnResponse, error) {
+	if len(msg.ClassId) == 0 {
+		return nil, nft.ErrEmptyClassID
+	}
+
+	if len(msg.Id) == 0 {
+		return nil, nft.ErrEmptyNFTID
+	}
+
+	sender, err := k.ac.StringToBytes(msg.Sender)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", msg.Sender)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	owner := k.GetOwner(ctx, msg.ClassId, msg.Id)
+	if !bytes.Equal(owner, sender) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the owner of nft %s", msg.Sender, msg.Id)
+	}
+
+	if err := k.Keeper.Burn(ctx, msg.ClassId, msg.Id); err != nil {
+		return nil, err
+	}
+
+	return &nft.MsgBurnResponse{}, nil
+}
+
+// UpdateNFT implements UpdateNFT method of the types.MsgServer.
+func (k msgServer) UpdateNFT(goCtx context.Context, msg *nft.MsgUpdateNFT) (*nft.MsgUpdateNFTResponse, error) {
+	if len(msg.Nft.ClassId) == 0 {
+		return nil, nft.ErrEmptyClassID
+	}
+
+	if len(msg.Nft.Id) == 0 {
+		return nil, nft.ErrEmptyNFTID
+	}
+
+	if _, err := k.ac.StringToBytes(msg.Sender); err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", msg.Sender)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.assertClassOwner(ctx, msg.Nft.ClassId, msg.Sender); err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.Update(ctx, msg.Nft); err != nil {
+		return nil, err
+	}
+
+	return &nft.MsgUpdateNFTResponse{}, nil
+}
+
+// assertClassOwner returns an error if the sender is neither the owner of the
+// class nor the module authority.
+func (k msgServer) assertClassOwner(ctx sdk.Context, classID, sender string) error {
+	if !k.HasClass(ctx, classID) {
+		return errorsmod.Wrap(nft.ErrClassNotExists, classID)
+	}
+
+	if sender == k.authority {
+		return nil
+	}
+
+	policy, err := k.getClassPolicyOrDefault(ctx, classID)
+	if err != nil {
+		return err
+	}
+
+	if len(policy.Owner) == 0 || policy.Owner != sender {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the owner of class %s", sender, classID)
+	}
+
+	return nil
+}
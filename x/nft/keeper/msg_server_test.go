@@ -120,7 +120,7 @@ func (s *TestSuite) TestSend() {
 
 	for _, tc := range testCases {
 		s.Run(tc.name, func() {
-			_, err := s.nftKeeper.Send(s.ctx, tc.req)
+			_, err := s.msgServer.Send(s.ctx, tc.req)
 			if tc.expErr {
 				s.Require().Error(err)
 				s.Require().Contains(err.Error(), tc.errMsg)
@@ -130,3 +130,445 @@ func (s *TestSuite) TestSend() {
 		})
 	}
 }
+
+func (s *TestSuite) TestSaveClassMsg() {
+	testCases := []struct {
+		name   string
+		req    *nft.MsgSaveClass
+		expErr bool
+		errMsg string
+	}{
+		{
+			name: "invalid authority",
+			req: &nft.MsgSaveClass{
+				Authority: s.addrs[0].String(),
+				Class:     ExpClass,
+			},
+			expErr: true,
+			errMsg: "invalid authority",
+		},
+		{
+			name: "empty class id",
+			req: &nft.MsgSaveClass{
+				Authority: s.authority,
+				Class:     nft.Class{Name: testClassName},
+			},
+			expErr: true,
+			errMsg: "empty class id",
+		},
+		{
+			name: "invalid owner",
+			req: &nft.MsgSaveClass{
+				Authority: s.authority,
+				Class:     ExpClass,
+				Owner:     "invalid",
+			},
+			expErr: true,
+			errMsg: "Invalid owner address",
+		},
+		{
+			name: "invalid mint policy",
+			req: &nft.MsgSaveClass{
+				Authority:  s.authority,
+				Class:      ExpClass,
+				MintPolicy: nft.MintPolicy(100),
+			},
+			expErr: true,
+			errMsg: "unknown mint policy",
+		},
+		{
+			name: "valid transaction",
+			req: &nft.MsgSaveClass{
+				Authority:  s.authority,
+				Class:      ExpClass,
+				Owner:      s.addrs[0].String(),
+				MintPolicy: nft.MintPolicy_MINT_POLICY_EVERYONE,
+			},
+			expErr: false,
+			errMsg: "",
+		},
+		{
+			name: "class exists",
+			req: &nft.MsgSaveClass{
+				Authority: s.authority,
+				Class:     ExpClass,
+			},
+			expErr: true,
+			errMsg: "nft class already exists",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			_, err := s.msgServer.SaveClass(s.ctx, tc.req)
+			if tc.expErr {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.errMsg)
+			} else {
+				s.Require().NoError(err)
+			}
+		})
+	}
+
+	actual, has := s.nftKeeper.GetClass(s.ctx, testClassID)
+	s.Require().True(has)
+	s.Require().EqualValues(ExpClass, actual)
+
+	policy, err := s.nftKeeper.GetClassPolicy(s.ctx, testClassID)
+	s.Require().NoError(err)
+	s.Require().Equal(nft.ClassPolicy{
+		ClassId:    testClassID,
+		Owner:      s.addrs[0].String(),
+		MintPolicy: nft.MintPolicy_MINT_POLICY_EVERYONE,
+	}, policy)
+}
+
+func (s *TestSuite) TestUpdateClassMsg() {
+	_, err := s.msgServer.SaveClass(s.ctx, &nft.MsgSaveClass{
+		Authority: s.authority,
+		Class:     ExpClass,
+		Owner:     s.addrs[0].String(),
+	})
+	s.Require().NoError(err)
+
+	updatedClass := ExpClass
+	updatedClass.Description = "updated description"
+
+	testCases := []struct {
+		name   string
+		req    *nft.MsgUpdateClass
+		expErr bool
+		errMsg string
+	}{
+		{
+			name: "class does not exist",
+			req: &nft.MsgUpdateClass{
+				Sender: s.addrs[0].String(),
+				Class:  nft.Class{Id: "kitty2"},
+			},
+			expErr: true,
+			errMsg: "nft class does not exist",
+		},
+		{
+			name: "unauthorized sender",
+			req: &nft.MsgUpdateClass{
+				Sender: s.addrs[1].String(),
+				Class:  updatedClass,
+			},
+			expErr: true,
+			errMsg: fmt.Sprintf("%s is not the owner of class %s", s.addrs[1].String(), testClassID),
+		},
+		{
+			name: "policy of another class",
+			req: &nft.MsgUpdateClass{
+				Sender: s.addrs[0].String(),
+				Class:  updatedClass,
+				Policy: &nft.ClassPolicy{ClassId: "kitty2", Owner: s.addrs[1].String()},
+			},
+			expErr: true,
+			errMsg: fmt.Sprintf("policy class id kitty2 does not match class id %s", testClassID),
+		},
+		{
+			name: "valid transaction from the class owner",
+			req: &nft.MsgUpdateClass{
+				Sender: s.addrs[0].String(),
+				Class:  updatedClass,
+				Policy: &nft.ClassPolicy{Owner: s.addrs[1].String()},
+			},
+			expErr: false,
+			errMsg: "",
+		},
+		{
+			name: "previous class owner",
+			req: &nft.MsgUpdateClass{
+				Sender: s.addrs[0].String(),
+				Class:  updatedClass,
+			},
+			expErr: true,
+			errMsg: fmt.Sprintf("%s is not the owner of class %s", s.addrs[0].String(), testClassID),
+		},
+		{
+			name: "valid transaction from the authority",
+			req: &nft.MsgUpdateClass{
+				Sender: s.authority,
+				Class:  updatedClass,
+				Policy: &nft.ClassPolicy{
+					ClassId:    testClassID,
+					Owner:      s.addrs[1].String(),
+					MintPolicy: nft.MintPolicy_MINT_POLICY_EVERYONE,
+				},
+			},
+			expErr: false,
+			errMsg: "",
+		},
+		{
+			name: "valid transaction keeping the policy",
+			req: &nft.MsgUpdateClass{
+				Sender: s.addrs[1].String(),
+				Class:  updatedClass,
+			},
+			expErr: false,
+			errMsg: "",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			_, err := s.msgServer.UpdateClass(s.ctx, tc.req)
+			if tc.expErr {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.errMsg)
+			} else {
+				s.Require().NoError(err)
+			}
+		})
+	}
+
+	actual, has := s.nftKeeper.GetClass(s.ctx, testClassID)
+	s.Require().True(has)
+	s.Require().EqualValues(updatedClass, actual)
+
+	policy, err := s.nftKeeper.GetClassPolicy(s.ctx, testClassID)
+	s.Require().NoError(err)
+	s.Require().Equal(nft.ClassPolicy{
+		ClassId:    testClassID,
+		Owner:      s.addrs[1].String(),
+		MintPolicy: nft.MintPolicy_MINT_POLICY_EVERYONE,
+	}, policy)
+}
+
+func (s *TestSuite) TestMintMsg() {
+	_, err := s.msgServer.SaveClass(s.ctx, &nft.MsgSaveClass{
+		Authority: s.authority,
+		Class:     ExpClass,
+		Owner:     s.addrs[0].String(),
+	})
+	s.Require().NoError(err)
+
+	testCases := []struct {
+		name       string
+		mintPolicy nft.MintPolicy
+		req        *nft.MsgMint
+		expErr     bool
+		errMsg     string
+	}{
+		{
+			name: "empty nft id",
+			req: &nft.MsgMint{
+				Minter:   s.addrs[0].String(),
+				Nft:      nft.NFT{ClassId: testClassID},
+				Receiver: s.addrs[1].String(),
+			},
+			expErr: true,
+			errMsg: "empty nft id",
+		},
+		{
+			name: "invalid receiver",
+			req: &nft.MsgMint{
+				Minter:   s.addrs[0].String(),
+				Nft:      ExpNFT,
+				Receiver: "invalid",
+			},
+			expErr: true,
+			errMsg: "Invalid receiver address",
+		},
+		{
+			name: "class does not exist",
+			req: &nft.MsgMint{
+				Minter:   s.addrs[0].String(),
+				Nft:      nft.NFT{ClassId: "kitty2", Id: testID},
+				Receiver: s.addrs[1].String(),
+			},
+			expErr: true,
+			errMsg: "nft class does not exist",
+		},
+		{
+			name: "minter is not the class owner",
+			req: &nft.MsgMint{
+				Minter:   s.addrs[1].String(),
+				Nft:      ExpNFT,
+				Receiver: s.addrs[1].String(),
+			},
+			expErr: true,
+			errMsg: fmt.Sprintf("%s is not the owner of class %s", s.addrs[1].String(), testClassID),
+		},
+		{
+			name: "valid transaction from the class owner",
+			req: &nft.MsgMint{
+				Minter:   s.addrs[0].String(),
+				Nft:      ExpNFT,
+				Receiver: s.addrs[1].String(),
+			},
+			expErr: false,
+			errMsg: "",
+		},
+		{
+			name: "nft exists",
+			req: &nft.MsgMint{
+				Minter:   s.addrs[0].String(),
+				Nft:      ExpNFT,
+				Receiver: s.addrs[1].String(),
+			},
+			expErr: true,
+			errMsg: "nft already exists",
+		},
+		{
+			name:       "valid transaction with the everyone mint policy",
+			mintPolicy: nft.MintPolicy_MINT_POLICY_EVERYONE,
+			req: &nft.MsgMint{
+				Minter:   s.addrs[2].String(),
+				Nft:      nft.NFT{ClassId: testClassID, Id: "kitty2"},
+				Receiver: s.addrs[2].String(),
+			},
+			expErr: false,
+			errMsg: "",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.Require().NoError(s.nftKeeper.SetClassPolicy(s.ctx, nft.ClassPolicy{
+				ClassId:    testClassID,
+				Owner:      s.addrs[0].String(),
+				MintPolicy: tc.mintPolicy,
+			}))
+
+			_, err := s.msgServer.Mint(s.ctx, tc.req)
+			if tc.expErr {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.errMsg)
+			} else {
+				s.Require().NoError(err)
+				s.Require().Equal(tc.req.Receiver, s.nftKeeper.GetOwner(s.ctx, tc.req.Nft.ClassId, tc.req.Nft.Id).String())
+			}
+		})
+	}
+
+	s.Require().EqualValues(2, s.nftKeeper.GetTotalSupply(s.ctx, testClassID))
+}
+
+func (s *TestSuite) TestBurnMsg() {
+	err := s.nftKeeper.SaveClass(s.ctx, ExpClass)
+	s.Require().NoError(err)
+
+	err = s.nftKeeper.Mint(s.ctx, ExpNFT, s.addrs[0])
+	s.Require().NoError(err)
+
+	testCases := []struct {
+		name   string
+		req    *nft.MsgBurn
+		expErr bool
+		errMsg string
+	}{
+		{
+			name: "empty class id",
+			req: &nft.MsgBurn{
+				Sender: s.addrs[0].String(),
+				Id:     testID,
+			},
+			expErr: true,
+			errMsg: "empty class id",
+		},
+		{
+			name: "unauthorized sender",
+			req: &nft.MsgBurn{
+				Sender:  s.addrs[1].String(),
+				ClassId: testClassID,
+				Id:      testID,
+			},
+			expErr: true,
+			errMsg: fmt.Sprintf("%s is not the owner of nft %s", s.addrs[1].String(), testID),
+		},
+		{
+			name: "valid transaction",
+			req: &nft.MsgBurn{
+				Sender:  s.addrs[0].String(),
+				ClassId: testClassID,
+				Id:      testID,
+			},
+			expErr: false,
+			errMsg: "",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			_, err := s.msgServer.Burn(s.ctx, tc.req)
+			if tc.expErr {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.errMsg)
+			} else {
+				s.Require().NoError(err)
+			}
+		})
+	}
+
+	s.Require().False(s.nftKeeper.HasNFT(s.ctx, testClassID, testID))
+	s.Require().EqualValues(0, s.nftKeeper.GetTotalSupply(s.ctx, testClassID))
+}
+
+func (s *TestSuite) TestUpdateNFTMsg() {
+	_, err := s.msgServer.SaveClass(s.ctx, &nft.MsgSaveClass{
+		Authority: s.authority,
+		Class:     ExpClass,
+		Owner:     s.addrs[0].String(),
+	})
+	s.Require().NoError(err)
+
+	err = s.nftKeeper.Mint(s.ctx, ExpNFT, s.addrs[1])
+	s.Require().NoError(err)
+
+	updatedNFT := ExpNFT
+	updatedNFT.Uri = "updated uri"
+
+	testCases := []struct {
+		name   string
+		req    *nft.MsgUpdateNFT
+		expErr bool
+		errMsg string
+	}{
+		{
+			name: "nft owner is not the class owner",
+			req: &nft.MsgUpdateNFT{
+				Sender: s.addrs[1].String(),
+				Nft:    updatedNFT,
+			},
+			expErr: true,
+			errMsg: fmt.Sprintf("%s is not the owner of class %s", s.addrs[1].String(), testClassID),
+		},
+		{
+			name: "nft does not exist",
+			req: &nft.MsgUpdateNFT{
+				Sender: s.addrs[0].String(),
+				Nft:    nft.NFT{ClassId: testClassID, Id: "kitty2"},
+			},
+			expErr: true,
+			errMsg: "nft does not exist",
+		},
+		{
+			name: "valid transaction",
+			req: &nft.MsgUpdateNFT{
+				Sender: s.addrs[0].String(),
+				Nft:    updatedNFT,
+			},
+			expErr: false,
+			errMsg: "",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			_, err := s.msgServer.UpdateNFT(s.ctx, tc.req)
+			if tc.expErr {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.errMsg)
+			} else {
+				s.Require().NoError(err)
+			}
+		})
+	}
+
+	actual, has := s.nftKeeper.GetNFT(s.ctx, testClassID, testID)
+	s.Require().True(has)
+	s.Require().EqualValues(updatedNFT, actual)
+}
@@ -82,6 +82,66 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
 			Service: nftv1beta1.Msg_ServiceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "Send",
+					Use:       "send [class-id] [nft-id] [receiver] --from [sender]",
+					Short:     "Transfer ownership of an NFT.",
+					Example:   fmt.Sprintf(`%s tx %s send <class-id> <nft-id> <receiver> --from <sender>`, version.AppName, nft.ModuleName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "class_id"},
+						{ProtoField: "id"},
+						{ProtoField: "receiver"},
+					},
+				},
+				{
+					RpcMethod: "SaveClass",
+					Use:       "save-class [class_json] --from [authority]",
+					Short:     "Create or replace an NFT class, the sender must be the module authority.",
+					Example:   fmt.Sprintf(`%s tx %s save-class '{"id":"kitty","name":"Crypto Kitty","symbol":"KITTY"}' --owner <owner> --mint-policy everyone --from <authority>`, version.AppName, nft.ModuleName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "class"},
+					},
+				},
+				{
+					RpcMethod: "UpdateClass",
+					Use:       "update-class [class_json] --from [class-owner]",
+					Short:     "Update an NFT class, the sender must be the class owner or the module authority.",
+					Example:   fmt.Sprintf(`%s tx %s update-class '{"id":"kitty","name":"Crypto Kitty","uri":"<uri>"}' --policy '{"owner":"<owner>","mint_policy":"MINT_POLICY_EVERYONE"}' --from <class-owner>`, version.AppName, nft.ModuleName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "class"},
+					},
+				},
+				{
+					RpcMethod: "Mint",
+					Use:       "mint [nft_json] [receiver] --from [minter]",
+					Short:     "Mint a new NFT, the minter must be allowed by the mint policy of the class.",
+					Example:   fmt.Sprintf(`%s tx %s mint '{"class_id":"kitty","id":"kitty1","uri":"<uri>"}' <receiver> --from <minter>`, version.AppName, nft.ModuleName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "nft"},
+						{ProtoField: "receiver"},
+					},
+				},
+				{
+					RpcMethod: "Burn",
+					Use:       "burn [class-id] [nft-id] --from [owner]",
+					Short:     "Burn an NFT.",
+					Example:   fmt.Sprintf(`%s tx %s burn <class-id> <nft-id> --from <owner>`, version.AppName, nft.ModuleName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "class_id"},
+						{ProtoField: "id"},
+					},
+				},
+				{
+					RpcMethod: "UpdateNFT",
+					Use:       "update-nft [nft_json] --from [class-owner]",
+					Short:     "Update the uri of an NFT, the sender must be the class owner or the module authority.",
+					Example:   fmt.Sprintf(`%s tx %s update-nft '{"class_id":"kitty","id":"kitty1","uri":"<uri>"}' --from <class-owner>`, version.AppName, nft.ModuleName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "nft"},
+					},
+				},
+			},
 		},
 	}
 }
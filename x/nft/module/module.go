@@ -21,11 +21,12 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	modulev1 "cosmossdk.io/api/cosmos/nft/module/v1"
 
 	"cosmossdk.io/x/nft"
-	"cosmossdk.io/x/nft/client/cli"
 	"cosmossdk.io/x/nft/keeper"
 	"cosmossdk.io/x/nft/simulation"
 )
@@ -50,7 +51,7 @@ func (AppModuleBasic) Name() string {
 // RegisterServices registers a gRPC query service to respond to the
 // module-specific gRPC queries.
 func (am AppModule) RegisterServices(registrar grpc.ServiceRegistrar) error {
-	nft.RegisterMsgServer(registrar, am.keeper)
+	nft.RegisterMsgServer(registrar, keeper.NewMsgServerImpl(am.keeper))
 	nft.RegisterQueryServer(registrar, am.keeper)
 	return nil
 }
@@ -92,9 +93,10 @@ func (ab AppModuleBasic) GetQueryCmd() *cobra.Command {
 	return nil
 }
 
-// GetTxCmd returns the transaction commands for the nft module
+// GetTxCmd returns a no-op command for the nft module.
+// Transactions for NFT are registered by autocli.
 func (AppModuleBasic) GetTxCmd() *cobra.Command {
-	return cli.GetTxCmd()
+	return nil
 }
 
 // AppModule implements the sdk.AppModule interface
@@ -162,6 +164,11 @@ func (AppModule) GenerateGenesisState(simState *module.SimulationState) {
 	simulation.RandomizedGenState(simState)
 }
 
+// ProposalMsgs returns msgs used for governance proposals for simulations.
+func (AppModule) ProposalMsgs(simState module.SimulationState) []simtypes.WeightedProposalMsg {
+	return simulation.ProposalMsgs()
+}
+
 // RegisterStoreDecoder registers a decoder for nft module's types
 func (am AppModule) RegisterStoreDecoder(sdr simtypes.StoreDecoderRegistry) {
 	sdr[keeper.StoreKey] = simulation.NewDecodeStore(am.cdc)
@@ -189,6 +196,7 @@ func init() {
 type NftInputs struct {
 	depinject.In
 
+	Config       *modulev1.Module
 	StoreService store.KVStoreService
 	Cdc          codec.Codec
 	Registry     cdctypes.InterfaceRegistry
@@ -205,7 +213,13 @@ type NftOutputs struct {
 }
 
 func ProvideModule(in NftInputs) NftOutputs {
-	k := keeper.NewKeeper(in.StoreService, in.Cdc, in.AccountKeeper, in.BankKeeper)
+	// default to governance authority if not provided
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName)
+	if in.Config.Authority != "" {
+		authority = authtypes.NewModuleAddressOrBech32Address(in.Config.Authority)
+	}
+
+	k := keeper.NewKeeper(in.StoreService, in.Cdc, in.AccountKeeper, in.BankKeeper, authority.String())
 	m := NewAppModule(in.Cdc, k, in.AccountKeeper, in.BankKeeper, in.Registry)
 
 	return NftOutputs{NFTKeeper: k, Module: m}
@@ -9,10 +9,47 @@ const (
 	TypeMsgSend = "send"
 )
 
-var _ sdk.Msg = &MsgSend{}
+var (
+	_ sdk.Msg = &MsgSend{}
+	_ sdk.Msg = &MsgSaveClass{}
+	_ sdk.Msg = &MsgUpdateClass{}
+	_ sdk.Msg = &MsgMint{}
+	_ sdk.Msg = &MsgBurn{}
+	_ sdk.Msg = &MsgUpdateNFT{}
+)
 
 // GetSigners returns the expected signers for MsgSend.
 func (m MsgSend) GetSigners() []sdk.AccAddress {
 	signer, _ := sdk.AccAddressFromBech32(m.Sender)
 	return []sdk.AccAddress{signer}
 }
+
+// GetSigners returns the expected signers for MsgSaveClass.
+func (m MsgSaveClass) GetSigners() []sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{signer}
+}
+
+// GetSigners returns the expected signers for MsgUpdateClass.
+func (m MsgUpdateClass) GetSigners() []sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{signer}
+}
+
+// GetSigners returns the expected signers for MsgMint.
+func (m MsgMint) GetSigners() []sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromBech32(m.Minter)
+	return []sdk.AccAddress{signer}
+}
+
+// GetSigners returns the expected signers for MsgBurn.
+func (m MsgBurn) GetSigners() []sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{signer}
+}
+
+// GetSigners returns the expected signers for MsgUpdateNFT.
+func (m MsgUpdateNFT) GetSigners() []sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{signer}
+}
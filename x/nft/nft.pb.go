@@ -5,6 +5,7 @@ package nft
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	types "github.com/cosmos/cosmos-sdk/codec/types"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -23,6 +24,34 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// MintPolicy defines who is allowed to mint the NFTs of a class.
+type MintPolicy int32
+
+const (
+	// MINT_POLICY_OWNER_ONLY only allows the class owner, and the module authority, to mint.
+	MintPolicy_MINT_POLICY_OWNER_ONLY MintPolicy = 0
+	// MINT_POLICY_EVERYONE allows any account to mint.
+	MintPolicy_MINT_POLICY_EVERYONE MintPolicy = 1
+)
+
+var MintPolicy_name = map[int32]string{
+	0: "MINT_POLICY_OWNER_ONLY",
+	1: "MINT_POLICY_EVERYONE",
+}
+
+var MintPolicy_value = map[string]int32{
+	"MINT_POLICY_OWNER_ONLY": 0,
+	"MINT_POLICY_EVERYONE":   1,
+}
+
+func (x MintPolicy) String() string {
+	return proto.EnumName(MintPolicy_name, int32(x))
+}
+
+func (MintPolicy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_eb8ebf8e8053172c, []int{0}
+}
+
 // Class defines the class of the nft type.
 type Class struct {
 	// id defines the unique identifier of the NFT classification, similar to the contract address of ERC721
@@ -205,35 +234,110 @@ func (m *NFT) GetData() *types.Any {
 	return nil
 }
 
+// ClassPolicy defines who is allowed to manage an NFT class and to mint its NFTs.
+type ClassPolicy struct {
+	// class_id associated with the policy
+	ClassId string `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	// owner is the address allowed to manage the class and its NFTs, along with the module authority.
+	// Optional, a class without owner is only managed by the module authority.
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// mint_policy defines who is allowed to mint the NFTs of the class.
+	MintPolicy MintPolicy `protobuf:"varint,3,opt,name=mint_policy,json=mintPolicy,proto3,enum=cosmos.nft.v1beta1.MintPolicy" json:"mint_policy,omitempty"`
+}
+
+func (m *ClassPolicy) Reset()         { *m = ClassPolicy{} }
+func (m *ClassPolicy) String() string { return proto.CompactTextString(m) }
+func (*ClassPolicy) ProtoMessage()    {}
+func (*ClassPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eb8ebf8e8053172c, []int{2}
+}
+func (m *ClassPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClassPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClassPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClassPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClassPolicy.Merge(m, src)
+}
+func (m *ClassPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClassPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClassPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClassPolicy proto.InternalMessageInfo
+
+func (m *ClassPolicy) GetClassId() string {
+	if m != nil {
+		return m.ClassId
+	}
+	return ""
+}
+
+func (m *ClassPolicy) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *ClassPolicy) GetMintPolicy() MintPolicy {
+	if m != nil {
+		return m.MintPolicy
+	}
+	return MintPolicy_MINT_POLICY_OWNER_ONLY
+}
+
 func init() {
+	proto.RegisterEnum("cosmos.nft.v1beta1.MintPolicy", MintPolicy_name, MintPolicy_value)
 	proto.RegisterType((*Class)(nil), "cosmos.nft.v1beta1.Class")
 	proto.RegisterType((*NFT)(nil), "cosmos.nft.v1beta1.NFT")
+	proto.RegisterType((*ClassPolicy)(nil), "cosmos.nft.v1beta1.ClassPolicy")
 }
 
 func init() { proto.RegisterFile("cosmos/nft/v1beta1/nft.proto", fileDescriptor_eb8ebf8e8053172c) }
 
 var fileDescriptor_eb8ebf8e8053172c = []byte{
-	// 308 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x91, 0xc1, 0x4a, 0xf3, 0x40,
-	0x14, 0x85, 0x3b, 0x49, 0x9a, 0xfc, 0xff, 0x14, 0x44, 0x06, 0x91, 0xa9, 0xc8, 0x10, 0xba, 0xca,
-	0x42, 0x26, 0x54, 0x9f, 0x40, 0x05, 0xd1, 0x8d, 0x8b, 0xe2, 0xca, 0x4d, 0x99, 0x64, 0xd2, 0x66,
-	0x30, 0xc9, 0x94, 0x4c, 0x22, 0xe6, 0x09, 0xdc, 0xfa, 0x40, 0x3e, 0x80, 0xcb, 0x2e, 0x5d, 0x4a,
-	0xf2, 0x22, 0x32, 0x93, 0x58, 0x5c, 0x14, 0xdc, 0xdd, 0x7b, 0xce, 0xe1, 0xf2, 0x1d, 0x2e, 0x3c,
-	0x8d, 0xa5, 0xca, 0xa5, 0x0a, 0x8b, 0x55, 0x15, 0x3e, 0xcf, 0xa3, 0xa4, 0x62, 0x73, 0x3d, 0xd3,
-	0x4d, 0x29, 0x2b, 0x89, 0x50, 0xef, 0x52, 0xad, 0x0c, 0xee, 0xc9, 0x74, 0x2d, 0xe5, 0x3a, 0x4b,
-	0x42, 0x93, 0x88, 0xea, 0x55, 0xc8, 0x8a, 0xa6, 0x8f, 0xcf, 0xde, 0x01, 0x1c, 0x5f, 0x67, 0x4c,
-	0x29, 0x74, 0x00, 0x2d, 0xc1, 0x31, 0xf0, 0x41, 0xf0, 0x7f, 0x61, 0x09, 0x8e, 0x10, 0x74, 0x0a,
-	0x96, 0x27, 0xd8, 0x32, 0x8a, 0x99, 0xd1, 0x31, 0x74, 0x55, 0x93, 0x47, 0x32, 0xc3, 0xb6, 0x51,
-	0x87, 0x0d, 0xf9, 0x70, 0xc2, 0x13, 0x15, 0x97, 0x62, 0x53, 0x09, 0x59, 0x60, 0xc7, 0x98, 0xbf,
-	0x25, 0x74, 0x08, 0xed, 0xba, 0x14, 0x78, 0x6c, 0x1c, 0x3d, 0xa2, 0x29, 0xfc, 0x57, 0x97, 0x62,
-	0x99, 0x32, 0x95, 0x62, 0xd7, 0xc8, 0x5e, 0x5d, 0x8a, 0x5b, 0xa6, 0x52, 0x14, 0x40, 0x87, 0xb3,
-	0x8a, 0x61, 0xcf, 0x07, 0xc1, 0xe4, 0xfc, 0x88, 0xf6, 0xf8, 0xf4, 0x07, 0x9f, 0x5e, 0x16, 0xcd,
-	0xc2, 0x24, 0x66, 0xaf, 0x00, 0xda, 0xf7, 0x37, 0x0f, 0xfa, 0x58, 0xac, 0x5b, 0x2c, 0x77, 0x15,
-	0x3c, 0xb3, 0xdf, 0xf1, 0xa1, 0x97, 0xb5, 0xeb, 0x35, 0x90, 0xd8, 0xfb, 0x49, 0x9c, 0xfd, 0x24,
-	0xf0, 0x2f, 0x92, 0xab, 0xb3, 0x8f, 0x96, 0x80, 0x6d, 0x4b, 0xc0, 0x57, 0x4b, 0xc0, 0x5b, 0x47,
-	0x46, 0xdb, 0x8e, 0x8c, 0x3e, 0x3b, 0x32, 0x7a, 0x1c, 0x3e, 0xa2, 0xf8, 0x13, 0x15, 0x32, 0x7c,
-	0xd1, 0xbf, 0x8a, 0x5c, 0x73, 0xe1, 0xe2, 0x3b, 0x00, 0x00, 0xff, 0xff, 0xf2, 0xf7, 0xce, 0x23,
-	0xcc, 0x01, 0x00, 0x00,
+	// 436 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x52, 0xcf, 0x6a, 0x13, 0x41,
+	0x18, 0xcf, 0xe4, 0xaf, 0x7e, 0x81, 0x12, 0x86, 0x50, 0x26, 0x41, 0x96, 0xd0, 0x53, 0x10, 0x9d,
+	0xa5, 0xf5, 0x01, 0xa4, 0x29, 0x11, 0x03, 0xed, 0xa6, 0xac, 0x45, 0x89, 0x97, 0x65, 0x92, 0xd9,
+	0x26, 0x83, 0xd9, 0x99, 0x30, 0x33, 0xab, 0xee, 0x13, 0x78, 0xf5, 0xea, 0x7b, 0x78, 0xf4, 0x01,
+	0x3c, 0x16, 0x4f, 0x1e, 0x25, 0x79, 0x11, 0xd9, 0xd9, 0x35, 0x16, 0x2c, 0xf6, 0xf6, 0xfd, 0xfe,
+	0xec, 0xec, 0xef, 0x37, 0xf3, 0xc1, 0xa3, 0x85, 0x32, 0x89, 0x32, 0xbe, 0xbc, 0xb6, 0xfe, 0xfb,
+	0xe3, 0x79, 0x6c, 0xd9, 0x71, 0x3e, 0xd3, 0x8d, 0x56, 0x56, 0x61, 0x5c, 0xa8, 0x34, 0x67, 0x4a,
+	0xb5, 0xdf, 0x5b, 0x2a, 0xb5, 0x5c, 0xc7, 0xbe, 0x73, 0xcc, 0xd3, 0x6b, 0x9f, 0xc9, 0xac, 0xb0,
+	0xf7, 0x7b, 0x85, 0x3d, 0x72, 0xc8, 0x2f, 0xbf, 0x75, 0xe0, 0xe8, 0x1b, 0x82, 0xc6, 0xd9, 0x9a,
+	0x19, 0x83, 0x0f, 0xa0, 0x2a, 0x38, 0x41, 0x03, 0x34, 0x7c, 0x18, 0x56, 0x05, 0xc7, 0x18, 0xea,
+	0x92, 0x25, 0x31, 0xa9, 0x3a, 0xc6, 0xcd, 0xf8, 0x10, 0x9a, 0x26, 0x4b, 0xe6, 0x6a, 0x4d, 0x6a,
+	0x8e, 0x2d, 0x11, 0x1e, 0x40, 0x9b, 0xc7, 0x66, 0xa1, 0xc5, 0xc6, 0x0a, 0x25, 0x49, 0xdd, 0x89,
+	0xb7, 0x29, 0xdc, 0x81, 0x5a, 0xaa, 0x05, 0x69, 0x38, 0x25, 0x1f, 0x71, 0x0f, 0x1e, 0xa4, 0x5a,
+	0x44, 0x2b, 0x66, 0x56, 0xa4, 0xe9, 0xe8, 0x56, 0xaa, 0xc5, 0x4b, 0x66, 0x56, 0x78, 0x08, 0x75,
+	0xce, 0x2c, 0x23, 0xad, 0x01, 0x1a, 0xb6, 0x4f, 0xba, 0xb4, 0x68, 0x46, 0xff, 0x34, 0xa3, 0xa7,
+	0x32, 0x0b, 0x9d, 0xe3, 0xe8, 0x13, 0x82, 0x5a, 0xf0, 0xe2, 0x2a, 0x3f, 0x6c, 0x91, 0xb7, 0x88,
+	0xf6, 0x15, 0x5a, 0x0e, 0x4f, 0x78, 0xd9, 0xab, 0xba, 0xef, 0x55, 0x26, 0xa9, 0xdd, 0x9d, 0xa4,
+	0x7e, 0x77, 0x12, 0xb8, 0x37, 0xc9, 0x17, 0x04, 0x6d, 0x77, 0x91, 0x97, 0x6a, 0x2d, 0x16, 0xd9,
+	0xff, 0x12, 0x51, 0x68, 0xa8, 0x0f, 0x32, 0xd6, 0x45, 0xa8, 0x11, 0xf9, 0xf1, 0xf5, 0x69, 0xb7,
+	0x7c, 0x94, 0x53, 0xce, 0x75, 0x6c, 0xcc, 0x2b, 0xab, 0x85, 0x5c, 0x86, 0x85, 0x0d, 0x3f, 0x87,
+	0x76, 0x22, 0xa4, 0x8d, 0x36, 0xee, 0x64, 0x97, 0xfc, 0xe0, 0xc4, 0xa3, 0xff, 0xee, 0x00, 0xbd,
+	0x10, 0xd2, 0x16, 0xff, 0x0f, 0x21, 0xd9, 0xcf, 0x8f, 0x47, 0x00, 0x7f, 0x15, 0xdc, 0x87, 0xc3,
+	0x8b, 0x49, 0x70, 0x15, 0x5d, 0x4e, 0xcf, 0x27, 0x67, 0xb3, 0x68, 0xfa, 0x26, 0x18, 0x87, 0xd1,
+	0x34, 0x38, 0x9f, 0x75, 0x2a, 0x98, 0x40, 0xf7, 0xb6, 0x36, 0x7e, 0x3d, 0x0e, 0x67, 0xd3, 0x60,
+	0xdc, 0x41, 0xa3, 0x27, 0xdf, 0xb7, 0x1e, 0xba, 0xd9, 0x7a, 0xe8, 0xd7, 0xd6, 0x43, 0x9f, 0x77,
+	0x5e, 0xe5, 0x66, 0xe7, 0x55, 0x7e, 0xee, 0xbc, 0xca, 0xdb, 0x72, 0x19, 0x0d, 0x7f, 0x47, 0x85,
+	0xf2, 0x3f, 0xe6, 0x6b, 0x3a, 0x6f, 0xba, 0x1b, 0x7a, 0xf6, 0x7b, 0x00, 0xfa, 0x8e, 0x3f, 0xa7,
+	0xc7, 0x02, 0x00, 0x00,
 }
 
 func (m *Class) Marshal() (dAtA []byte, err error) {
@@ -376,6 +480,48 @@ func (m *NFT) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ClassPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClassPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClassPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MintPolicy != 0 {
+		i = encodeVarintNft(dAtA, i, uint64(m.MintPolicy))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintNft(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClassId) > 0 {
+		i -= len(m.ClassId)
+		copy(dAtA[i:], m.ClassId)
+		i = encodeVarintNft(dAtA, i, uint64(len(m.ClassId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintNft(dAtA []byte, offset int, v uint64) int {
 	offset -= sovNft(v)
 	base := offset
@@ -453,6 +599,26 @@ func (m *NFT) Size() (n int) {
 	return n
 }
 
+func (m *ClassPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovNft(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovNft(uint64(l))
+	}
+	if m.MintPolicy != 0 {
+		n += 1 + sovNft(uint64(m.MintPolicy))
+	}
+	return n
+}
+
 func sovNft(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -951,6 +1117,139 @@ func (m *NFT) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ClassPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNft
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClassPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClassPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintPolicy", wireType)
+			}
+			m.MintPolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MintPolicy |= MintPolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNft(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNft
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipNft(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,28 @@
+package nft
+
+import (
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/errors"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Validate checks that the class policy has a class id, a valid owner address,
+// if any, and a known mint policy.
+func (p ClassPolicy) Validate(ac address.Codec) error {
+	if len(p.ClassId) == 0 {
+		return ErrEmptyClassID
+	}
+
+	if len(p.Owner) > 0 {
+		if _, err := ac.StringToBytes(p.Owner); err != nil {
+			return errors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid owner address (%s)", p.Owner)
+		}
+	}
+
+	if _, ok := MintPolicy_name[int32(p.MintPolicy)]; !ok {
+		return errors.Wrapf(ErrInvalidPolicy, "unknown mint policy %d", p.MintPolicy)
+	}
+
+	return nil
+}
@@ -736,6 +736,98 @@ func (m *QueryClassesResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryClassPolicyRequest is the request type for the Query/ClassPolicy RPC method
+type QueryClassPolicyRequest struct {
+	// class_id associated with the nft
+	ClassId string `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+}
+
+func (m *QueryClassPolicyRequest) Reset()         { *m = QueryClassPolicyRequest{} }
+func (m *QueryClassPolicyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryClassPolicyRequest) ProtoMessage()    {}
+func (*QueryClassPolicyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d24e0db697b0f9d, []int{14}
+}
+func (m *QueryClassPolicyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryClassPolicyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClassPolicyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryClassPolicyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClassPolicyRequest.Merge(m, src)
+}
+func (m *QueryClassPolicyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryClassPolicyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClassPolicyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryClassPolicyRequest proto.InternalMessageInfo
+
+func (m *QueryClassPolicyRequest) GetClassId() string {
+	if m != nil {
+		return m.ClassId
+	}
+	return ""
+}
+
+// QueryClassPolicyResponse is the response type for the Query/ClassPolicy RPC method
+type QueryClassPolicyResponse struct {
+	// policy defines the policy of the class
+	Policy *ClassPolicy `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"`
+}
+
+func (m *QueryClassPolicyResponse) Reset()         { *m = QueryClassPolicyResponse{} }
+func (m *QueryClassPolicyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryClassPolicyResponse) ProtoMessage()    {}
+func (*QueryClassPolicyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d24e0db697b0f9d, []int{15}
+}
+func (m *QueryClassPolicyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryClassPolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClassPolicyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryClassPolicyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClassPolicyResponse.Merge(m, src)
+}
+func (m *QueryClassPolicyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryClassPolicyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClassPolicyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryClassPolicyResponse proto.InternalMessageInfo
+
+func (m *QueryClassPolicyResponse) GetPolicy() *ClassPolicy {
+	if m != nil {
+		return m.Policy
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryBalanceRequest)(nil), "cosmos.nft.v1beta1.QueryBalanceRequest")
 	proto.RegisterType((*QueryBalanceResponse)(nil), "cosmos.nft.v1beta1.QueryBalanceResponse")
@@ -751,57 +843,63 @@ func init() {
 	proto.RegisterType((*QueryClassResponse)(nil), "cosmos.nft.v1beta1.QueryClassResponse")
 	proto.RegisterType((*QueryClassesRequest)(nil), "cosmos.nft.v1beta1.QueryClassesRequest")
 	proto.RegisterType((*QueryClassesResponse)(nil), "cosmos.nft.v1beta1.QueryClassesResponse")
+	proto.RegisterType((*QueryClassPolicyRequest)(nil), "cosmos.nft.v1beta1.QueryClassPolicyRequest")
+	proto.RegisterType((*QueryClassPolicyResponse)(nil), "cosmos.nft.v1beta1.QueryClassPolicyResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/nft/v1beta1/query.proto", fileDescriptor_0d24e0db697b0f9d) }
 
 var fileDescriptor_0d24e0db697b0f9d = []byte{
-	// 719 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0x4b, 0x6f, 0xd3, 0x4c,
-	0x14, 0x86, 0x3b, 0x49, 0xd3, 0x7c, 0xdf, 0xa9, 0xc4, 0x65, 0xa8, 0x20, 0x35, 0x60, 0x45, 0xee,
-	0x25, 0x69, 0x4b, 0x67, 0x7a, 0x91, 0x58, 0x01, 0x8b, 0x22, 0x82, 0xd8, 0x14, 0x08, 0x5d, 0x21,
-	0x21, 0xe4, 0x34, 0x4e, 0x64, 0x91, 0x7a, 0xd2, 0x8e, 0x03, 0x54, 0x55, 0x17, 0x74, 0x81, 0xa8,
-	0xd8, 0x20, 0xd1, 0xdf, 0xc3, 0x9a, 0x65, 0x25, 0x36, 0x2c, 0x51, 0xcb, 0x0f, 0x41, 0x9e, 0x39,
-	0x4e, 0x6d, 0xea, 0xd8, 0x55, 0xc4, 0xd2, 0x99, 0xf7, 0x9c, 0xf7, 0xf1, 0x9c, 0x4b, 0x0c, 0xe6,
-	0xa6, 0x90, 0x5b, 0x42, 0x72, 0xaf, 0xe5, 0xf3, 0xb7, 0xcb, 0x0d, 0xc7, 0xb7, 0x97, 0xf9, 0x76,
-	0xcf, 0xd9, 0xd9, 0x65, 0xdd, 0x1d, 0xe1, 0x0b, 0x4a, 0xf5, 0x39, 0xf3, 0x5a, 0x3e, 0xc3, 0x73,
-	0x63, 0x1e, 0x63, 0x1a, 0xb6, 0x74, 0xb4, 0xb8, 0x1f, 0xda, 0xb5, 0xdb, 0xae, 0x67, 0xfb, 0xae,
-	0xf0, 0x74, 0xbc, 0x71, 0xab, 0x2d, 0x44, 0xbb, 0xe3, 0x70, 0xbb, 0xeb, 0x72, 0xdb, 0xf3, 0x84,
-	0xaf, 0x0e, 0x65, 0x78, 0x9a, 0xe0, 0x1e, 0x38, 0xa9, 0x53, 0xab, 0x06, 0xd7, 0x9e, 0x07, 0xd9,
-	0xd7, 0xec, 0x8e, 0xed, 0x6d, 0x3a, 0x75, 0x67, 0xbb, 0xe7, 0x48, 0x9f, 0x4e, 0xc2, 0x7f, 0x9b,
-	0x1d, 0x5b, 0xca, 0xd7, 0x6e, 0xb3, 0x44, 0xca, 0xa4, 0xfa, 0x7f, 0xbd, 0xa8, 0x9e, 0x9f, 0x34,
-	0xe9, 0x04, 0x14, 0xc4, 0x3b, 0xcf, 0xd9, 0x29, 0xe5, 0xd4, 0xef, 0xfa, 0xc1, 0x62, 0x30, 0x11,
-	0xcf, 0x23, 0xbb, 0xc2, 0x93, 0x0e, 0xbd, 0x0e, 0x63, 0xf6, 0x96, 0xe8, 0x79, 0xbe, 0x4a, 0x33,
-	0x5a, 0xc7, 0x27, 0xeb, 0x01, 0x5c, 0x55, 0xfa, 0xa7, 0x41, 0xf4, 0x05, 0x5c, 0x2f, 0x41, 0xce,
-	0x6d, 0xa2, 0x65, 0xce, 0x6d, 0x5a, 0xf3, 0x40, 0xa3, 0xf1, 0xe8, 0xd6, 0x67, 0x23, 0x51, 0x36,
-	0x8e, 0xda, 0x17, 0xbd, 0x6e, 0xb7, 0xb3, 0x9b, 0x6d, 0x66, 0x2d, 0xe2, 0xa5, 0x84, 0x01, 0x19,
-	0xef, 0xf2, 0x99, 0xc0, 0x15, 0xa5, 0x5f, 0xaf, 0x6d, 0xc8, 0x61, 0x6f, 0x90, 0xd6, 0x00, 0xce,
-	0x2a, 0x5b, 0xca, 0x97, 0x49, 0x75, 0x7c, 0x65, 0x96, 0x61, 0x6b, 0x04, 0x6d, 0xc0, 0x74, 0xcf,
-	0x60, 0x0d, 0xd9, 0x33, 0xbb, 0x1d, 0x96, 0xab, 0x1e, 0x89, 0xb4, 0x0e, 0x09, 0x5e, 0xad, 0xa6,
-	0x41, 0xf6, 0x05, 0x18, 0xf5, 0x5a, 0xbe, 0x2c, 0x91, 0x72, 0xbe, 0x3a, 0xbe, 0x72, 0x83, 0x9d,
-	0x6f, 0x39, 0xb6, 0x5e, 0xdb, 0xa8, 0x2b, 0x11, 0x7d, 0x1c, 0x43, 0xc9, 0x29, 0x94, 0x4a, 0x26,
-	0x8a, 0x76, 0x8a, 0xb1, 0xdc, 0x83, 0xcb, 0x21, 0xca, 0x10, 0x35, 0xbe, 0x7f, 0x76, 0xad, 0xfd,
-	0xf7, 0x98, 0x83, 0xbc, 0xd7, 0xd2, 0x05, 0x48, 0x79, 0x8d, 0x40, 0x63, 0x31, 0xbc, 0x87, 0x87,
-	0x41, 0xfa, 0x0b, 0x54, 0xfd, 0x11, 0xb6, 0x09, 0xea, 0xd1, 0x90, 0x43, 0x41, 0x09, 0xd0, 0x72,
-	0x32, 0xc9, 0x52, 0x47, 0x68, 0x9d, 0xf5, 0x0a, 0x9b, 0x47, 0xfd, 0xe8, 0xf4, 0x8d, 0xe3, 0xe5,
-	0x25, 0x43, 0x97, 0xf7, 0x88, 0xe0, 0xa4, 0xf5, 0xf3, 0x23, 0xe8, 0x2a, 0xe8, 0x37, 0x71, 0xc2,
-	0x22, 0xa7, 0xa0, 0x86, 0xca, 0x7f, 0x56, 0xe9, 0x95, 0x6f, 0x45, 0x28, 0x28, 0x2c, 0x7a, 0x44,
-	0xa0, 0x88, 0x5b, 0x80, 0x56, 0x92, 0x10, 0x12, 0xf6, 0x8d, 0x51, 0xcd, 0x16, 0x6a, 0x53, 0xeb,
-	0xee, 0xc1, 0x8f, 0xdf, 0x5f, 0x73, 0x4b, 0x94, 0xf1, 0x84, 0xbd, 0xd6, 0xd0, 0x62, 0xbe, 0xa7,
-	0x46, 0x6a, 0x9f, 0xef, 0x85, 0xb5, 0xde, 0xa7, 0x87, 0x04, 0x0a, 0x6a, 0x59, 0xd0, 0x99, 0x81,
-	0x5e, 0xd1, 0x65, 0x64, 0xcc, 0x66, 0xc9, 0x10, 0x68, 0x59, 0x01, 0x2d, 0xd0, 0xb9, 0x24, 0x20,
-	0xc5, 0x11, 0xc1, 0xe0, 0x7b, 0x01, 0xcb, 0x27, 0x02, 0x63, 0x7a, 0xb7, 0xd0, 0xc1, 0x2e, 0xb1,
-	0x6d, 0x65, 0x54, 0x32, 0x75, 0x88, 0xb3, 0xa8, 0x70, 0x2a, 0x74, 0x26, 0x09, 0x47, 0x2a, 0x6d,
-	0xf4, 0x5a, 0x7a, 0x30, 0x1a, 0xec, 0x09, 0x3a, 0x3d, 0x30, 0x7f, 0x64, 0xa9, 0x19, 0x33, 0x19,
-	0x2a, 0x64, 0x28, 0x2b, 0x06, 0x83, 0x96, 0x78, 0xf2, 0x7f, 0x8f, 0xa4, 0x07, 0x04, 0xf2, 0xeb,
-	0xb5, 0x0d, 0x3a, 0x95, 0x96, 0x30, 0x74, 0x9d, 0x4e, 0x17, 0xa1, 0xe9, 0x92, 0x32, 0x9d, 0xa7,
-	0xd5, 0x41, 0xa6, 0xe7, 0xca, 0xf0, 0x91, 0x40, 0x41, 0xcd, 0x43, 0x4a, 0x4b, 0x44, 0x97, 0x47,
-	0x4a, 0x4b, 0xc4, 0x76, 0x86, 0xc5, 0x14, 0x4a, 0x95, 0xce, 0x26, 0xa1, 0xe0, 0xe8, 0x45, 0x8b,
-	0xf0, 0x81, 0x40, 0x11, 0xc7, 0x39, 0x65, 0x64, 0xe2, 0x0b, 0x25, 0x65, 0x64, 0xfe, 0xda, 0x0c,
-	0xd6, 0x94, 0xc2, 0xb9, 0x4d, 0x6f, 0xa6, 0xe0, 0xac, 0xdd, 0xf9, 0x7e, 0x62, 0x92, 0xe3, 0x13,
-	0x93, 0xfc, 0x3a, 0x31, 0xc9, 0x97, 0x53, 0x73, 0xe4, 0xf8, 0xd4, 0x1c, 0xf9, 0x79, 0x6a, 0x8e,
-	0xbc, 0xc4, 0xcf, 0x13, 0xd9, 0x7c, 0xc3, 0x5c, 0xc1, 0xdf, 0x07, 0xd1, 0x8d, 0x31, 0xf5, 0xf5,
-	0xb0, 0xfa, 0x27, 0x00, 0x00, 0xff, 0xff, 0x5b, 0x6c, 0x33, 0x5d, 0xdb, 0x08, 0x00, 0x00,
+	// 774 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0xcd, 0x4f, 0x13, 0x41,
+	0x18, 0xc6, 0x99, 0x96, 0x16, 0x7c, 0x49, 0x54, 0x06, 0x22, 0x65, 0xd5, 0xda, 0x2c, 0xd0, 0x96,
+	0xaf, 0x1d, 0x3e, 0xfc, 0xb8, 0xa8, 0x07, 0x8c, 0x35, 0x5e, 0x10, 0x0b, 0x27, 0x13, 0x63, 0xb6,
+	0xed, 0xb6, 0xd9, 0x58, 0x76, 0x17, 0x66, 0xab, 0x12, 0xc2, 0x41, 0x0e, 0x46, 0xe2, 0xc5, 0x44,
+	0xee, 0xfe, 0x3b, 0x1e, 0x49, 0xbc, 0x78, 0x24, 0xe0, 0x1f, 0x62, 0x76, 0xe6, 0xdd, 0xb2, 0x1b,
+	0xb6, 0xbb, 0x0d, 0xf1, 0xb8, 0x3b, 0xcf, 0xfb, 0x3e, 0xbf, 0x99, 0x77, 0xf6, 0x69, 0x21, 0x5f,
+	0xb7, 0xf9, 0xb6, 0xcd, 0x99, 0xd5, 0x74, 0xd9, 0x87, 0xe5, 0x9a, 0xe1, 0xea, 0xcb, 0x6c, 0xa7,
+	0x63, 0xec, 0xee, 0x69, 0xce, 0xae, 0xed, 0xda, 0x94, 0xca, 0x75, 0xcd, 0x6a, 0xba, 0x1a, 0xae,
+	0x2b, 0x73, 0x58, 0x53, 0xd3, 0xb9, 0x21, 0xc5, 0xdd, 0x52, 0x47, 0x6f, 0x99, 0x96, 0xee, 0x9a,
+	0xb6, 0x25, 0xeb, 0x95, 0x3b, 0x2d, 0xdb, 0x6e, 0xb5, 0x0d, 0xa6, 0x3b, 0x26, 0xd3, 0x2d, 0xcb,
+	0x76, 0xc5, 0x22, 0xf7, 0x57, 0x23, 0xdc, 0x3d, 0x27, 0xb1, 0xaa, 0x56, 0x60, 0xec, 0xb5, 0xd7,
+	0x7d, 0x4d, 0x6f, 0xeb, 0x56, 0xdd, 0xa8, 0x1a, 0x3b, 0x1d, 0x83, 0xbb, 0x74, 0x12, 0x86, 0xeb,
+	0x6d, 0x9d, 0xf3, 0x77, 0x66, 0x23, 0x47, 0x0a, 0xa4, 0x7c, 0xad, 0x3a, 0x24, 0x9e, 0x5f, 0x36,
+	0xe8, 0x38, 0x64, 0xec, 0x8f, 0x96, 0xb1, 0x9b, 0x4b, 0x89, 0xf7, 0xf2, 0x41, 0xd5, 0x60, 0x3c,
+	0xdc, 0x87, 0x3b, 0xb6, 0xc5, 0x0d, 0x7a, 0x0b, 0xb2, 0xfa, 0xb6, 0xdd, 0xb1, 0x5c, 0xd1, 0x66,
+	0xb0, 0x8a, 0x4f, 0xea, 0x53, 0x18, 0x15, 0xfa, 0x57, 0x5e, 0x75, 0x1f, 0xae, 0xd7, 0x21, 0x65,
+	0x36, 0xd0, 0x32, 0x65, 0x36, 0xd4, 0x39, 0xa0, 0xc1, 0x7a, 0x74, 0xeb, 0xb2, 0x91, 0x20, 0x1b,
+	0x43, 0xed, 0x66, 0xc7, 0x71, 0xda, 0x7b, 0xc9, 0x66, 0xea, 0x22, 0x8c, 0x85, 0x0a, 0x12, 0xf6,
+	0xf2, 0x8d, 0xc0, 0x4d, 0xa1, 0x5f, 0xaf, 0x6c, 0xf1, 0xab, 0x9e, 0x20, 0xad, 0x00, 0x5c, 0x4c,
+	0x36, 0x97, 0x2e, 0x90, 0xf2, 0xc8, 0x4a, 0x51, 0xc3, 0xab, 0xe1, 0x5d, 0x03, 0x4d, 0xde, 0x19,
+	0x9c, 0xa1, 0xb6, 0xa1, 0xb7, 0xfc, 0x71, 0x55, 0x03, 0x95, 0xea, 0x11, 0x81, 0xd1, 0x00, 0x0d,
+	0xb2, 0xcf, 0xc3, 0xa0, 0xd5, 0x74, 0x79, 0x8e, 0x14, 0xd2, 0xe5, 0x91, 0x95, 0x09, 0xed, 0xf2,
+	0x95, 0xd3, 0xd6, 0x2b, 0x5b, 0x55, 0x21, 0xa2, 0x2f, 0x42, 0x28, 0x29, 0x81, 0x52, 0x4a, 0x44,
+	0x91, 0x4e, 0x21, 0x96, 0xc7, 0x70, 0xc3, 0x47, 0xb9, 0xc2, 0x8c, 0x9f, 0x5c, 0x1c, 0x6b, 0x77,
+	0x1f, 0xb3, 0x90, 0xb6, 0x9a, 0x72, 0x00, 0x31, 0xdb, 0xf0, 0x34, 0xaa, 0x86, 0xe7, 0xf0, 0xcc,
+	0x6b, 0xdf, 0xc7, 0xd4, 0x9f, 0x03, 0x0d, 0xea, 0xd1, 0x90, 0x41, 0x46, 0x08, 0xd0, 0x72, 0x32,
+	0xca, 0x52, 0x56, 0x48, 0x9d, 0xfa, 0x16, 0x2f, 0x8f, 0x78, 0x69, 0x74, 0x8d, 0xc3, 0xe3, 0x25,
+	0x57, 0x1e, 0xef, 0x31, 0x81, 0xf1, 0x70, 0x7f, 0x04, 0x5d, 0x05, 0xb9, 0x13, 0xc3, 0x1f, 0x72,
+	0x0c, 0xaa, 0xaf, 0xfc, 0x7f, 0x93, 0xbe, 0x0f, 0x13, 0x17, 0x54, 0x1b, 0x76, 0xdb, 0xac, 0xf7,
+	0xf3, 0xa1, 0x6d, 0x42, 0xee, 0x72, 0x15, 0xee, 0xe7, 0x11, 0x64, 0x1d, 0xf1, 0x06, 0x0f, 0xeb,
+	0x5e, 0xcf, 0xed, 0x60, 0x21, 0xca, 0x57, 0x4e, 0x87, 0x21, 0x23, 0xba, 0xd2, 0x63, 0x02, 0x43,
+	0x18, 0x48, 0xb4, 0x14, 0x55, 0x1e, 0x11, 0x7d, 0x4a, 0x39, 0x59, 0x28, 0x09, 0xd5, 0x87, 0x87,
+	0xbf, 0xff, 0xfe, 0x48, 0x2d, 0x51, 0x8d, 0x45, 0x44, 0x6c, 0x4d, 0x8a, 0xd9, 0xbe, 0xf8, 0xba,
+	0x0f, 0xd8, 0xbe, 0x7f, 0x06, 0x07, 0xf4, 0x88, 0x40, 0x46, 0xe4, 0x16, 0x9d, 0xe9, 0xe9, 0x15,
+	0xcc, 0x45, 0xa5, 0x98, 0x24, 0x43, 0xa0, 0x65, 0x01, 0x34, 0x4f, 0x67, 0xa3, 0x80, 0x04, 0x47,
+	0x00, 0x83, 0xed, 0x7b, 0x2c, 0x5f, 0x09, 0x64, 0x65, 0xcc, 0xd1, 0xde, 0x2e, 0xa1, 0xe0, 0x54,
+	0x4a, 0x89, 0x3a, 0xc4, 0x59, 0x14, 0x38, 0x25, 0x3a, 0x13, 0x85, 0xc3, 0x85, 0x36, 0x78, 0x2c,
+	0x1d, 0x18, 0xf4, 0x22, 0x8b, 0x4e, 0xf7, 0xec, 0x1f, 0xc8, 0x57, 0x65, 0x26, 0x41, 0x85, 0x0c,
+	0x05, 0xc1, 0xa0, 0xd0, 0x1c, 0x8b, 0xfe, 0x19, 0xe4, 0xf4, 0x90, 0x40, 0x7a, 0xbd, 0xb2, 0x45,
+	0xa7, 0xe2, 0x1a, 0xfa, 0xae, 0xd3, 0xf1, 0x22, 0x34, 0x5d, 0x12, 0xa6, 0x73, 0xb4, 0xdc, 0xcb,
+	0xf4, 0xd2, 0x18, 0xbe, 0x10, 0xc8, 0x88, 0xbb, 0x1c, 0x73, 0x25, 0x82, 0x39, 0xa6, 0x14, 0x93,
+	0x64, 0x88, 0xa2, 0x09, 0x94, 0x32, 0x2d, 0x46, 0xa1, 0x60, 0x0a, 0x04, 0x87, 0xf0, 0x99, 0xc0,
+	0x10, 0x26, 0x4b, 0xcc, 0x27, 0x13, 0xce, 0x36, 0xa5, 0x9c, 0x2c, 0x44, 0x9c, 0x29, 0x81, 0x73,
+	0x97, 0xde, 0x8e, 0xc1, 0xa1, 0x3f, 0x09, 0x8c, 0x04, 0x3e, 0x6c, 0x3a, 0x1f, 0xdf, 0x3e, 0x94,
+	0x36, 0xca, 0x42, 0x7f, 0x62, 0xe4, 0x79, 0x20, 0x78, 0x18, 0x5d, 0xec, 0xef, 0x78, 0x98, 0x8c,
+	0x98, 0xb5, 0x85, 0x5f, 0x67, 0x79, 0x72, 0x72, 0x96, 0x27, 0xa7, 0x67, 0x79, 0xf2, 0xfd, 0x3c,
+	0x3f, 0x70, 0x72, 0x9e, 0x1f, 0xf8, 0x73, 0x9e, 0x1f, 0x78, 0x83, 0xff, 0xe5, 0x78, 0xe3, 0xbd,
+	0x66, 0xda, 0xec, 0x93, 0xd7, 0xaf, 0x96, 0x15, 0x7f, 0xb5, 0x56, 0xff, 0x0d, 0x00, 0x83, 0x7d,
+	0x9e, 0x12, 0x08, 0x0a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -831,6 +929,8 @@ type QueryClient interface {
 	Class(ctx context.Context, in *QueryClassRequest, opts ...grpc.CallOption) (*QueryClassResponse, error)
 	// Classes queries all NFT classes
 	Classes(ctx context.Context, in *QueryClassesRequest, opts ...grpc.CallOption) (*QueryClassesResponse, error)
+	// ClassPolicy queries the policy of an NFT class based on its id
+	ClassPolicy(ctx context.Context, in *QueryClassPolicyRequest, opts ...grpc.CallOption) (*QueryClassPolicyResponse, error)
 }
 
 type queryClient struct {
@@ -904,6 +1004,15 @@ func (c *queryClient) Classes(ctx context.Context, in *QueryClassesRequest, opts
 	return out, nil
 }
 
+func (c *queryClient) ClassPolicy(ctx context.Context, in *QueryClassPolicyRequest, opts ...grpc.CallOption) (*QueryClassPolicyResponse, error) {
+	out := new(QueryClassPolicyResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.nft.v1beta1.Query/ClassPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Balance queries the number of NFTs of a given class owned by the owner, same as balanceOf in ERC721
@@ -921,6 +1030,8 @@ type QueryServer interface {
 	Class(context.Context, *QueryClassRequest) (*QueryClassResponse, error)
 	// Classes queries all NFT classes
 	Classes(context.Context, *QueryClassesRequest) (*QueryClassesResponse, error)
+	// ClassPolicy queries the policy of an NFT class based on its id
+	ClassPolicy(context.Context, *QueryClassPolicyRequest) (*QueryClassPolicyResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -948,6 +1059,9 @@ func (*UnimplementedQueryServer) Class(ctx context.Context, req *QueryClassReque
 func (*UnimplementedQueryServer) Classes(ctx context.Context, req *QueryClassesRequest) (*QueryClassesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Classes not implemented")
 }
+func (*UnimplementedQueryServer) ClassPolicy(ctx context.Context, req *QueryClassPolicyRequest) (*QueryClassPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClassPolicy not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1079,6 +1193,24 @@ func _Query_Classes_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ClassPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClassPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ClassPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.nft.v1beta1.Query/ClassPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ClassPolicy(ctx, req.(*QueryClassPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.nft.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1111,6 +1243,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Classes",
 			Handler:    _Query_Classes_Handler,
 		},
+		{
+			MethodName: "ClassPolicy",
+			Handler:    _Query_ClassPolicy_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/nft/v1beta1/query.proto",
@@ -1625,6 +1761,71 @@ func (m *QueryClassesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryClassPolicyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClassPolicyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClassPolicyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ClassId) > 0 {
+		i -= len(m.ClassId)
+		copy(dAtA[i:], m.ClassId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClassId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClassPolicyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClassPolicyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClassPolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Policy != nil {
+		{
+			size, err := m.Policy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -1848,6 +2049,32 @@ func (m *QueryClassesResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryClassPolicyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryClassPolicyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Policy != nil {
+		l = m.Policy.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3228,6 +3455,174 @@ func (m *QueryClassesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryClassPolicyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClassPolicyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClassPolicyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryClassPolicyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClassPolicyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClassPolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Policy == nil {
+				m.Policy = &ClassPolicy{}
+			}
+			if err := m.Policy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -35,6 +35,14 @@ func TestDecodeStore(t *testing.T) {
 	classBz, err := encCfg.Codec.Marshal(&class)
 	require.NoError(t, err)
 
+	policy := nft.ClassPolicy{
+		ClassId:    "ClassID",
+		Owner:      ownerAddr1.String(),
+		MintPolicy: nft.MintPolicy_MINT_POLICY_EVERYONE,
+	}
+	policyBz, err := encCfg.Codec.Marshal(&policy)
+	require.NoError(t, err)
+
 	nft := nft.NFT{
 		ClassId: "ClassID",
 		Id:      "NFTID",
@@ -55,6 +63,7 @@ func TestDecodeStore(t *testing.T) {
 			{Key: keeper.NFTOfClassByOwnerKey, Value: nftOfClassByOwnerValue},
 			{Key: keeper.OwnerKey, Value: ownerAddr1},
 			{Key: keeper.ClassTotalSupply, Value: totalSupplyBz},
+			{Key: keeper.ClassPolicyKey, Value: policyBz},
 			{Key: []byte{0x99}, Value: []byte{0x99}},
 		},
 	}
@@ -69,6 +78,7 @@ func TestDecodeStore(t *testing.T) {
 		{"NFTOfClassByOwnerKey", false, fmt.Sprintf("%v\n%v", nftOfClassByOwnerValue, nftOfClassByOwnerValue)},
 		{"OwnerKey", false, fmt.Sprintf("%v\n%v", ownerAddr1, ownerAddr1)},
 		{"ClassTotalSupply", false, fmt.Sprintf("%v\n%v", totalSupply, totalSupply)},
+		{"ClassPolicy", false, fmt.Sprintf("%v\n%v", policy, policy)},
 		{"other", true, ""},
 	}
 
@@ -8,21 +8,41 @@ import (
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 )
 
+// genClass returns a random nft class.
+func genClass(r *rand.Rand) nft.Class {
+	return nft.Class{
+		Id:          simtypes.RandStringOfLength(r, 10),
+		Name:        simtypes.RandStringOfLength(r, 10),
+		Symbol:      simtypes.RandStringOfLength(r, 10),
+		Description: simtypes.RandStringOfLength(r, 10),
+		Uri:         simtypes.RandStringOfLength(r, 10),
+	}
+}
+
 // genClasses returns a slice of nft class.
 func genClasses(r *rand.Rand, accounts []simtypes.Account) []*nft.Class {
 	classes := make([]*nft.Class, len(accounts)-1)
 	for i := 0; i < len(accounts)-1; i++ {
-		classes[i] = &nft.Class{
-			Id:          simtypes.RandStringOfLength(r, 10),
-			Name:        simtypes.RandStringOfLength(r, 10),
-			Symbol:      simtypes.RandStringOfLength(r, 10),
-			Description: simtypes.RandStringOfLength(r, 10),
-			Uri:         simtypes.RandStringOfLength(r, 10),
-		}
+		class := genClass(r)
+		classes[i] = &class
 	}
 	return classes
 }
 
+// genClassPolicies returns a slice of class policy, the class of index i is
+// owned by the account of index i.
+func genClassPolicies(r *rand.Rand, classes []*nft.Class, accounts []simtypes.Account) []*nft.ClassPolicy {
+	policies := make([]*nft.ClassPolicy, len(classes))
+	for i, class := range classes {
+		policies[i] = &nft.ClassPolicy{
+			ClassId:    class.Id,
+			Owner:      accounts[i].Address.String(),
+			MintPolicy: randMintPolicy(r),
+		}
+	}
+	return policies
+}
+
 // genNFT returns a slice of nft.
 func genNFT(r *rand.Rand, classID string, accounts []simtypes.Account) []*nft.Entry {
 	entries := make([]*nft.Entry, len(accounts)-1)
@@ -59,9 +79,16 @@ func RandomizedGenState(simState *module.SimulationState) {
 		},
 	)
 
+	var policies []*nft.ClassPolicy
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, "nft", &policies, simState.Rand,
+		func(r *rand.Rand) { policies = genClassPolicies(r, classes, simState.Accounts) },
+	)
+
 	nftGenesis := &nft.GenesisState{
-		Classes: classes,
-		Entries: entries,
+		Classes:       classes,
+		Entries:       entries,
+		ClassPolicies: policies,
 	}
 	simState.GenState[nft.ModuleName] = simState.Cdc.MustMarshalJSON(nftGenesis)
 }
@@ -38,4 +38,9 @@ func TestRandomizedGenState(t *testing.T) {
 
 	require.Len(t, nftGenesis.Classes, len(simState.Accounts)-1)
 	require.Len(t, nftGenesis.Entries, len(simState.Accounts)-1)
+	require.Len(t, nftGenesis.ClassPolicies, len(nftGenesis.Classes))
+	for i, policy := range nftGenesis.ClassPolicies {
+		require.Equal(t, nftGenesis.Classes[i].Id, policy.ClassId)
+		require.Equal(t, simState.Accounts[i].Address.String(), policy.Owner)
+	}
 }
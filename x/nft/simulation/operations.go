@@ -18,13 +18,27 @@ import (
 
 const (
 	// OpWeightMsgSend Simulation operation weights constants
-	OpWeightMsgSend = "op_weight_msg_send"
+	OpWeightMsgSend        = "op_weight_msg_send"
+	OpWeightMsgUpdateClass = "op_weight_msg_update_class"
+	OpWeightMsgMint        = "op_weight_msg_mint"
+	OpWeightMsgBurn        = "op_weight_msg_burn"
+	OpWeightMsgUpdateNFT   = "op_weight_msg_update_nft"
 
 	// WeightSend nft operations weights
-	WeightSend = 100
+	WeightSend        = 100
+	WeightUpdateClass = 20
+	WeightMint        = 80
+	WeightBurn        = 20
+	WeightUpdateNFT   = 40
 )
 
-var TypeMsgSend = sdk.MsgTypeURL(&nft.MsgSend{})
+var (
+	TypeMsgSend        = sdk.MsgTypeURL(&nft.MsgSend{})
+	TypeMsgUpdateClass = sdk.MsgTypeURL(&nft.MsgUpdateClass{})
+	TypeMsgMint        = sdk.MsgTypeURL(&nft.MsgMint{})
+	TypeMsgBurn        = sdk.MsgTypeURL(&nft.MsgBurn{})
+	TypeMsgUpdateNFT   = sdk.MsgTypeURL(&nft.MsgUpdateNFT{})
+)
 
 // WeightedOperations returns all the operations from the module with their respective weights
 func WeightedOperations(
@@ -36,7 +50,13 @@ func WeightedOperations(
 	bk nft.BankKeeper,
 	k keeper.Keeper,
 ) simulation.WeightedOperations {
-	var weightMsgSend int
+	var (
+		weightMsgSend        int
+		weightMsgUpdateClass int
+		weightMsgMint        int
+		weightMsgBurn        int
+		weightMsgUpdateNFT   int
+	)
 
 	appParams.GetOrGenerate(cdc, OpWeightMsgSend, &weightMsgSend, nil,
 		func(_ *rand.Rand) {
@@ -44,10 +64,51 @@ func WeightedOperations(
 		},
 	)
 
+	appParams.GetOrGenerate(cdc, OpWeightMsgUpdateClass, &weightMsgUpdateClass, nil,
+		func(_ *rand.Rand) {
+			weightMsgUpdateClass = WeightUpdateClass
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgMint, &weightMsgMint, nil,
+		func(_ *rand.Rand) {
+			weightMsgMint = WeightMint
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgBurn, &weightMsgBurn, nil,
+		func(_ *rand.Rand) {
+			weightMsgBurn = WeightBurn
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgUpdateNFT, &weightMsgUpdateNFT, nil,
+		func(_ *rand.Rand) {
+			weightMsgUpdateNFT = WeightUpdateNFT
+		},
+	)
+
+	protoCdc := codec.NewProtoCodec(registry)
 	return simulation.WeightedOperations{
 		simulation.NewWeightedOperation(
 			weightMsgSend,
-			SimulateMsgSend(codec.NewProtoCodec(registry), txCfg, ak, bk, k),
+			SimulateMsgSend(protoCdc, txCfg, ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgUpdateClass,
+			SimulateMsgUpdateClass(protoCdc, txCfg, ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgMint,
+			SimulateMsgMint(protoCdc, txCfg, ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgBurn,
+			SimulateMsgBurn(protoCdc, txCfg, ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgUpdateNFT,
+			SimulateMsgUpdateNFT(protoCdc, txCfg, ak, bk, k),
 		),
 	}
 }
@@ -117,6 +178,227 @@ func SimulateMsgSend(
 	}
 }
 
+// SimulateMsgUpdateClass generates a MsgUpdateClass with random values.
+func SimulateMsgUpdateClass(
+	cdc *codec.ProtoCodec,
+	txCfg client.TxConfig,
+	ak nft.AccountKeeper,
+	bk nft.BankKeeper,
+	k keeper.Keeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		class, owner, found := randOwnedClass(ctx, r, k, accs)
+		if !found {
+			return simtypes.NoOpMsg(nft.ModuleName, TypeMsgUpdateClass, "no class owned by a simulation account"), nil, nil
+		}
+
+		class.Description = simtypes.RandStringOfLength(r, 10)
+		msg := &nft.MsgUpdateClass{
+			Sender: owner.Address.String(),
+			Class:  class,
+			Policy: &nft.ClassPolicy{
+				Owner:      owner.Address.String(),
+				MintPolicy: randMintPolicy(r),
+			},
+		}
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           txCfg,
+			Cdc:             cdc,
+			Msg:             msg,
+			Context:         ctx,
+			SimAccount:      owner,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      nft.ModuleName,
+			CoinsSpentInMsg: sdk.NewCoins(),
+		}
+
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+// SimulateMsgMint generates a MsgMint with random values.
+func SimulateMsgMint(
+	cdc *codec.ProtoCodec,
+	txCfg client.TxConfig,
+	ak nft.AccountKeeper,
+	bk nft.BankKeeper,
+	k keeper.Keeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		class, minter, found := randOwnedClass(ctx, r, k, accs)
+		if !found {
+			return simtypes.NoOpMsg(nft.ModuleName, TypeMsgMint, "no class owned by a simulation account"), nil, nil
+		}
+
+		// anyone can mint a class with the everyone mint policy
+		if policy, err := k.GetClassPolicy(ctx, class.Id); err == nil && policy.MintPolicy == nft.MintPolicy_MINT_POLICY_EVERYONE {
+			minter, _ = simtypes.RandomAcc(r, accs)
+		}
+		receiver, _ := simtypes.RandomAcc(r, accs)
+
+		n := nft.NFT{
+			ClassId: class.Id,
+			Id:      simtypes.RandStringOfLength(r, 10),
+			Uri:     simtypes.RandStringOfLength(r, 10),
+		}
+		if k.HasNFT(ctx, n.ClassId, n.Id) {
+			return simtypes.NoOpMsg(nft.ModuleName, TypeMsgMint, "nft already exists"), nil, nil
+		}
+
+		msg := &nft.MsgMint{
+			Minter:   minter.Address.String(),
+			Nft:      n,
+			Receiver: receiver.Address.String(),
+		}
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           txCfg,
+			Cdc:             cdc,
+			Msg:             msg,
+			Context:         ctx,
+			SimAccount:      minter,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      nft.ModuleName,
+			CoinsSpentInMsg: sdk.NewCoins(),
+		}
+
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+// SimulateMsgBurn generates a MsgBurn with random values.
+func SimulateMsgBurn(
+	cdc *codec.ProtoCodec,
+	txCfg client.TxConfig,
+	ak nft.AccountKeeper,
+	bk nft.BankKeeper,
+	k keeper.Keeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		sender, _ := simtypes.RandomAcc(r, accs)
+
+		n, err := randNFT(ctx, r, k, sender.Address)
+		if err != nil {
+			return simtypes.NoOpMsg(nft.ModuleName, TypeMsgBurn, err.Error()), nil, err
+		}
+
+		msg := &nft.MsgBurn{
+			Sender:  sender.Address.String(),
+			ClassId: n.ClassId,
+			Id:      n.Id,
+		}
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           txCfg,
+			Cdc:             cdc,
+			Msg:             msg,
+			Context:         ctx,
+			SimAccount:      sender,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      nft.ModuleName,
+			CoinsSpentInMsg: sdk.NewCoins(),
+		}
+
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+// SimulateMsgUpdateNFT generates a MsgUpdateNFT with random values.
+func SimulateMsgUpdateNFT(
+	cdc *codec.ProtoCodec,
+	txCfg client.TxConfig,
+	ak nft.AccountKeeper,
+	bk nft.BankKeeper,
+	k keeper.Keeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		class, owner, found := randOwnedClass(ctx, r, k, accs)
+		if !found {
+			return simtypes.NoOpMsg(nft.ModuleName, TypeMsgUpdateNFT, "no class owned by a simulation account"), nil, nil
+		}
+
+		nfts := k.GetNFTsOfClass(ctx, class.Id)
+		if len(nfts) == 0 {
+			return simtypes.NoOpMsg(nft.ModuleName, TypeMsgUpdateNFT, "no nft in class"), nil, nil
+		}
+
+		n := nfts[r.Intn(len(nfts))]
+		n.Uri = simtypes.RandStringOfLength(r, 10)
+		msg := &nft.MsgUpdateNFT{
+			Sender: owner.Address.String(),
+			Nft:    n,
+		}
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           txCfg,
+			Cdc:             cdc,
+			Msg:             msg,
+			Context:         ctx,
+			SimAccount:      owner,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      nft.ModuleName,
+			CoinsSpentInMsg: sdk.NewCoins(),
+		}
+
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+// randOwnedClass picks a random Class owned by one of the simulation accounts.
+func randOwnedClass(ctx sdk.Context, r *rand.Rand, k keeper.Keeper, accs []simtypes.Account) (nft.Class, simtypes.Account, bool) {
+	policies := k.GetClassPolicies(ctx)
+	r.Shuffle(len(policies), func(i, j int) {
+		policies[i], policies[j] = policies[j], policies[i]
+	})
+
+	for _, policy := range policies {
+		owner, err := sdk.AccAddressFromBech32(policy.Owner)
+		if err != nil {
+			continue
+		}
+
+		acc, found := simtypes.FindAccount(accs, owner)
+		if !found {
+			continue
+		}
+
+		class, found := k.GetClass(ctx, policy.ClassId)
+		if !found {
+			continue
+		}
+
+		return class, acc, true
+	}
+
+	return nft.Class{}, simtypes.Account{}, false
+}
+
+// randMintPolicy picks a random MintPolicy.
+func randMintPolicy(r *rand.Rand) nft.MintPolicy {
+	return nft.MintPolicy(r.Intn(len(nft.MintPolicy_name)))
+}
+
 // randNFT picks a random NFT from a class belonging to the specified owner(minter).
 func randNFT(ctx sdk.Context, r *rand.Rand, k keeper.Keeper, minter sdk.AccAddress) (nft.NFT, error) {
 	c, err := randClass(ctx, r, k)
@@ -87,6 +87,10 @@ func (suite *SimTestSuite) TestWeightedOperations() {
 		opMsgName  string
 	}{
 		{simulation.WeightSend, nft.ModuleName, simulation.TypeMsgSend},
+		{simulation.WeightUpdateClass, nft.ModuleName, simulation.TypeMsgUpdateClass},
+		{simulation.WeightMint, nft.ModuleName, simulation.TypeMsgMint},
+		{simulation.WeightBurn, nft.ModuleName, simulation.TypeMsgBurn},
+		{simulation.WeightUpdateNFT, nft.ModuleName, simulation.TypeMsgUpdateNFT},
 	}
 
 	for i, w := range weightedOps {
@@ -145,6 +149,104 @@ func (suite *SimTestSuite) TestSimulateMsgSend() {
 	suite.Require().Len(futureOperations, 0)
 }
 
+func (suite *SimTestSuite) TestSimulateMsgMint() {
+	s := rand.NewSource(1)
+	r := rand.New(s)
+	accounts := suite.getTestingAccounts(r, 2)
+	blockTime := time.Now().UTC()
+	ctx := suite.ctx.WithBlockTime(blockTime)
+
+	suite.saveOwnedClass(ctx, accounts[0])
+
+	// begin new block
+	suite.app.BeginBlock(abci.RequestBeginBlock{
+		Header: cmtproto.Header{
+			Height:  suite.app.LastBlockHeight() + 1,
+			AppHash: suite.app.LastCommitID().Hash,
+		},
+	})
+
+	// execute operation
+	registry := suite.interfaceRegistry
+	op := simulation.SimulateMsgMint(codec.NewProtoCodec(registry), suite.txConfig, suite.accountKeeper, suite.bankKeeper, suite.nftKeeper)
+	operationMsg, futureOperations, err := op(r, suite.app.BaseApp, ctx, accounts, "")
+	suite.Require().NoError(err)
+
+	var msg nft.MsgMint
+	suite.codec.UnmarshalJSON(operationMsg.Msg, &msg)
+	suite.Require().True(operationMsg.OK)
+	suite.Require().Equal(simulation.TypeMsgMint, operationMsg.Name)
+	suite.Require().Len(futureOperations, 0)
+}
+
+func (suite *SimTestSuite) TestSimulateMsgBurn() {
+	s := rand.NewSource(1)
+	r := rand.New(s)
+	accounts := suite.getTestingAccounts(r, 2)
+	blockTime := time.Now().UTC()
+	ctx := suite.ctx.WithBlockTime(blockTime)
+
+	// begin new block
+	suite.app.BeginBlock(abci.RequestBeginBlock{
+		Header: cmtproto.Header{
+			Height:  suite.app.LastBlockHeight() + 1,
+			AppHash: suite.app.LastCommitID().Hash,
+		},
+	})
+
+	// execute operation
+	registry := suite.interfaceRegistry
+	op := simulation.SimulateMsgBurn(codec.NewProtoCodec(registry), suite.txConfig, suite.accountKeeper, suite.bankKeeper, suite.nftKeeper)
+	operationMsg, futureOperations, err := op(r, suite.app.BaseApp, ctx, accounts, "")
+	suite.Require().NoError(err)
+
+	var msg nft.MsgBurn
+	suite.codec.UnmarshalJSON(operationMsg.Msg, &msg)
+	suite.Require().True(operationMsg.OK)
+	suite.Require().Equal(simulation.TypeMsgBurn, operationMsg.Name)
+	suite.Require().Len(futureOperations, 0)
+}
+
+func (suite *SimTestSuite) TestSimulateMsgUpdateClass() {
+	s := rand.NewSource(1)
+	r := rand.New(s)
+	accounts := suite.getTestingAccounts(r, 2)
+	blockTime := time.Now().UTC()
+	ctx := suite.ctx.WithBlockTime(blockTime)
+
+	suite.saveOwnedClass(ctx, accounts[1])
+
+	// begin new block
+	suite.app.BeginBlock(abci.RequestBeginBlock{
+		Header: cmtproto.Header{
+			Height:  suite.app.LastBlockHeight() + 1,
+			AppHash: suite.app.LastCommitID().Hash,
+		},
+	})
+
+	// execute operation
+	registry := suite.interfaceRegistry
+	op := simulation.SimulateMsgUpdateClass(codec.NewProtoCodec(registry), suite.txConfig, suite.accountKeeper, suite.bankKeeper, suite.nftKeeper)
+	operationMsg, futureOperations, err := op(r, suite.app.BaseApp, ctx, accounts, "")
+	suite.Require().NoError(err)
+
+	var msg nft.MsgUpdateClass
+	suite.codec.UnmarshalJSON(operationMsg.Msg, &msg)
+	suite.Require().True(operationMsg.OK)
+	suite.Require().Equal(simulation.TypeMsgUpdateClass, operationMsg.Name)
+	suite.Require().Len(futureOperations, 0)
+}
+
+// saveOwnedClass saves a class owned by the given account.
+func (suite *SimTestSuite) saveOwnedClass(ctx sdk.Context, owner simtypes.Account) {
+	class := nft.Class{Id: "kitty", Name: "Crypto Kitty"}
+	suite.Require().NoError(suite.nftKeeper.SaveClass(ctx, class))
+	suite.Require().NoError(suite.nftKeeper.SetClassPolicy(ctx, nft.ClassPolicy{
+		ClassId: class.Id,
+		Owner:   owner.Address.String(),
+	}))
+}
+
 func TestSimTestSuite(t *testing.T) {
 	suite.Run(t, new(SimTestSuite))
 }
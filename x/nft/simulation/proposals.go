@@ -0,0 +1,45 @@
+package simulation
+
+import (
+	"math/rand"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"cosmossdk.io/x/nft"
+)
+
+// Simulation operation weights constants
+const (
+	DefaultWeightMsgSaveClass int = 100
+
+	OpWeightMsgSaveClass = "op_weight_msg_save_class"
+)
+
+// ProposalMsgs defines the module weighted proposals' contents
+func ProposalMsgs() []simtypes.WeightedProposalMsg {
+	return []simtypes.WeightedProposalMsg{
+		simulation.NewWeightedProposalMsg(
+			OpWeightMsgSaveClass,
+			DefaultWeightMsgSaveClass,
+			SimulateMsgSaveClass,
+		),
+	}
+}
+
+// SimulateMsgSaveClass returns a random MsgSaveClass
+func SimulateMsgSaveClass(r *rand.Rand, _ sdk.Context, accs []simtypes.Account) sdk.Msg {
+	// use the default gov module account address as authority
+	var authority sdk.AccAddress = address.Module("gov")
+
+	owner, _ := simtypes.RandomAcc(r, accs)
+
+	return &nft.MsgSaveClass{
+		Authority:  authority.String(),
+		Class:      genClass(r),
+		Owner:      owner.Address.String(),
+		MintPolicy: randMintPolicy(r),
+	}
+}
@@ -0,0 +1,43 @@
+package simulation_test
+
+import (
+	"math/rand"
+	"testing"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"gotest.tools/v3/assert"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+
+	"cosmossdk.io/x/nft"
+	"cosmossdk.io/x/nft/simulation"
+)
+
+func TestProposalMsgs(t *testing.T) {
+	// initialize parameters
+	s := rand.NewSource(1)
+	r := rand.New(s)
+
+	ctx := sdk.NewContext(nil, cmtproto.Header{}, true, nil)
+	accounts := simtypes.RandomAccounts(r, 3)
+
+	// execute ProposalMsgs function
+	weightedProposalMsgs := simulation.ProposalMsgs()
+	assert.Assert(t, len(weightedProposalMsgs) == 1)
+
+	w0 := weightedProposalMsgs[0]
+
+	// tests w0 interface:
+	assert.Equal(t, simulation.OpWeightMsgSaveClass, w0.AppParamsKey())
+	assert.Equal(t, simulation.DefaultWeightMsgSaveClass, w0.DefaultWeight())
+
+	msg := w0.MsgSimulatorFn()(r, ctx, accounts)
+	msgSaveClass, ok := msg.(*nft.MsgSaveClass)
+	assert.Assert(t, ok)
+
+	assert.Equal(t, sdk.AccAddress(address.Module("gov")).String(), msgSaveClass.Authority)
+	assert.Assert(t, len(msgSaveClass.Class.Id) > 0)
+	assert.Assert(t, len(msgSaveClass.Owner) > 0)
+}
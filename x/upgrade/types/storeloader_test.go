@@ -150,3 +150,56 @@ func TestSetLoader(t *testing.T) {
 		})
 	}
 }
+
+// Test that the prefix upgrades are applied at the upgrade height, even when no
+// store is added, renamed or deleted.
+func TestSetLoaderPrefixUpgrades(t *testing.T) {
+	upgradeHeight := int64(5)
+	storeKey := storetypes.NewKVStoreKey("foo")
+
+	// prepare a db with some data
+	db := dbm.NewMemDB()
+	rs := rootmulti.NewStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics())
+	rs.SetPruning(pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
+	rs.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, nil)
+	require.NoError(t, rs.LoadLatestVersion())
+	kv := rs.GetKVStore(storeKey)
+	kv.Set([]byte("moved/key"), []byte("moved value"))
+	kv.Set([]byte("copied/key"), []byte("copied value"))
+	kv.Set([]byte("deleted/key"), []byte("deleted value"))
+	kv.Set([]byte("kept/key"), []byte("kept value"))
+	rs.Commit()
+
+	opts := []func(*baseapp.BaseApp){baseapp.SetPruning(pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))}
+	logger := log.NewTestLogger(t)
+
+	origapp := baseapp.NewBaseApp(t.Name(), logger.With("instance", "orig"), db, nil, opts...)
+	origapp.MountStores(storeKey)
+	require.NoError(t, origapp.LoadLatestVersion())
+	for i := int64(2); i <= upgradeHeight-1; i++ {
+		origapp.BeginBlock(abci.RequestBeginBlock{Header: cmtproto.Header{Height: i}})
+		origapp.Commit()
+	}
+
+	// load the new app with the original app db and the prefix upgrades
+	opts = append(opts, useUpgradeLoader(upgradeHeight, &storetypes.StoreUpgrades{
+		PrefixMoved:   []storetypes.PrefixMove{{OldStore: "foo", OldPrefix: []byte("moved/"), NewStore: "foo", NewPrefix: []byte("new-moved/")}},
+		PrefixCopied:  []storetypes.PrefixCopy{{OldStore: "foo", OldPrefix: []byte("copied/"), NewStore: "foo", NewPrefix: []byte("new-copied/")}},
+		PrefixDeleted: []storetypes.PrefixDelete{{Store: "foo", Prefix: []byte("deleted/")}},
+	}))
+	app := baseapp.NewBaseApp(t.Name(), logger.With("instance", "new"), db, nil, opts...)
+	app.MountStores(storeKey)
+	require.NoError(t, app.LoadLatestVersion())
+
+	// "execute" one block
+	app.BeginBlock(abci.RequestBeginBlock{Header: cmtproto.Header{Height: upgradeHeight}})
+	app.Commit()
+
+	// check db is properly updated
+	checkStore(t, db, upgradeHeight, "foo", []byte("moved/key"), nil)
+	checkStore(t, db, upgradeHeight, "foo", []byte("new-moved/key"), []byte("moved value"))
+	checkStore(t, db, upgradeHeight, "foo", []byte("copied/key"), []byte("copied value"))
+	checkStore(t, db, upgradeHeight, "foo", []byte("new-copied/key"), []byte("copied value"))
+	checkStore(t, db, upgradeHeight, "foo", []byte("deleted/key"), nil)
+	checkStore(t, db, upgradeHeight, "foo", []byte("kept/key"), []byte("kept value"))
+}